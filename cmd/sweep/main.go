@@ -0,0 +1,40 @@
+// Command sweep deletes the objects left behind on a Looker instance by failed
+// acceptance test runs. It reads the instance and credentials from
+// LOOKER_BASE_URL, LOOKER_CLIENT_ID and LOOKER_CLIENT_SECRET.
+//
+//	go run ./cmd/sweep -dry-run
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"terraform-provider-looker/internal/sweep"
+
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func main() {
+	prefix := flag.String("prefix", sweep.DefaultPrefix, "delete objects whose name starts with this prefix")
+	dryRun := flag.Bool("dry-run", false, "only list the objects that would be deleted")
+	flag.Parse()
+
+	settings := rtl.ApiSettings{
+		BaseUrl:      os.Getenv("LOOKER_BASE_URL"),
+		ApiVersion:   "4.0",
+		ClientId:     os.Getenv("LOOKER_CLIENT_ID"),
+		ClientSecret: os.Getenv("LOOKER_CLIENT_SECRET"),
+		Timeout:      120,
+		VerifySsl:    true,
+	}
+	if settings.BaseUrl == "" || settings.ClientId == "" || settings.ClientSecret == "" {
+		log.Fatal("LOOKER_BASE_URL, LOOKER_CLIENT_ID and LOOKER_CLIENT_SECRET must be set")
+	}
+	sdk := v4.NewLookerSDK(rtl.NewAuthSession(settings))
+
+	if err := sweep.Sweep(sdk, *prefix, *dryRun, log.Printf); err != nil {
+		log.Fatal(err)
+	}
+}
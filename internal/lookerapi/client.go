@@ -0,0 +1,163 @@
+// Package lookerapi defines the subset of the Looker API 4.0 SDK the provider
+// calls, and the Streamer for listings too large for it, so that resources can
+// be tested against mocks instead of a Looker instance. The lookerapimock
+// package holds the generated mocks.
+package lookerapi
+
+//go:generate go tool mockgen -destination=lookerapimock/client.go -package=lookerapimock . Client,Streamer
+
+import (
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// Client is implemented by *v4.LookerSDK. Methods are grouped by API area, in
+// the order of the SDK; add a method here before calling it from the provider.
+type Client interface {
+	// Alert
+	SearchAlerts(request v4.RequestSearchAlerts, options *rtl.ApiSettings) ([]v4.Alert, error)
+
+	// ApiAuth
+	LoginUser(userId string, associative bool, options *rtl.ApiSettings) (v4.AccessToken, error)
+
+	// Artifact
+	Artifact(request v4.RequestArtifact, options *rtl.ApiSettings) ([]v4.Artifact, error)
+	DeleteArtifact(namespace string, key string, options *rtl.ApiSettings) error
+	UpdateArtifacts(namespace string, body []v4.UpdateArtifact, fields string, options *rtl.ApiSettings) ([]v4.Artifact, error)
+
+	// Auth
+	OidcConfig(options *rtl.ApiSettings) (v4.OIDCConfig, error)
+	UpdateOidcConfig(body v4.WriteOIDCConfig, options *rtl.ApiSettings) (v4.OIDCConfig, error)
+	AllUserLoginLockouts(fields string, options *rtl.ApiSettings) ([]v4.UserLoginLockout, error)
+	SearchUserLoginLockouts(request v4.RequestSearchUserLoginLockouts, options *rtl.ApiSettings) ([]v4.UserLoginLockout, error)
+	DeleteUserLoginLockout(key string, options *rtl.ApiSettings) (string, error)
+
+	// Board
+	SearchBoards(request v4.RequestSearchBoards, options *rtl.ApiSettings) ([]v4.Board, error)
+
+	// Config
+	AllLocales(options *rtl.ApiSettings) ([]v4.Locale, error)
+	Versions(fields string, options *rtl.ApiSettings) (v4.ApiVersion, error)
+
+	// Connection
+	Connection(connectionName string, fields string, options *rtl.ApiSettings) (v4.DBConnection, error)
+	UpdateConnection(connectionName string, body v4.WriteDBConnection, options *rtl.ApiSettings) (v4.DBConnection, error)
+	AllExternalOauthApplications(request v4.RequestAllExternalOauthApplications, options *rtl.ApiSettings) ([]v4.ExternalOauthApplication, error)
+	CreateExternalOauthApplication(body v4.WriteExternalOauthApplication, options *rtl.ApiSettings) (v4.ExternalOauthApplication, error)
+	UpdateExternalOauthApplication(clientId string, body v4.WriteExternalOauthApplication, options *rtl.ApiSettings) (v4.ExternalOauthApplication, error)
+
+	// Content
+	ContentMetadata(contentMetadataId string, fields string, options *rtl.ApiSettings) (v4.ContentMeta, error)
+	UpdateContentMetadata(contentMetadataId string, body v4.WriteContentMeta, options *rtl.ApiSettings) (v4.ContentMeta, error)
+	AllContentMetadataAccesses(contentMetadataId string, fields string, options *rtl.ApiSettings) ([]v4.ContentMetaGroupUser, error)
+	CreateContentMetadataAccess(body v4.ContentMetaGroupUser, sendBoardsNotificationEmail bool, options *rtl.ApiSettings) (v4.ContentMetaGroupUser, error)
+	UpdateContentMetadataAccess(contentMetadataAccessId string, body v4.ContentMetaGroupUser, options *rtl.ApiSettings) (v4.ContentMetaGroupUser, error)
+	DeleteContentMetadataAccess(contentMetadataAccessId string, options *rtl.ApiSettings) (string, error)
+	ContentValidation(request v4.RequestContentValidation, options *rtl.ApiSettings) (v4.ContentValidation, error)
+
+	// Dashboard
+	CreateDashboard(body v4.WriteDashboard, options *rtl.ApiSettings) (v4.Dashboard, error)
+	SearchDashboards(request v4.RequestSearchDashboards, options *rtl.ApiSettings) ([]v4.Dashboard, error)
+	Dashboard(dashboardId string, fields string, options *rtl.ApiSettings) (v4.Dashboard, error)
+	UpdateDashboard(dashboardId string, body v4.WriteDashboard, options *rtl.ApiSettings) (v4.Dashboard, error)
+	DeleteDashboard(dashboardId string, options *rtl.ApiSettings) (string, error)
+	DashboardLookml(dashboardId string, options *rtl.ApiSettings) (v4.DashboardLookml, error)
+	ImportDashboardFromLookml(body v4.WriteDashboardLookml, options *rtl.ApiSettings) (v4.Dashboard, error)
+	DeleteDashboardElement(dashboardElementId string, options *rtl.ApiSettings) (string, error)
+	DashboardDashboardElements(dashboardId string, fields string, options *rtl.ApiSettings) ([]v4.DashboardElement, error)
+	CreateDashboardElement(request v4.RequestCreateDashboardElement, options *rtl.ApiSettings) (v4.DashboardElement, error)
+	DeleteDashboardFilter(dashboardFilterId string, options *rtl.ApiSettings) (string, error)
+	DashboardDashboardFilters(dashboardId string, fields string, options *rtl.ApiSettings) ([]v4.DashboardFilter, error)
+	CreateDashboardFilter(body v4.WriteCreateDashboardFilter, fields string, options *rtl.ApiSettings) (v4.DashboardFilter, error)
+
+	// Datagroup
+	UpdateDatagroup(datagroupId string, body v4.WriteDatagroup, options *rtl.ApiSettings) (v4.Datagroup, error)
+
+	// Folder
+	SearchFolders(request v4.RequestSearchFolders, options *rtl.ApiSettings) ([]v4.Folder, error)
+	Folder(folderId string, fields string, options *rtl.ApiSettings) (v4.Folder, error)
+	UpdateFolder(folderId string, body v4.UpdateFolder, options *rtl.ApiSettings) (v4.Folder, error)
+	DeleteFolder(folderId string, options *rtl.ApiSettings) (string, error)
+	CreateFolder(body v4.CreateFolder, options *rtl.ApiSettings) (v4.Folder, error)
+	FolderChildren(request v4.RequestFolderChildren, options *rtl.ApiSettings) ([]v4.Folder, error)
+	FolderLooks(folderId string, fields string, options *rtl.ApiSettings) ([]v4.LookWithQuery, error)
+	FolderDashboards(folderId string, fields string, options *rtl.ApiSettings) ([]v4.Dashboard, error)
+
+	// Group
+	AllGroups(request v4.RequestAllGroups, options *rtl.ApiSettings) ([]v4.Group, error)
+	CreateGroup(body v4.WriteGroup, fields string, options *rtl.ApiSettings) (v4.Group, error)
+	SearchGroups(request v4.RequestSearchGroups, options *rtl.ApiSettings) ([]v4.Group, error)
+	SearchGroupsWithRoles(request v4.RequestSearchGroupsWithRoles, options *rtl.ApiSettings) ([]v4.GroupSearch, error)
+	Group(groupId string, fields string, options *rtl.ApiSettings) (v4.Group, error)
+	UpdateGroup(groupId string, body v4.WriteGroup, fields string, options *rtl.ApiSettings) (v4.Group, error)
+	DeleteGroup(groupId string, options *rtl.ApiSettings) (string, error)
+	AllGroupUsers(request v4.RequestAllGroupUsers, options *rtl.ApiSettings) ([]v4.User, error)
+	AddGroupUser(groupId string, body v4.GroupIdForGroupUserInclusion, options *rtl.ApiSettings) (v4.User, error)
+	DeleteGroupUser(groupId string, userId string, options *rtl.ApiSettings) error
+
+	// Homepage
+	AllPrimaryHomepageSections(fields string, options *rtl.ApiSettings) ([]v4.HomepageSection, error)
+
+	// Look
+	CreateLook(body v4.WriteLookWithQuery, fields string, options *rtl.ApiSettings) (v4.LookWithQuery, error)
+	SearchLooks(request v4.RequestSearchLooks, options *rtl.ApiSettings) ([]v4.Look, error)
+	Look(lookId string, fields string, options *rtl.ApiSettings) (v4.LookWithQuery, error)
+	DeleteLook(lookId string, options *rtl.ApiSettings) (string, error)
+
+	// LookmlModel
+	AllLookmlModels(request v4.RequestAllLookmlModels, options *rtl.ApiSettings) ([]v4.LookmlModel, error)
+
+	// Project
+	DeployRefToProduction(request v4.RequestDeployRefToProduction, options *rtl.ApiSettings) (string, error)
+	Project(projectId string, fields string, options *rtl.ApiSettings) (v4.Project, error)
+	UpdateProject(projectId string, body v4.WriteProject, fields string, options *rtl.ApiSettings) (v4.Project, error)
+	GitDeployKey(projectId string, options *rtl.ApiSettings) (string, error)
+	CreateGitDeployKey(projectId string, options *rtl.ApiSettings) (string, error)
+
+	// Role
+	SearchModelSets(request v4.RequestSearchModelSets, options *rtl.ApiSettings) ([]v4.ModelSet, error)
+	ModelSet(modelSetId string, fields string, options *rtl.ApiSettings) (v4.ModelSet, error)
+	UpdateModelSet(modelSetId string, body v4.WriteModelSet, options *rtl.ApiSettings) (v4.ModelSet, error)
+	DeleteModelSet(modelSetId string, options *rtl.ApiSettings) (string, error)
+	CreateModelSet(body v4.WriteModelSet, options *rtl.ApiSettings) (v4.ModelSet, error)
+	AllPermissions(options *rtl.ApiSettings) ([]v4.Permission, error)
+	SearchPermissionSets(request v4.RequestSearchPermissionSets, options *rtl.ApiSettings) ([]v4.PermissionSet, error)
+	PermissionSet(permissionSetId string, fields string, options *rtl.ApiSettings) (v4.PermissionSet, error)
+	UpdatePermissionSet(permissionSetId string, body v4.WritePermissionSet, options *rtl.ApiSettings) (v4.PermissionSet, error)
+	DeletePermissionSet(permissionSetId string, options *rtl.ApiSettings) (string, error)
+	CreatePermissionSet(body v4.WritePermissionSet, options *rtl.ApiSettings) (v4.PermissionSet, error)
+	CreateRole(body v4.WriteRole, options *rtl.ApiSettings) (v4.Role, error)
+	SearchRoles(request v4.RequestSearchRoles, options *rtl.ApiSettings) ([]v4.Role, error)
+	Role(roleId string, options *rtl.ApiSettings) (v4.Role, error)
+	UpdateRole(roleId string, body v4.WriteRole, options *rtl.ApiSettings) (v4.Role, error)
+	DeleteRole(roleId string, options *rtl.ApiSettings) (string, error)
+	RoleGroups(roleId string, fields string, options *rtl.ApiSettings) ([]v4.Group, error)
+	SetRoleGroups(roleId string, body []string, options *rtl.ApiSettings) ([]v4.Group, error)
+	RoleUsers(request v4.RequestRoleUsers, options *rtl.ApiSettings) ([]v4.User, error)
+	SetRoleUsers(roleId string, body []string, options *rtl.ApiSettings) ([]v4.User, error)
+
+	// ScheduledPlan
+	ScheduledPlanRunOnceById(scheduledPlanId string, body v4.WriteScheduledPlan, options *rtl.ApiSettings) (v4.ScheduledPlan, error)
+
+	// Session
+	UpdateSession(body v4.WriteApiSession, options *rtl.ApiSettings) (v4.ApiSession, error)
+
+	// User
+	Me(fields string, options *rtl.ApiSettings) (v4.User, error)
+	CreateUser(body v4.WriteUser, fields string, options *rtl.ApiSettings) (v4.User, error)
+	SearchUsers(request v4.RequestSearchUsers, options *rtl.ApiSettings) ([]v4.User, error)
+	User(userId string, fields string, options *rtl.ApiSettings) (v4.User, error)
+	UpdateUser(userId string, body v4.WriteUser, fields string, options *rtl.ApiSettings) (v4.User, error)
+	DeleteUser(userId string, options *rtl.ApiSettings) (string, error)
+	CreateUserCredentialsEmail(userId string, body v4.WriteCredentialsEmail, fields string, options *rtl.ApiSettings) (v4.CredentialsEmail, error)
+	UpdateUserCredentialsEmail(userId string, body v4.WriteCredentialsEmail, fields string, options *rtl.ApiSettings) (v4.CredentialsEmail, error)
+	DeleteUserCredentialsEmail(userId string, options *rtl.ApiSettings) (string, error)
+	UserRoles(request v4.RequestUserRoles, options *rtl.ApiSettings) ([]v4.Role, error)
+
+	// UserAttribute
+	UserAttributeUserValues(request v4.RequestUserAttributeUserValues, options *rtl.ApiSettings) ([]v4.UserAttributeWithValue, error)
+	SetUserAttributeUserValue(userId string, userAttributeId string, body v4.WriteUserAttributeWithValue, options *rtl.ApiSettings) (v4.UserAttributeWithValue, error)
+	DeleteUserAttributeUserValue(userId string, userAttributeId string, options *rtl.ApiSettings) error
+}
+
+var _ Client = (*v4.LookerSDK)(nil)
@@ -0,0 +1,1626 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: terraform-provider-looker/internal/lookerapi (interfaces: Client,Streamer)
+//
+// Generated by this command:
+//
+//	mockgen -destination=lookerapimock/client.go -package=lookerapimock . Client,Streamer
+//
+
+// Package lookerapimock is a generated GoMock package.
+package lookerapimock
+
+import (
+	context "context"
+	io "io"
+	url "net/url"
+	reflect "reflect"
+
+	rtl "github.com/looker-open-source/sdk-codegen/go/rtl"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockClient is a mock of Client interface.
+type MockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockClientMockRecorder
+	isgomock struct{}
+}
+
+// MockClientMockRecorder is the mock recorder for MockClient.
+type MockClientMockRecorder struct {
+	mock *MockClient
+}
+
+// NewMockClient creates a new mock instance.
+func NewMockClient(ctrl *gomock.Controller) *MockClient {
+	mock := &MockClient{ctrl: ctrl}
+	mock.recorder = &MockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClient) EXPECT() *MockClientMockRecorder {
+	return m.recorder
+}
+
+// AddGroupUser mocks base method.
+func (m *MockClient) AddGroupUser(groupId string, body v4.GroupIdForGroupUserInclusion, options *rtl.ApiSettings) (v4.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGroupUser", groupId, body, options)
+	ret0, _ := ret[0].(v4.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGroupUser indicates an expected call of AddGroupUser.
+func (mr *MockClientMockRecorder) AddGroupUser(groupId, body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupUser", reflect.TypeOf((*MockClient)(nil).AddGroupUser), groupId, body, options)
+}
+
+// AllContentMetadataAccesses mocks base method.
+func (m *MockClient) AllContentMetadataAccesses(contentMetadataId, fields string, options *rtl.ApiSettings) ([]v4.ContentMetaGroupUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllContentMetadataAccesses", contentMetadataId, fields, options)
+	ret0, _ := ret[0].([]v4.ContentMetaGroupUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllContentMetadataAccesses indicates an expected call of AllContentMetadataAccesses.
+func (mr *MockClientMockRecorder) AllContentMetadataAccesses(contentMetadataId, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllContentMetadataAccesses", reflect.TypeOf((*MockClient)(nil).AllContentMetadataAccesses), contentMetadataId, fields, options)
+}
+
+// AllExternalOauthApplications mocks base method.
+func (m *MockClient) AllExternalOauthApplications(request v4.RequestAllExternalOauthApplications, options *rtl.ApiSettings) ([]v4.ExternalOauthApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllExternalOauthApplications", request, options)
+	ret0, _ := ret[0].([]v4.ExternalOauthApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllExternalOauthApplications indicates an expected call of AllExternalOauthApplications.
+func (mr *MockClientMockRecorder) AllExternalOauthApplications(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllExternalOauthApplications", reflect.TypeOf((*MockClient)(nil).AllExternalOauthApplications), request, options)
+}
+
+// AllGroupUsers mocks base method.
+func (m *MockClient) AllGroupUsers(request v4.RequestAllGroupUsers, options *rtl.ApiSettings) ([]v4.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllGroupUsers", request, options)
+	ret0, _ := ret[0].([]v4.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllGroupUsers indicates an expected call of AllGroupUsers.
+func (mr *MockClientMockRecorder) AllGroupUsers(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllGroupUsers", reflect.TypeOf((*MockClient)(nil).AllGroupUsers), request, options)
+}
+
+// AllGroups mocks base method.
+func (m *MockClient) AllGroups(request v4.RequestAllGroups, options *rtl.ApiSettings) ([]v4.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllGroups", request, options)
+	ret0, _ := ret[0].([]v4.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllGroups indicates an expected call of AllGroups.
+func (mr *MockClientMockRecorder) AllGroups(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllGroups", reflect.TypeOf((*MockClient)(nil).AllGroups), request, options)
+}
+
+// AllLocales mocks base method.
+func (m *MockClient) AllLocales(options *rtl.ApiSettings) ([]v4.Locale, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllLocales", options)
+	ret0, _ := ret[0].([]v4.Locale)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllLocales indicates an expected call of AllLocales.
+func (mr *MockClientMockRecorder) AllLocales(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllLocales", reflect.TypeOf((*MockClient)(nil).AllLocales), options)
+}
+
+// AllLookmlModels mocks base method.
+func (m *MockClient) AllLookmlModels(request v4.RequestAllLookmlModels, options *rtl.ApiSettings) ([]v4.LookmlModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllLookmlModels", request, options)
+	ret0, _ := ret[0].([]v4.LookmlModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllLookmlModels indicates an expected call of AllLookmlModels.
+func (mr *MockClientMockRecorder) AllLookmlModels(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllLookmlModels", reflect.TypeOf((*MockClient)(nil).AllLookmlModels), request, options)
+}
+
+// AllPermissions mocks base method.
+func (m *MockClient) AllPermissions(options *rtl.ApiSettings) ([]v4.Permission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllPermissions", options)
+	ret0, _ := ret[0].([]v4.Permission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllPermissions indicates an expected call of AllPermissions.
+func (mr *MockClientMockRecorder) AllPermissions(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllPermissions", reflect.TypeOf((*MockClient)(nil).AllPermissions), options)
+}
+
+// AllPrimaryHomepageSections mocks base method.
+func (m *MockClient) AllPrimaryHomepageSections(fields string, options *rtl.ApiSettings) ([]v4.HomepageSection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllPrimaryHomepageSections", fields, options)
+	ret0, _ := ret[0].([]v4.HomepageSection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllPrimaryHomepageSections indicates an expected call of AllPrimaryHomepageSections.
+func (mr *MockClientMockRecorder) AllPrimaryHomepageSections(fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllPrimaryHomepageSections", reflect.TypeOf((*MockClient)(nil).AllPrimaryHomepageSections), fields, options)
+}
+
+// AllUserLoginLockouts mocks base method.
+func (m *MockClient) AllUserLoginLockouts(fields string, options *rtl.ApiSettings) ([]v4.UserLoginLockout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllUserLoginLockouts", fields, options)
+	ret0, _ := ret[0].([]v4.UserLoginLockout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllUserLoginLockouts indicates an expected call of AllUserLoginLockouts.
+func (mr *MockClientMockRecorder) AllUserLoginLockouts(fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllUserLoginLockouts", reflect.TypeOf((*MockClient)(nil).AllUserLoginLockouts), fields, options)
+}
+
+// Artifact mocks base method.
+func (m *MockClient) Artifact(request v4.RequestArtifact, options *rtl.ApiSettings) ([]v4.Artifact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Artifact", request, options)
+	ret0, _ := ret[0].([]v4.Artifact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Artifact indicates an expected call of Artifact.
+func (mr *MockClientMockRecorder) Artifact(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Artifact", reflect.TypeOf((*MockClient)(nil).Artifact), request, options)
+}
+
+// Connection mocks base method.
+func (m *MockClient) Connection(connectionName, fields string, options *rtl.ApiSettings) (v4.DBConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Connection", connectionName, fields, options)
+	ret0, _ := ret[0].(v4.DBConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Connection indicates an expected call of Connection.
+func (mr *MockClientMockRecorder) Connection(connectionName, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connection", reflect.TypeOf((*MockClient)(nil).Connection), connectionName, fields, options)
+}
+
+// ContentMetadata mocks base method.
+func (m *MockClient) ContentMetadata(contentMetadataId, fields string, options *rtl.ApiSettings) (v4.ContentMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContentMetadata", contentMetadataId, fields, options)
+	ret0, _ := ret[0].(v4.ContentMeta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContentMetadata indicates an expected call of ContentMetadata.
+func (mr *MockClientMockRecorder) ContentMetadata(contentMetadataId, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContentMetadata", reflect.TypeOf((*MockClient)(nil).ContentMetadata), contentMetadataId, fields, options)
+}
+
+// ContentValidation mocks base method.
+func (m *MockClient) ContentValidation(request v4.RequestContentValidation, options *rtl.ApiSettings) (v4.ContentValidation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContentValidation", request, options)
+	ret0, _ := ret[0].(v4.ContentValidation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContentValidation indicates an expected call of ContentValidation.
+func (mr *MockClientMockRecorder) ContentValidation(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContentValidation", reflect.TypeOf((*MockClient)(nil).ContentValidation), request, options)
+}
+
+// CreateContentMetadataAccess mocks base method.
+func (m *MockClient) CreateContentMetadataAccess(body v4.ContentMetaGroupUser, sendBoardsNotificationEmail bool, options *rtl.ApiSettings) (v4.ContentMetaGroupUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContentMetadataAccess", body, sendBoardsNotificationEmail, options)
+	ret0, _ := ret[0].(v4.ContentMetaGroupUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateContentMetadataAccess indicates an expected call of CreateContentMetadataAccess.
+func (mr *MockClientMockRecorder) CreateContentMetadataAccess(body, sendBoardsNotificationEmail, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContentMetadataAccess", reflect.TypeOf((*MockClient)(nil).CreateContentMetadataAccess), body, sendBoardsNotificationEmail, options)
+}
+
+// CreateDashboard mocks base method.
+func (m *MockClient) CreateDashboard(body v4.WriteDashboard, options *rtl.ApiSettings) (v4.Dashboard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDashboard", body, options)
+	ret0, _ := ret[0].(v4.Dashboard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDashboard indicates an expected call of CreateDashboard.
+func (mr *MockClientMockRecorder) CreateDashboard(body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDashboard", reflect.TypeOf((*MockClient)(nil).CreateDashboard), body, options)
+}
+
+// CreateDashboardElement mocks base method.
+func (m *MockClient) CreateDashboardElement(request v4.RequestCreateDashboardElement, options *rtl.ApiSettings) (v4.DashboardElement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDashboardElement", request, options)
+	ret0, _ := ret[0].(v4.DashboardElement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDashboardElement indicates an expected call of CreateDashboardElement.
+func (mr *MockClientMockRecorder) CreateDashboardElement(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDashboardElement", reflect.TypeOf((*MockClient)(nil).CreateDashboardElement), request, options)
+}
+
+// CreateDashboardFilter mocks base method.
+func (m *MockClient) CreateDashboardFilter(body v4.WriteCreateDashboardFilter, fields string, options *rtl.ApiSettings) (v4.DashboardFilter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDashboardFilter", body, fields, options)
+	ret0, _ := ret[0].(v4.DashboardFilter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDashboardFilter indicates an expected call of CreateDashboardFilter.
+func (mr *MockClientMockRecorder) CreateDashboardFilter(body, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDashboardFilter", reflect.TypeOf((*MockClient)(nil).CreateDashboardFilter), body, fields, options)
+}
+
+// CreateExternalOauthApplication mocks base method.
+func (m *MockClient) CreateExternalOauthApplication(body v4.WriteExternalOauthApplication, options *rtl.ApiSettings) (v4.ExternalOauthApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExternalOauthApplication", body, options)
+	ret0, _ := ret[0].(v4.ExternalOauthApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExternalOauthApplication indicates an expected call of CreateExternalOauthApplication.
+func (mr *MockClientMockRecorder) CreateExternalOauthApplication(body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExternalOauthApplication", reflect.TypeOf((*MockClient)(nil).CreateExternalOauthApplication), body, options)
+}
+
+// CreateFolder mocks base method.
+func (m *MockClient) CreateFolder(body v4.CreateFolder, options *rtl.ApiSettings) (v4.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFolder", body, options)
+	ret0, _ := ret[0].(v4.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFolder indicates an expected call of CreateFolder.
+func (mr *MockClientMockRecorder) CreateFolder(body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolder", reflect.TypeOf((*MockClient)(nil).CreateFolder), body, options)
+}
+
+// CreateGitDeployKey mocks base method.
+func (m *MockClient) CreateGitDeployKey(projectId string, options *rtl.ApiSettings) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGitDeployKey", projectId, options)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGitDeployKey indicates an expected call of CreateGitDeployKey.
+func (mr *MockClientMockRecorder) CreateGitDeployKey(projectId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGitDeployKey", reflect.TypeOf((*MockClient)(nil).CreateGitDeployKey), projectId, options)
+}
+
+// CreateGroup mocks base method.
+func (m *MockClient) CreateGroup(body v4.WriteGroup, fields string, options *rtl.ApiSettings) (v4.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroup", body, fields, options)
+	ret0, _ := ret[0].(v4.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroup indicates an expected call of CreateGroup.
+func (mr *MockClientMockRecorder) CreateGroup(body, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockClient)(nil).CreateGroup), body, fields, options)
+}
+
+// CreateLook mocks base method.
+func (m *MockClient) CreateLook(body v4.WriteLookWithQuery, fields string, options *rtl.ApiSettings) (v4.LookWithQuery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLook", body, fields, options)
+	ret0, _ := ret[0].(v4.LookWithQuery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLook indicates an expected call of CreateLook.
+func (mr *MockClientMockRecorder) CreateLook(body, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLook", reflect.TypeOf((*MockClient)(nil).CreateLook), body, fields, options)
+}
+
+// CreateModelSet mocks base method.
+func (m *MockClient) CreateModelSet(body v4.WriteModelSet, options *rtl.ApiSettings) (v4.ModelSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateModelSet", body, options)
+	ret0, _ := ret[0].(v4.ModelSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateModelSet indicates an expected call of CreateModelSet.
+func (mr *MockClientMockRecorder) CreateModelSet(body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModelSet", reflect.TypeOf((*MockClient)(nil).CreateModelSet), body, options)
+}
+
+// CreatePermissionSet mocks base method.
+func (m *MockClient) CreatePermissionSet(body v4.WritePermissionSet, options *rtl.ApiSettings) (v4.PermissionSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePermissionSet", body, options)
+	ret0, _ := ret[0].(v4.PermissionSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePermissionSet indicates an expected call of CreatePermissionSet.
+func (mr *MockClientMockRecorder) CreatePermissionSet(body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePermissionSet", reflect.TypeOf((*MockClient)(nil).CreatePermissionSet), body, options)
+}
+
+// CreateRole mocks base method.
+func (m *MockClient) CreateRole(body v4.WriteRole, options *rtl.ApiSettings) (v4.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRole", body, options)
+	ret0, _ := ret[0].(v4.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRole indicates an expected call of CreateRole.
+func (mr *MockClientMockRecorder) CreateRole(body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockClient)(nil).CreateRole), body, options)
+}
+
+// CreateUser mocks base method.
+func (m *MockClient) CreateUser(body v4.WriteUser, fields string, options *rtl.ApiSettings) (v4.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", body, fields, options)
+	ret0, _ := ret[0].(v4.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockClientMockRecorder) CreateUser(body, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockClient)(nil).CreateUser), body, fields, options)
+}
+
+// CreateUserCredentialsEmail mocks base method.
+func (m *MockClient) CreateUserCredentialsEmail(userId string, body v4.WriteCredentialsEmail, fields string, options *rtl.ApiSettings) (v4.CredentialsEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserCredentialsEmail", userId, body, fields, options)
+	ret0, _ := ret[0].(v4.CredentialsEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserCredentialsEmail indicates an expected call of CreateUserCredentialsEmail.
+func (mr *MockClientMockRecorder) CreateUserCredentialsEmail(userId, body, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserCredentialsEmail", reflect.TypeOf((*MockClient)(nil).CreateUserCredentialsEmail), userId, body, fields, options)
+}
+
+// Dashboard mocks base method.
+func (m *MockClient) Dashboard(dashboardId, fields string, options *rtl.ApiSettings) (v4.Dashboard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dashboard", dashboardId, fields, options)
+	ret0, _ := ret[0].(v4.Dashboard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Dashboard indicates an expected call of Dashboard.
+func (mr *MockClientMockRecorder) Dashboard(dashboardId, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dashboard", reflect.TypeOf((*MockClient)(nil).Dashboard), dashboardId, fields, options)
+}
+
+// DashboardDashboardElements mocks base method.
+func (m *MockClient) DashboardDashboardElements(dashboardId, fields string, options *rtl.ApiSettings) ([]v4.DashboardElement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DashboardDashboardElements", dashboardId, fields, options)
+	ret0, _ := ret[0].([]v4.DashboardElement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DashboardDashboardElements indicates an expected call of DashboardDashboardElements.
+func (mr *MockClientMockRecorder) DashboardDashboardElements(dashboardId, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DashboardDashboardElements", reflect.TypeOf((*MockClient)(nil).DashboardDashboardElements), dashboardId, fields, options)
+}
+
+// DashboardDashboardFilters mocks base method.
+func (m *MockClient) DashboardDashboardFilters(dashboardId, fields string, options *rtl.ApiSettings) ([]v4.DashboardFilter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DashboardDashboardFilters", dashboardId, fields, options)
+	ret0, _ := ret[0].([]v4.DashboardFilter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DashboardDashboardFilters indicates an expected call of DashboardDashboardFilters.
+func (mr *MockClientMockRecorder) DashboardDashboardFilters(dashboardId, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DashboardDashboardFilters", reflect.TypeOf((*MockClient)(nil).DashboardDashboardFilters), dashboardId, fields, options)
+}
+
+// DashboardLookml mocks base method.
+func (m *MockClient) DashboardLookml(dashboardId string, options *rtl.ApiSettings) (v4.DashboardLookml, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DashboardLookml", dashboardId, options)
+	ret0, _ := ret[0].(v4.DashboardLookml)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DashboardLookml indicates an expected call of DashboardLookml.
+func (mr *MockClientMockRecorder) DashboardLookml(dashboardId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DashboardLookml", reflect.TypeOf((*MockClient)(nil).DashboardLookml), dashboardId, options)
+}
+
+// DeleteArtifact mocks base method.
+func (m *MockClient) DeleteArtifact(namespace, key string, options *rtl.ApiSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArtifact", namespace, key, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArtifact indicates an expected call of DeleteArtifact.
+func (mr *MockClientMockRecorder) DeleteArtifact(namespace, key, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArtifact", reflect.TypeOf((*MockClient)(nil).DeleteArtifact), namespace, key, options)
+}
+
+// DeleteContentMetadataAccess mocks base method.
+func (m *MockClient) DeleteContentMetadataAccess(contentMetadataAccessId string, options *rtl.ApiSettings) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContentMetadataAccess", contentMetadataAccessId, options)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteContentMetadataAccess indicates an expected call of DeleteContentMetadataAccess.
+func (mr *MockClientMockRecorder) DeleteContentMetadataAccess(contentMetadataAccessId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContentMetadataAccess", reflect.TypeOf((*MockClient)(nil).DeleteContentMetadataAccess), contentMetadataAccessId, options)
+}
+
+// DeleteDashboard mocks base method.
+func (m *MockClient) DeleteDashboard(dashboardId string, options *rtl.ApiSettings) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDashboard", dashboardId, options)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDashboard indicates an expected call of DeleteDashboard.
+func (mr *MockClientMockRecorder) DeleteDashboard(dashboardId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDashboard", reflect.TypeOf((*MockClient)(nil).DeleteDashboard), dashboardId, options)
+}
+
+// DeleteDashboardElement mocks base method.
+func (m *MockClient) DeleteDashboardElement(dashboardElementId string, options *rtl.ApiSettings) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDashboardElement", dashboardElementId, options)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDashboardElement indicates an expected call of DeleteDashboardElement.
+func (mr *MockClientMockRecorder) DeleteDashboardElement(dashboardElementId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDashboardElement", reflect.TypeOf((*MockClient)(nil).DeleteDashboardElement), dashboardElementId, options)
+}
+
+// DeleteDashboardFilter mocks base method.
+func (m *MockClient) DeleteDashboardFilter(dashboardFilterId string, options *rtl.ApiSettings) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDashboardFilter", dashboardFilterId, options)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDashboardFilter indicates an expected call of DeleteDashboardFilter.
+func (mr *MockClientMockRecorder) DeleteDashboardFilter(dashboardFilterId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDashboardFilter", reflect.TypeOf((*MockClient)(nil).DeleteDashboardFilter), dashboardFilterId, options)
+}
+
+// DeleteFolder mocks base method.
+func (m *MockClient) DeleteFolder(folderId string, options *rtl.ApiSettings) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFolder", folderId, options)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFolder indicates an expected call of DeleteFolder.
+func (mr *MockClientMockRecorder) DeleteFolder(folderId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFolder", reflect.TypeOf((*MockClient)(nil).DeleteFolder), folderId, options)
+}
+
+// DeleteGroup mocks base method.
+func (m *MockClient) DeleteGroup(groupId string, options *rtl.ApiSettings) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroup", groupId, options)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGroup indicates an expected call of DeleteGroup.
+func (mr *MockClientMockRecorder) DeleteGroup(groupId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockClient)(nil).DeleteGroup), groupId, options)
+}
+
+// DeleteGroupUser mocks base method.
+func (m *MockClient) DeleteGroupUser(groupId, userId string, options *rtl.ApiSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupUser", groupId, userId, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroupUser indicates an expected call of DeleteGroupUser.
+func (mr *MockClientMockRecorder) DeleteGroupUser(groupId, userId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupUser", reflect.TypeOf((*MockClient)(nil).DeleteGroupUser), groupId, userId, options)
+}
+
+// DeleteLook mocks base method.
+func (m *MockClient) DeleteLook(lookId string, options *rtl.ApiSettings) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLook", lookId, options)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLook indicates an expected call of DeleteLook.
+func (mr *MockClientMockRecorder) DeleteLook(lookId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLook", reflect.TypeOf((*MockClient)(nil).DeleteLook), lookId, options)
+}
+
+// DeleteModelSet mocks base method.
+func (m *MockClient) DeleteModelSet(modelSetId string, options *rtl.ApiSettings) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteModelSet", modelSetId, options)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteModelSet indicates an expected call of DeleteModelSet.
+func (mr *MockClientMockRecorder) DeleteModelSet(modelSetId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteModelSet", reflect.TypeOf((*MockClient)(nil).DeleteModelSet), modelSetId, options)
+}
+
+// DeletePermissionSet mocks base method.
+func (m *MockClient) DeletePermissionSet(permissionSetId string, options *rtl.ApiSettings) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePermissionSet", permissionSetId, options)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePermissionSet indicates an expected call of DeletePermissionSet.
+func (mr *MockClientMockRecorder) DeletePermissionSet(permissionSetId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermissionSet", reflect.TypeOf((*MockClient)(nil).DeletePermissionSet), permissionSetId, options)
+}
+
+// DeleteRole mocks base method.
+func (m *MockClient) DeleteRole(roleId string, options *rtl.ApiSettings) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", roleId, options)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockClientMockRecorder) DeleteRole(roleId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockClient)(nil).DeleteRole), roleId, options)
+}
+
+// DeleteUser mocks base method.
+func (m *MockClient) DeleteUser(userId string, options *rtl.ApiSettings) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", userId, options)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockClientMockRecorder) DeleteUser(userId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockClient)(nil).DeleteUser), userId, options)
+}
+
+// DeleteUserAttributeUserValue mocks base method.
+func (m *MockClient) DeleteUserAttributeUserValue(userId, userAttributeId string, options *rtl.ApiSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserAttributeUserValue", userId, userAttributeId, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserAttributeUserValue indicates an expected call of DeleteUserAttributeUserValue.
+func (mr *MockClientMockRecorder) DeleteUserAttributeUserValue(userId, userAttributeId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserAttributeUserValue", reflect.TypeOf((*MockClient)(nil).DeleteUserAttributeUserValue), userId, userAttributeId, options)
+}
+
+// DeleteUserCredentialsEmail mocks base method.
+func (m *MockClient) DeleteUserCredentialsEmail(userId string, options *rtl.ApiSettings) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserCredentialsEmail", userId, options)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserCredentialsEmail indicates an expected call of DeleteUserCredentialsEmail.
+func (mr *MockClientMockRecorder) DeleteUserCredentialsEmail(userId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserCredentialsEmail", reflect.TypeOf((*MockClient)(nil).DeleteUserCredentialsEmail), userId, options)
+}
+
+// DeleteUserLoginLockout mocks base method.
+func (m *MockClient) DeleteUserLoginLockout(key string, options *rtl.ApiSettings) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserLoginLockout", key, options)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserLoginLockout indicates an expected call of DeleteUserLoginLockout.
+func (mr *MockClientMockRecorder) DeleteUserLoginLockout(key, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserLoginLockout", reflect.TypeOf((*MockClient)(nil).DeleteUserLoginLockout), key, options)
+}
+
+// DeployRefToProduction mocks base method.
+func (m *MockClient) DeployRefToProduction(request v4.RequestDeployRefToProduction, options *rtl.ApiSettings) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployRefToProduction", request, options)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployRefToProduction indicates an expected call of DeployRefToProduction.
+func (mr *MockClientMockRecorder) DeployRefToProduction(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployRefToProduction", reflect.TypeOf((*MockClient)(nil).DeployRefToProduction), request, options)
+}
+
+// Folder mocks base method.
+func (m *MockClient) Folder(folderId, fields string, options *rtl.ApiSettings) (v4.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Folder", folderId, fields, options)
+	ret0, _ := ret[0].(v4.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Folder indicates an expected call of Folder.
+func (mr *MockClientMockRecorder) Folder(folderId, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Folder", reflect.TypeOf((*MockClient)(nil).Folder), folderId, fields, options)
+}
+
+// FolderChildren mocks base method.
+func (m *MockClient) FolderChildren(request v4.RequestFolderChildren, options *rtl.ApiSettings) ([]v4.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FolderChildren", request, options)
+	ret0, _ := ret[0].([]v4.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FolderChildren indicates an expected call of FolderChildren.
+func (mr *MockClientMockRecorder) FolderChildren(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FolderChildren", reflect.TypeOf((*MockClient)(nil).FolderChildren), request, options)
+}
+
+// FolderDashboards mocks base method.
+func (m *MockClient) FolderDashboards(folderId, fields string, options *rtl.ApiSettings) ([]v4.Dashboard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FolderDashboards", folderId, fields, options)
+	ret0, _ := ret[0].([]v4.Dashboard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FolderDashboards indicates an expected call of FolderDashboards.
+func (mr *MockClientMockRecorder) FolderDashboards(folderId, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FolderDashboards", reflect.TypeOf((*MockClient)(nil).FolderDashboards), folderId, fields, options)
+}
+
+// FolderLooks mocks base method.
+func (m *MockClient) FolderLooks(folderId, fields string, options *rtl.ApiSettings) ([]v4.LookWithQuery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FolderLooks", folderId, fields, options)
+	ret0, _ := ret[0].([]v4.LookWithQuery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FolderLooks indicates an expected call of FolderLooks.
+func (mr *MockClientMockRecorder) FolderLooks(folderId, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FolderLooks", reflect.TypeOf((*MockClient)(nil).FolderLooks), folderId, fields, options)
+}
+
+// GitDeployKey mocks base method.
+func (m *MockClient) GitDeployKey(projectId string, options *rtl.ApiSettings) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GitDeployKey", projectId, options)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GitDeployKey indicates an expected call of GitDeployKey.
+func (mr *MockClientMockRecorder) GitDeployKey(projectId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GitDeployKey", reflect.TypeOf((*MockClient)(nil).GitDeployKey), projectId, options)
+}
+
+// Group mocks base method.
+func (m *MockClient) Group(groupId, fields string, options *rtl.ApiSettings) (v4.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Group", groupId, fields, options)
+	ret0, _ := ret[0].(v4.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Group indicates an expected call of Group.
+func (mr *MockClientMockRecorder) Group(groupId, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Group", reflect.TypeOf((*MockClient)(nil).Group), groupId, fields, options)
+}
+
+// ImportDashboardFromLookml mocks base method.
+func (m *MockClient) ImportDashboardFromLookml(body v4.WriteDashboardLookml, options *rtl.ApiSettings) (v4.Dashboard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportDashboardFromLookml", body, options)
+	ret0, _ := ret[0].(v4.Dashboard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportDashboardFromLookml indicates an expected call of ImportDashboardFromLookml.
+func (mr *MockClientMockRecorder) ImportDashboardFromLookml(body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportDashboardFromLookml", reflect.TypeOf((*MockClient)(nil).ImportDashboardFromLookml), body, options)
+}
+
+// LoginUser mocks base method.
+func (m *MockClient) LoginUser(userId string, associative bool, options *rtl.ApiSettings) (v4.AccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginUser", userId, associative, options)
+	ret0, _ := ret[0].(v4.AccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginUser indicates an expected call of LoginUser.
+func (mr *MockClientMockRecorder) LoginUser(userId, associative, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginUser", reflect.TypeOf((*MockClient)(nil).LoginUser), userId, associative, options)
+}
+
+// Look mocks base method.
+func (m *MockClient) Look(lookId, fields string, options *rtl.ApiSettings) (v4.LookWithQuery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Look", lookId, fields, options)
+	ret0, _ := ret[0].(v4.LookWithQuery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Look indicates an expected call of Look.
+func (mr *MockClientMockRecorder) Look(lookId, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Look", reflect.TypeOf((*MockClient)(nil).Look), lookId, fields, options)
+}
+
+// Me mocks base method.
+func (m *MockClient) Me(fields string, options *rtl.ApiSettings) (v4.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Me", fields, options)
+	ret0, _ := ret[0].(v4.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Me indicates an expected call of Me.
+func (mr *MockClientMockRecorder) Me(fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Me", reflect.TypeOf((*MockClient)(nil).Me), fields, options)
+}
+
+// ModelSet mocks base method.
+func (m *MockClient) ModelSet(modelSetId, fields string, options *rtl.ApiSettings) (v4.ModelSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelSet", modelSetId, fields, options)
+	ret0, _ := ret[0].(v4.ModelSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelSet indicates an expected call of ModelSet.
+func (mr *MockClientMockRecorder) ModelSet(modelSetId, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelSet", reflect.TypeOf((*MockClient)(nil).ModelSet), modelSetId, fields, options)
+}
+
+// OidcConfig mocks base method.
+func (m *MockClient) OidcConfig(options *rtl.ApiSettings) (v4.OIDCConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OidcConfig", options)
+	ret0, _ := ret[0].(v4.OIDCConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OidcConfig indicates an expected call of OidcConfig.
+func (mr *MockClientMockRecorder) OidcConfig(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OidcConfig", reflect.TypeOf((*MockClient)(nil).OidcConfig), options)
+}
+
+// PermissionSet mocks base method.
+func (m *MockClient) PermissionSet(permissionSetId, fields string, options *rtl.ApiSettings) (v4.PermissionSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PermissionSet", permissionSetId, fields, options)
+	ret0, _ := ret[0].(v4.PermissionSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PermissionSet indicates an expected call of PermissionSet.
+func (mr *MockClientMockRecorder) PermissionSet(permissionSetId, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermissionSet", reflect.TypeOf((*MockClient)(nil).PermissionSet), permissionSetId, fields, options)
+}
+
+// Project mocks base method.
+func (m *MockClient) Project(projectId, fields string, options *rtl.ApiSettings) (v4.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Project", projectId, fields, options)
+	ret0, _ := ret[0].(v4.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Project indicates an expected call of Project.
+func (mr *MockClientMockRecorder) Project(projectId, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Project", reflect.TypeOf((*MockClient)(nil).Project), projectId, fields, options)
+}
+
+// Role mocks base method.
+func (m *MockClient) Role(roleId string, options *rtl.ApiSettings) (v4.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Role", roleId, options)
+	ret0, _ := ret[0].(v4.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Role indicates an expected call of Role.
+func (mr *MockClientMockRecorder) Role(roleId, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Role", reflect.TypeOf((*MockClient)(nil).Role), roleId, options)
+}
+
+// RoleGroups mocks base method.
+func (m *MockClient) RoleGroups(roleId, fields string, options *rtl.ApiSettings) ([]v4.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RoleGroups", roleId, fields, options)
+	ret0, _ := ret[0].([]v4.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RoleGroups indicates an expected call of RoleGroups.
+func (mr *MockClientMockRecorder) RoleGroups(roleId, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoleGroups", reflect.TypeOf((*MockClient)(nil).RoleGroups), roleId, fields, options)
+}
+
+// RoleUsers mocks base method.
+func (m *MockClient) RoleUsers(request v4.RequestRoleUsers, options *rtl.ApiSettings) ([]v4.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RoleUsers", request, options)
+	ret0, _ := ret[0].([]v4.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RoleUsers indicates an expected call of RoleUsers.
+func (mr *MockClientMockRecorder) RoleUsers(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoleUsers", reflect.TypeOf((*MockClient)(nil).RoleUsers), request, options)
+}
+
+// ScheduledPlanRunOnceById mocks base method.
+func (m *MockClient) ScheduledPlanRunOnceById(scheduledPlanId string, body v4.WriteScheduledPlan, options *rtl.ApiSettings) (v4.ScheduledPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduledPlanRunOnceById", scheduledPlanId, body, options)
+	ret0, _ := ret[0].(v4.ScheduledPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduledPlanRunOnceById indicates an expected call of ScheduledPlanRunOnceById.
+func (mr *MockClientMockRecorder) ScheduledPlanRunOnceById(scheduledPlanId, body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduledPlanRunOnceById", reflect.TypeOf((*MockClient)(nil).ScheduledPlanRunOnceById), scheduledPlanId, body, options)
+}
+
+// SearchAlerts mocks base method.
+func (m *MockClient) SearchAlerts(request v4.RequestSearchAlerts, options *rtl.ApiSettings) ([]v4.Alert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAlerts", request, options)
+	ret0, _ := ret[0].([]v4.Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAlerts indicates an expected call of SearchAlerts.
+func (mr *MockClientMockRecorder) SearchAlerts(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAlerts", reflect.TypeOf((*MockClient)(nil).SearchAlerts), request, options)
+}
+
+// SearchBoards mocks base method.
+func (m *MockClient) SearchBoards(request v4.RequestSearchBoards, options *rtl.ApiSettings) ([]v4.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchBoards", request, options)
+	ret0, _ := ret[0].([]v4.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchBoards indicates an expected call of SearchBoards.
+func (mr *MockClientMockRecorder) SearchBoards(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchBoards", reflect.TypeOf((*MockClient)(nil).SearchBoards), request, options)
+}
+
+// SearchDashboards mocks base method.
+func (m *MockClient) SearchDashboards(request v4.RequestSearchDashboards, options *rtl.ApiSettings) ([]v4.Dashboard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchDashboards", request, options)
+	ret0, _ := ret[0].([]v4.Dashboard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchDashboards indicates an expected call of SearchDashboards.
+func (mr *MockClientMockRecorder) SearchDashboards(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchDashboards", reflect.TypeOf((*MockClient)(nil).SearchDashboards), request, options)
+}
+
+// SearchFolders mocks base method.
+func (m *MockClient) SearchFolders(request v4.RequestSearchFolders, options *rtl.ApiSettings) ([]v4.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFolders", request, options)
+	ret0, _ := ret[0].([]v4.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchFolders indicates an expected call of SearchFolders.
+func (mr *MockClientMockRecorder) SearchFolders(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFolders", reflect.TypeOf((*MockClient)(nil).SearchFolders), request, options)
+}
+
+// SearchGroups mocks base method.
+func (m *MockClient) SearchGroups(request v4.RequestSearchGroups, options *rtl.ApiSettings) ([]v4.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchGroups", request, options)
+	ret0, _ := ret[0].([]v4.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchGroups indicates an expected call of SearchGroups.
+func (mr *MockClientMockRecorder) SearchGroups(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchGroups", reflect.TypeOf((*MockClient)(nil).SearchGroups), request, options)
+}
+
+// SearchGroupsWithRoles mocks base method.
+func (m *MockClient) SearchGroupsWithRoles(request v4.RequestSearchGroupsWithRoles, options *rtl.ApiSettings) ([]v4.GroupSearch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchGroupsWithRoles", request, options)
+	ret0, _ := ret[0].([]v4.GroupSearch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchGroupsWithRoles indicates an expected call of SearchGroupsWithRoles.
+func (mr *MockClientMockRecorder) SearchGroupsWithRoles(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchGroupsWithRoles", reflect.TypeOf((*MockClient)(nil).SearchGroupsWithRoles), request, options)
+}
+
+// SearchLooks mocks base method.
+func (m *MockClient) SearchLooks(request v4.RequestSearchLooks, options *rtl.ApiSettings) ([]v4.Look, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchLooks", request, options)
+	ret0, _ := ret[0].([]v4.Look)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchLooks indicates an expected call of SearchLooks.
+func (mr *MockClientMockRecorder) SearchLooks(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchLooks", reflect.TypeOf((*MockClient)(nil).SearchLooks), request, options)
+}
+
+// SearchModelSets mocks base method.
+func (m *MockClient) SearchModelSets(request v4.RequestSearchModelSets, options *rtl.ApiSettings) ([]v4.ModelSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchModelSets", request, options)
+	ret0, _ := ret[0].([]v4.ModelSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchModelSets indicates an expected call of SearchModelSets.
+func (mr *MockClientMockRecorder) SearchModelSets(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchModelSets", reflect.TypeOf((*MockClient)(nil).SearchModelSets), request, options)
+}
+
+// SearchPermissionSets mocks base method.
+func (m *MockClient) SearchPermissionSets(request v4.RequestSearchPermissionSets, options *rtl.ApiSettings) ([]v4.PermissionSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPermissionSets", request, options)
+	ret0, _ := ret[0].([]v4.PermissionSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPermissionSets indicates an expected call of SearchPermissionSets.
+func (mr *MockClientMockRecorder) SearchPermissionSets(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPermissionSets", reflect.TypeOf((*MockClient)(nil).SearchPermissionSets), request, options)
+}
+
+// SearchRoles mocks base method.
+func (m *MockClient) SearchRoles(request v4.RequestSearchRoles, options *rtl.ApiSettings) ([]v4.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchRoles", request, options)
+	ret0, _ := ret[0].([]v4.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchRoles indicates an expected call of SearchRoles.
+func (mr *MockClientMockRecorder) SearchRoles(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchRoles", reflect.TypeOf((*MockClient)(nil).SearchRoles), request, options)
+}
+
+// SearchUserLoginLockouts mocks base method.
+func (m *MockClient) SearchUserLoginLockouts(request v4.RequestSearchUserLoginLockouts, options *rtl.ApiSettings) ([]v4.UserLoginLockout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUserLoginLockouts", request, options)
+	ret0, _ := ret[0].([]v4.UserLoginLockout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUserLoginLockouts indicates an expected call of SearchUserLoginLockouts.
+func (mr *MockClientMockRecorder) SearchUserLoginLockouts(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUserLoginLockouts", reflect.TypeOf((*MockClient)(nil).SearchUserLoginLockouts), request, options)
+}
+
+// SearchUsers mocks base method.
+func (m *MockClient) SearchUsers(request v4.RequestSearchUsers, options *rtl.ApiSettings) ([]v4.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsers", request, options)
+	ret0, _ := ret[0].([]v4.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUsers indicates an expected call of SearchUsers.
+func (mr *MockClientMockRecorder) SearchUsers(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockClient)(nil).SearchUsers), request, options)
+}
+
+// SetRoleGroups mocks base method.
+func (m *MockClient) SetRoleGroups(roleId string, body []string, options *rtl.ApiSettings) ([]v4.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRoleGroups", roleId, body, options)
+	ret0, _ := ret[0].([]v4.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRoleGroups indicates an expected call of SetRoleGroups.
+func (mr *MockClientMockRecorder) SetRoleGroups(roleId, body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoleGroups", reflect.TypeOf((*MockClient)(nil).SetRoleGroups), roleId, body, options)
+}
+
+// SetRoleUsers mocks base method.
+func (m *MockClient) SetRoleUsers(roleId string, body []string, options *rtl.ApiSettings) ([]v4.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRoleUsers", roleId, body, options)
+	ret0, _ := ret[0].([]v4.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRoleUsers indicates an expected call of SetRoleUsers.
+func (mr *MockClientMockRecorder) SetRoleUsers(roleId, body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoleUsers", reflect.TypeOf((*MockClient)(nil).SetRoleUsers), roleId, body, options)
+}
+
+// SetUserAttributeUserValue mocks base method.
+func (m *MockClient) SetUserAttributeUserValue(userId, userAttributeId string, body v4.WriteUserAttributeWithValue, options *rtl.ApiSettings) (v4.UserAttributeWithValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserAttributeUserValue", userId, userAttributeId, body, options)
+	ret0, _ := ret[0].(v4.UserAttributeWithValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserAttributeUserValue indicates an expected call of SetUserAttributeUserValue.
+func (mr *MockClientMockRecorder) SetUserAttributeUserValue(userId, userAttributeId, body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserAttributeUserValue", reflect.TypeOf((*MockClient)(nil).SetUserAttributeUserValue), userId, userAttributeId, body, options)
+}
+
+// UpdateArtifacts mocks base method.
+func (m *MockClient) UpdateArtifacts(namespace string, body []v4.UpdateArtifact, fields string, options *rtl.ApiSettings) ([]v4.Artifact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateArtifacts", namespace, body, fields, options)
+	ret0, _ := ret[0].([]v4.Artifact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateArtifacts indicates an expected call of UpdateArtifacts.
+func (mr *MockClientMockRecorder) UpdateArtifacts(namespace, body, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArtifacts", reflect.TypeOf((*MockClient)(nil).UpdateArtifacts), namespace, body, fields, options)
+}
+
+// UpdateConnection mocks base method.
+func (m *MockClient) UpdateConnection(connectionName string, body v4.WriteDBConnection, options *rtl.ApiSettings) (v4.DBConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConnection", connectionName, body, options)
+	ret0, _ := ret[0].(v4.DBConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateConnection indicates an expected call of UpdateConnection.
+func (mr *MockClientMockRecorder) UpdateConnection(connectionName, body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConnection", reflect.TypeOf((*MockClient)(nil).UpdateConnection), connectionName, body, options)
+}
+
+// UpdateContentMetadata mocks base method.
+func (m *MockClient) UpdateContentMetadata(contentMetadataId string, body v4.WriteContentMeta, options *rtl.ApiSettings) (v4.ContentMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateContentMetadata", contentMetadataId, body, options)
+	ret0, _ := ret[0].(v4.ContentMeta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateContentMetadata indicates an expected call of UpdateContentMetadata.
+func (mr *MockClientMockRecorder) UpdateContentMetadata(contentMetadataId, body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContentMetadata", reflect.TypeOf((*MockClient)(nil).UpdateContentMetadata), contentMetadataId, body, options)
+}
+
+// UpdateContentMetadataAccess mocks base method.
+func (m *MockClient) UpdateContentMetadataAccess(contentMetadataAccessId string, body v4.ContentMetaGroupUser, options *rtl.ApiSettings) (v4.ContentMetaGroupUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateContentMetadataAccess", contentMetadataAccessId, body, options)
+	ret0, _ := ret[0].(v4.ContentMetaGroupUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateContentMetadataAccess indicates an expected call of UpdateContentMetadataAccess.
+func (mr *MockClientMockRecorder) UpdateContentMetadataAccess(contentMetadataAccessId, body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContentMetadataAccess", reflect.TypeOf((*MockClient)(nil).UpdateContentMetadataAccess), contentMetadataAccessId, body, options)
+}
+
+// UpdateDashboard mocks base method.
+func (m *MockClient) UpdateDashboard(dashboardId string, body v4.WriteDashboard, options *rtl.ApiSettings) (v4.Dashboard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDashboard", dashboardId, body, options)
+	ret0, _ := ret[0].(v4.Dashboard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDashboard indicates an expected call of UpdateDashboard.
+func (mr *MockClientMockRecorder) UpdateDashboard(dashboardId, body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDashboard", reflect.TypeOf((*MockClient)(nil).UpdateDashboard), dashboardId, body, options)
+}
+
+// UpdateDatagroup mocks base method.
+func (m *MockClient) UpdateDatagroup(datagroupId string, body v4.WriteDatagroup, options *rtl.ApiSettings) (v4.Datagroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDatagroup", datagroupId, body, options)
+	ret0, _ := ret[0].(v4.Datagroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDatagroup indicates an expected call of UpdateDatagroup.
+func (mr *MockClientMockRecorder) UpdateDatagroup(datagroupId, body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDatagroup", reflect.TypeOf((*MockClient)(nil).UpdateDatagroup), datagroupId, body, options)
+}
+
+// UpdateExternalOauthApplication mocks base method.
+func (m *MockClient) UpdateExternalOauthApplication(clientId string, body v4.WriteExternalOauthApplication, options *rtl.ApiSettings) (v4.ExternalOauthApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExternalOauthApplication", clientId, body, options)
+	ret0, _ := ret[0].(v4.ExternalOauthApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateExternalOauthApplication indicates an expected call of UpdateExternalOauthApplication.
+func (mr *MockClientMockRecorder) UpdateExternalOauthApplication(clientId, body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExternalOauthApplication", reflect.TypeOf((*MockClient)(nil).UpdateExternalOauthApplication), clientId, body, options)
+}
+
+// UpdateFolder mocks base method.
+func (m *MockClient) UpdateFolder(folderId string, body v4.UpdateFolder, options *rtl.ApiSettings) (v4.Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFolder", folderId, body, options)
+	ret0, _ := ret[0].(v4.Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFolder indicates an expected call of UpdateFolder.
+func (mr *MockClientMockRecorder) UpdateFolder(folderId, body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFolder", reflect.TypeOf((*MockClient)(nil).UpdateFolder), folderId, body, options)
+}
+
+// UpdateGroup mocks base method.
+func (m *MockClient) UpdateGroup(groupId string, body v4.WriteGroup, fields string, options *rtl.ApiSettings) (v4.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroup", groupId, body, fields, options)
+	ret0, _ := ret[0].(v4.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGroup indicates an expected call of UpdateGroup.
+func (mr *MockClientMockRecorder) UpdateGroup(groupId, body, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroup", reflect.TypeOf((*MockClient)(nil).UpdateGroup), groupId, body, fields, options)
+}
+
+// UpdateModelSet mocks base method.
+func (m *MockClient) UpdateModelSet(modelSetId string, body v4.WriteModelSet, options *rtl.ApiSettings) (v4.ModelSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateModelSet", modelSetId, body, options)
+	ret0, _ := ret[0].(v4.ModelSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateModelSet indicates an expected call of UpdateModelSet.
+func (mr *MockClientMockRecorder) UpdateModelSet(modelSetId, body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateModelSet", reflect.TypeOf((*MockClient)(nil).UpdateModelSet), modelSetId, body, options)
+}
+
+// UpdateOidcConfig mocks base method.
+func (m *MockClient) UpdateOidcConfig(body v4.WriteOIDCConfig, options *rtl.ApiSettings) (v4.OIDCConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOidcConfig", body, options)
+	ret0, _ := ret[0].(v4.OIDCConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOidcConfig indicates an expected call of UpdateOidcConfig.
+func (mr *MockClientMockRecorder) UpdateOidcConfig(body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOidcConfig", reflect.TypeOf((*MockClient)(nil).UpdateOidcConfig), body, options)
+}
+
+// UpdatePermissionSet mocks base method.
+func (m *MockClient) UpdatePermissionSet(permissionSetId string, body v4.WritePermissionSet, options *rtl.ApiSettings) (v4.PermissionSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePermissionSet", permissionSetId, body, options)
+	ret0, _ := ret[0].(v4.PermissionSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePermissionSet indicates an expected call of UpdatePermissionSet.
+func (mr *MockClientMockRecorder) UpdatePermissionSet(permissionSetId, body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePermissionSet", reflect.TypeOf((*MockClient)(nil).UpdatePermissionSet), permissionSetId, body, options)
+}
+
+// UpdateProject mocks base method.
+func (m *MockClient) UpdateProject(projectId string, body v4.WriteProject, fields string, options *rtl.ApiSettings) (v4.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", projectId, body, fields, options)
+	ret0, _ := ret[0].(v4.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProject indicates an expected call of UpdateProject.
+func (mr *MockClientMockRecorder) UpdateProject(projectId, body, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockClient)(nil).UpdateProject), projectId, body, fields, options)
+}
+
+// UpdateRole mocks base method.
+func (m *MockClient) UpdateRole(roleId string, body v4.WriteRole, options *rtl.ApiSettings) (v4.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", roleId, body, options)
+	ret0, _ := ret[0].(v4.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRole indicates an expected call of UpdateRole.
+func (mr *MockClientMockRecorder) UpdateRole(roleId, body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockClient)(nil).UpdateRole), roleId, body, options)
+}
+
+// UpdateSession mocks base method.
+func (m *MockClient) UpdateSession(body v4.WriteApiSession, options *rtl.ApiSettings) (v4.ApiSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSession", body, options)
+	ret0, _ := ret[0].(v4.ApiSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSession indicates an expected call of UpdateSession.
+func (mr *MockClientMockRecorder) UpdateSession(body, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSession", reflect.TypeOf((*MockClient)(nil).UpdateSession), body, options)
+}
+
+// UpdateUser mocks base method.
+func (m *MockClient) UpdateUser(userId string, body v4.WriteUser, fields string, options *rtl.ApiSettings) (v4.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", userId, body, fields, options)
+	ret0, _ := ret[0].(v4.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockClientMockRecorder) UpdateUser(userId, body, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockClient)(nil).UpdateUser), userId, body, fields, options)
+}
+
+// UpdateUserCredentialsEmail mocks base method.
+func (m *MockClient) UpdateUserCredentialsEmail(userId string, body v4.WriteCredentialsEmail, fields string, options *rtl.ApiSettings) (v4.CredentialsEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserCredentialsEmail", userId, body, fields, options)
+	ret0, _ := ret[0].(v4.CredentialsEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserCredentialsEmail indicates an expected call of UpdateUserCredentialsEmail.
+func (mr *MockClientMockRecorder) UpdateUserCredentialsEmail(userId, body, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserCredentialsEmail", reflect.TypeOf((*MockClient)(nil).UpdateUserCredentialsEmail), userId, body, fields, options)
+}
+
+// User mocks base method.
+func (m *MockClient) User(userId, fields string, options *rtl.ApiSettings) (v4.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "User", userId, fields, options)
+	ret0, _ := ret[0].(v4.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// User indicates an expected call of User.
+func (mr *MockClientMockRecorder) User(userId, fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "User", reflect.TypeOf((*MockClient)(nil).User), userId, fields, options)
+}
+
+// UserAttributeUserValues mocks base method.
+func (m *MockClient) UserAttributeUserValues(request v4.RequestUserAttributeUserValues, options *rtl.ApiSettings) ([]v4.UserAttributeWithValue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserAttributeUserValues", request, options)
+	ret0, _ := ret[0].([]v4.UserAttributeWithValue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserAttributeUserValues indicates an expected call of UserAttributeUserValues.
+func (mr *MockClientMockRecorder) UserAttributeUserValues(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserAttributeUserValues", reflect.TypeOf((*MockClient)(nil).UserAttributeUserValues), request, options)
+}
+
+// UserRoles mocks base method.
+func (m *MockClient) UserRoles(request v4.RequestUserRoles, options *rtl.ApiSettings) ([]v4.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserRoles", request, options)
+	ret0, _ := ret[0].([]v4.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserRoles indicates an expected call of UserRoles.
+func (mr *MockClientMockRecorder) UserRoles(request, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserRoles", reflect.TypeOf((*MockClient)(nil).UserRoles), request, options)
+}
+
+// Versions mocks base method.
+func (m *MockClient) Versions(fields string, options *rtl.ApiSettings) (v4.ApiVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Versions", fields, options)
+	ret0, _ := ret[0].(v4.ApiVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Versions indicates an expected call of Versions.
+func (mr *MockClientMockRecorder) Versions(fields, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Versions", reflect.TypeOf((*MockClient)(nil).Versions), fields, options)
+}
+
+// MockStreamer is a mock of Streamer interface.
+type MockStreamer struct {
+	ctrl     *gomock.Controller
+	recorder *MockStreamerMockRecorder
+	isgomock struct{}
+}
+
+// MockStreamerMockRecorder is the mock recorder for MockStreamer.
+type MockStreamerMockRecorder struct {
+	mock *MockStreamer
+}
+
+// NewMockStreamer creates a new mock instance.
+func NewMockStreamer(ctrl *gomock.Controller) *MockStreamer {
+	mock := &MockStreamer{ctrl: ctrl}
+	mock.recorder = &MockStreamerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStreamer) EXPECT() *MockStreamerMockRecorder {
+	return m.recorder
+}
+
+// Stream mocks base method.
+func (m *MockStreamer) Stream(ctx context.Context, apiPath string, query url.Values) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", ctx, apiPath, query)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockStreamerMockRecorder) Stream(ctx, apiPath, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockStreamer)(nil).Stream), ctx, apiPath, query)
+}
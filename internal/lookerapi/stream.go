@@ -0,0 +1,82 @@
+package lookerapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+)
+
+// Streamer sends GET requests whose JSON responses the caller decodes while
+// reading them. The SDK reads the whole response before decoding it, which on
+// very large instances holds a listing in memory twice.
+type Streamer interface {
+	// Stream returns the body of a successful GET of apiPath, e.g. "/users",
+	// with query. The caller closes it. A failed request returns an error
+	// worded like those of the SDK.
+	Stream(ctx context.Context, apiPath string, query url.Values) (io.ReadCloser, error)
+}
+
+// NewStreamer returns a Streamer sending its requests through session. The
+// session's client signs them, logging in again when needed, and tags them
+// like every other API call.
+func NewStreamer(session *rtl.AuthSession) Streamer {
+	return &sessionStreamer{session: session}
+}
+
+// sessionStreamer is the Streamer of an SDK session.
+type sessionStreamer struct {
+	session *rtl.AuthSession
+}
+
+// Stream implements Streamer. The response is transferred gzip-compressed,
+// net/http asks for it and decompresses it transparently.
+func (s *sessionStreamer) Stream(ctx context.Context, apiPath string, query url.Values) (io.ReadCloser, error) {
+	settings := s.session.Config
+	cancel := context.CancelFunc(func() {})
+	if settings.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(settings.Timeout)*time.Second)
+	}
+
+	endpoint := settings.BaseUrl + "/api/" + settings.ApiVersion + apiPath
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp, err := s.session.Client.Do(req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// Same wording as the SDK, so that callers recognize e.g. a 404.
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+		resp.Body.Close()
+		cancel()
+		return nil, fmt.Errorf("response error. status=%s. error=%s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}, nil
+}
+
+// cancelOnClose ends the timeout of a streamed response once it is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close implements io.Closer.
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
@@ -0,0 +1,52 @@
+package lookerapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+)
+
+func TestSessionStreamer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("fields") != "id" {
+			t.Errorf("fields = %q, want id", r.URL.Query().Get("fields"))
+		}
+		switch r.URL.Path {
+		case "/api/4.0/users":
+			_, _ = io.WriteString(w, `[{"id":"1"}]`)
+		default:
+			http.Error(w, `{"message":"Not found"}`, http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	session := &rtl.AuthSession{Config: rtl.ApiSettings{BaseUrl: server.URL, ApiVersion: "4.0", Timeout: 30}, Client: *server.Client()}
+	streamer := NewStreamer(session)
+	query := url.Values{"fields": {"id"}}
+
+	body, err := streamer.Stream(context.Background(), "/users", query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := body.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != `[{"id":"1"}]` {
+		t.Errorf("body = %s", got)
+	}
+
+	_, err = streamer.Stream(context.Background(), "/missing", query)
+	if err == nil || !strings.Contains(err.Error(), "status=404") || !strings.Contains(err.Error(), "Not found") {
+		t.Errorf("error = %v, want the SDK's wording of a 404", err)
+	}
+}
@@ -0,0 +1,685 @@
+// Package lookertest provides an in-memory emulation of the Looker API 4.0
+// endpoints used by the provider's group, role, permission set, model set,
+// folder and folder access resources, so that they can be exercised without a
+// Looker instance.
+//
+// Point the provider at a Server through the environment:
+//
+//	srv := lookertest.NewServer()
+//	defer srv.Close()
+//	os.Setenv("LOOKER_BASE_URL", srv.URL)
+//	os.Setenv("LOOKER_CLIENT_ID", lookertest.ClientID)
+//	os.Setenv("LOOKER_CLIENT_SECRET", lookertest.ClientSecret)
+//
+// Seed stores a few fixtures, such as a group, a role and a folder, for tests
+// reading existing objects.
+//
+// The emulation is deliberately shallow: objects are stored as the JSON sent
+// by the client, `fields` is ignored and searches compare the given
+// parameters case-insensitively, with `%` as wildcard.
+package lookertest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Credentials accepted by the login endpoint.
+const (
+	ClientID     = "lookertest-client-id"
+	ClientSecret = "lookertest-client-secret"
+)
+
+// tokenLifetime is the expires_in reported for access tokens, in seconds.
+const tokenLifetime = 3600
+
+// Object is a stored API object, as decoded from JSON.
+type Object map[string]any
+
+// Server is an httptest.Server emulating a Looker instance. Its zero value is
+// not usable; create it with NewServer.
+type Server struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	nextID  int
+	me      string
+	tokens  map[string]bool
+	objects map[string]map[string]Object
+
+	groupUsers map[string]map[string]bool
+	roleGroups map[string][]string
+}
+
+// Collections of stored objects, named after their API path.
+const (
+	Users                 = "users"
+	Groups                = "groups"
+	Roles                 = "roles"
+	PermissionSets        = "permission_sets"
+	ModelSets             = "model_sets"
+	LookmlModels          = "lookml_models"
+	Folders               = "folders"
+	ContentMetadata       = "content_metadata"
+	ContentMetadataAccess = "content_metadata_access"
+)
+
+// SharedFolderID is the ID of the Shared folder, the root of the folder tree.
+const SharedFolderID = "1"
+
+// MeEmail is the email of the API user returned by GET /user.
+const MeEmail = "lookertest@example.com"
+
+const apiPrefix = "/api/4.0"
+
+// NewServer starts a Server holding the Shared folder, the API user and no
+// other objects. Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		tokens:     make(map[string]bool),
+		objects:    make(map[string]map[string]Object),
+		groupUsers: make(map[string]map[string]bool),
+		roleGroups: make(map[string][]string),
+	}
+	for _, collection := range []string{Users, Groups, Roles, PermissionSets, ModelSets, LookmlModels, Folders, ContentMetadata, ContentMetadataAccess} {
+		s.objects[collection] = make(map[string]Object)
+	}
+	s.insertFolder(Object{"name": "Shared"})
+	s.me = s.AddUser(MeEmail)
+
+	mux := http.NewServeMux()
+	s.routes(mux)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// AddUser stores a user with email and returns its ID.
+func (s *Server) AddUser(email string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.insert(Users, Object{"email": email, "is_disabled": false})
+}
+
+// AddModel stores a LookML model named name.
+func (s *Server) AddModel(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[LookmlModels][name] = Object{"name": name, "label": name}
+}
+
+// Get returns a copy of the object with id in collection.
+func (s *Server) Get(collection, id string) (Object, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	object, ok := s.objects[collection][id]
+	return clone(object), ok
+}
+
+// Count returns the number of objects in collection.
+func (s *Server) Count(collection string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.objects[collection])
+}
+
+// GroupUsers returns the sorted IDs of the users of group id.
+func (s *Server) GroupUsers(id string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return sortedKeys(s.groupUsers[id])
+}
+
+// Fixtures are the IDs of the objects stored by Seed.
+type Fixtures struct {
+	UserID          string
+	GroupID         string
+	PermissionSetID string
+	ModelSetID      string
+	RoleID          string
+	FolderID        string
+}
+
+// Names of the objects stored by Seed.
+const (
+	FixtureUserEmail     = "analyst@example.com"
+	FixtureGroupName     = "Analysts"
+	FixtureModelName     = "sales"
+	FixturePermissionSet = "Analyst permissions"
+	FixtureModelSet      = "Analyst models"
+	FixtureRoleName      = "Analyst"
+	FixtureFolderName    = "Analytics"
+)
+
+// Seed stores the objects of a typical instance, for tests that read
+// existing objects rather than create them: a user in a group, a role
+// assigned to the group and a folder under Shared that the group can view.
+func (s *Server) Seed() Fixtures {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var f Fixtures
+	f.UserID = s.insert(Users, Object{"email": FixtureUserEmail, "is_disabled": false})
+	f.GroupID = s.insert(Groups, Object{"name": FixtureGroupName})
+	s.groupUsers[f.GroupID] = map[string]bool{f.UserID: true}
+
+	s.objects[LookmlModels][FixtureModelName] = Object{"name": FixtureModelName, "label": FixtureModelName}
+	f.PermissionSetID = s.insert(PermissionSets, Object{"name": FixturePermissionSet, "permissions": []any{"access_data", "see_looks", "see_user_dashboards"}})
+	f.ModelSetID = s.insert(ModelSets, Object{"name": FixtureModelSet, "models": []any{FixtureModelName}})
+	f.RoleID = s.insert(Roles, Object{"name": FixtureRoleName, "permission_set_id": f.PermissionSetID, "model_set_id": f.ModelSetID})
+	s.roleGroups[f.RoleID] = []string{f.GroupID}
+
+	f.FolderID = s.insertFolder(Object{"name": FixtureFolderName, "parent_id": SharedFolderID})
+	metadata, _ := s.metadataOf(f.FolderID)
+	metadata["inherits"] = false
+	s.insert(ContentMetadataAccess, Object{"content_metadata_id": metadata["id"], "group_id": f.GroupID, "permission_type": "view"})
+	return f
+}
+
+// ExpireTokens invalidates every access token, so that the next request of
+// each client is rejected with 401 as after a revoked session.
+func (s *Server) ExpireTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens = make(map[string]bool)
+}
+
+func (s *Server) routes(mux *http.ServeMux) {
+	handle := func(pattern string, handler func(r *http.Request) (any, int)) {
+		method, path, _ := strings.Cut(pattern, " ")
+		mux.HandleFunc(method+" "+apiPrefix+path, func(w http.ResponseWriter, r *http.Request) {
+			if !s.authorized(r) {
+				writeJSON(w, http.StatusUnauthorized, apiError("Requires authentication."))
+				return
+			}
+			s.mu.Lock()
+			body, status := handler(r)
+			s.mu.Unlock()
+			writeJSON(w, status, body)
+		})
+	}
+
+	mux.HandleFunc("POST "+apiPrefix+"/login", s.login)
+	handle("GET /user", func(*http.Request) (any, int) { return s.objects[Users][s.me], http.StatusOK })
+	handle("GET /users", s.list(Users))
+	handle("GET /users/search", s.search(Users))
+	handle("GET /users/{id}", s.get(Users))
+
+	handle("POST /groups", s.create(Groups, "name"))
+	handle("GET /groups/search", s.search(Groups))
+	handle("GET /groups/{id}", s.get(Groups))
+	handle("PATCH /groups/{id}", s.update(Groups))
+	handle("DELETE /groups/{id}", s.delete(Groups))
+	handle("GET /groups/{id}/users", s.listGroupUsers)
+	handle("POST /groups/{id}/users", s.addGroupUser)
+	handle("DELETE /groups/{id}/users/{user_id}", s.deleteGroupUser)
+
+	handle("POST /permission_sets", s.create(PermissionSets, "name"))
+	handle("GET /permission_sets/search", s.search(PermissionSets))
+	handle("GET /permission_sets/{id}", s.get(PermissionSets))
+	handle("PATCH /permission_sets/{id}", s.update(PermissionSets))
+	handle("DELETE /permission_sets/{id}", s.delete(PermissionSets))
+
+	handle("POST /model_sets", s.create(ModelSets, "name"))
+	handle("GET /model_sets/search", s.search(ModelSets))
+	handle("GET /model_sets/{id}", s.get(ModelSets))
+	handle("PATCH /model_sets/{id}", s.update(ModelSets))
+	handle("DELETE /model_sets/{id}", s.delete(ModelSets))
+	handle("GET /lookml_models", s.list(LookmlModels))
+
+	handle("POST /roles", s.create(Roles, "name"))
+	handle("GET /roles/search", s.search(Roles))
+	handle("GET /roles/{id}", s.get(Roles))
+	handle("PATCH /roles/{id}", s.update(Roles))
+	handle("DELETE /roles/{id}", s.delete(Roles))
+	handle("GET /roles/{id}/groups", s.listRoleGroups)
+	handle("PUT /roles/{id}/groups", s.setRoleGroups)
+
+	handle("POST /folders", s.createFolder)
+	handle("GET /folders/search", s.search(Folders))
+	handle("GET /folders/{id}", s.get(Folders))
+	handle("PATCH /folders/{id}", s.update(Folders))
+	handle("DELETE /folders/{id}", s.deleteFolder)
+	handle("GET /folders/{id}/children", s.folderChildren)
+	handle("GET /folders/{id}/dashboards", s.empty(Folders))
+	handle("GET /folders/{id}/looks", s.empty(Folders))
+
+	handle("GET /content_metadata/{id}", s.get(ContentMetadata))
+	handle("PATCH /content_metadata/{id}", s.update(ContentMetadata))
+	handle("GET /content_metadata_access", s.search(ContentMetadataAccess))
+	handle("POST /content_metadata_access", s.create(ContentMetadataAccess, "content_metadata_id"))
+	handle("PUT /content_metadata_access/{id}", s.update(ContentMetadataAccess))
+	handle("DELETE /content_metadata_access/{id}", s.delete(ContentMetadataAccess))
+}
+
+// login implements POST /login with client credentials sent as form values.
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+	if r.FormValue("client_id") != ClientID || r.FormValue("client_secret") != ClientSecret {
+		writeJSON(w, http.StatusNotFound, apiError("Not found"))
+		return
+	}
+	s.mu.Lock()
+	s.nextID++
+	token := fmt.Sprintf("lookertest-token-%d", s.nextID)
+	s.tokens[token] = true
+	s.mu.Unlock()
+	writeJSON(w, http.StatusOK, Object{"access_token": token, "token_type": "Bearer", "expires_in": tokenLifetime})
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		token, ok = strings.CutPrefix(r.Header.Get("Authorization"), "token ")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return ok && s.tokens[token]
+}
+
+// insert stores object under a new ID and returns the ID. s.mu must be held.
+func (s *Server) insert(collection string, object Object) string {
+	s.nextID++
+	id := strconv.Itoa(s.nextID)
+	object["id"] = id
+	s.objects[collection][id] = object
+	return id
+}
+
+// insertFolder stores a folder with its content metadata and returns its ID.
+// s.mu must be held unless the server is not started yet.
+func (s *Server) insertFolder(folder Object) string {
+	id := s.insert(Folders, folder)
+	metadataID := s.insert(ContentMetadata, Object{"folder_id": id, "name": folder["name"], "inherits": folder["parent_id"] != nil})
+	folder["content_metadata_id"] = metadataID
+	if parent, ok := folder["parent_id"].(string); ok {
+		if metadata, ok := s.metadataOf(parent); ok {
+			s.objects[ContentMetadata][metadataID]["parent_id"] = metadata["id"]
+		}
+	}
+	return id
+}
+
+func (s *Server) metadataOf(folderID string) (Object, bool) {
+	folder, ok := s.objects[Folders][folderID]
+	if !ok {
+		return nil, false
+	}
+	metadata, ok := s.objects[ContentMetadata][fmt.Sprint(folder["content_metadata_id"])]
+	return metadata, ok
+}
+
+func (s *Server) create(collection, required string) func(r *http.Request) (any, int) {
+	return func(r *http.Request) (any, int) {
+		var object Object
+		if err := json.NewDecoder(r.Body).Decode(&object); err != nil {
+			return apiError("Invalid JSON: " + err.Error()), http.StatusBadRequest
+		}
+		if value, ok := object[required].(string); !ok || value == "" {
+			return validationError(required, "missing_field"), http.StatusUnprocessableEntity
+		}
+		if required == "name" && s.nameTaken(collection, object["name"], "") {
+			return validationError("name", "already_exists"), http.StatusUnprocessableEntity
+		}
+		s.insert(collection, object)
+		return s.expand(collection, object), http.StatusOK
+	}
+}
+
+func (s *Server) get(collection string) func(r *http.Request) (any, int) {
+	return func(r *http.Request) (any, int) {
+		object, ok := s.objects[collection][r.PathValue("id")]
+		if !ok {
+			return apiError("Not found"), http.StatusNotFound
+		}
+		return s.expand(collection, object), http.StatusOK
+	}
+}
+
+func (s *Server) update(collection string) func(r *http.Request) (any, int) {
+	return func(r *http.Request) (any, int) {
+		id := r.PathValue("id")
+		object, ok := s.objects[collection][id]
+		if !ok {
+			return apiError("Not found"), http.StatusNotFound
+		}
+		var changes Object
+		if err := json.NewDecoder(r.Body).Decode(&changes); err != nil {
+			return apiError("Invalid JSON: " + err.Error()), http.StatusBadRequest
+		}
+		if name, ok := changes["name"]; ok && s.nameTaken(collection, name, id) {
+			return validationError("name", "already_exists"), http.StatusUnprocessableEntity
+		}
+		for key, value := range changes {
+			if key != "id" {
+				object[key] = value
+			}
+		}
+		return s.expand(collection, object), http.StatusOK
+	}
+}
+
+func (s *Server) delete(collection string) func(r *http.Request) (any, int) {
+	return func(r *http.Request) (any, int) {
+		id := r.PathValue("id")
+		if _, ok := s.objects[collection][id]; !ok {
+			return apiError("Not found"), http.StatusNotFound
+		}
+		delete(s.objects[collection], id)
+		delete(s.groupUsers, id)
+		delete(s.roleGroups, id)
+		return "", http.StatusNoContent
+	}
+}
+
+func (s *Server) list(collection string) func(r *http.Request) (any, int) {
+	return func(*http.Request) (any, int) {
+		return s.sorted(collection, func(Object) bool { return true }), http.StatusOK
+	}
+}
+
+func (s *Server) empty(collection string) func(r *http.Request) (any, int) {
+	return func(r *http.Request) (any, int) {
+		if _, ok := s.objects[collection][r.PathValue("id")]; !ok {
+			return apiError("Not found"), http.StatusNotFound
+		}
+		return []Object{}, http.StatusOK
+	}
+}
+
+// search returns the objects of collection matching every query parameter
+// that is not a paging or formatting option.
+func (s *Server) search(collection string) func(r *http.Request) (any, int) {
+	return func(r *http.Request) (any, int) {
+		query := r.URL.Query()
+		var filters []func(Object) bool
+		for key, values := range query {
+			switch key {
+			case "fields", "sorts", "limit", "offset", "page", "per_page", "filter_or":
+				continue
+			}
+			pattern := wildcard(values[0])
+			filters = append(filters, func(object Object) bool {
+				value, ok := object[key]
+				return ok && value != nil && pattern.MatchString(fmt.Sprint(value))
+			})
+		}
+		results := s.sorted(collection, func(object Object) bool {
+			for _, filter := range filters {
+				if !filter(object) {
+					return false
+				}
+			}
+			return true
+		})
+		for i, object := range results {
+			results[i] = s.expand(collection, object)
+		}
+		return page(results, query.Get("limit"), query.Get("offset")), http.StatusOK
+	}
+}
+
+func (s *Server) listGroupUsers(r *http.Request) (any, int) {
+	id := r.PathValue("id")
+	if _, ok := s.objects[Groups][id]; !ok {
+		return apiError("Not found"), http.StatusNotFound
+	}
+	users := []Object{}
+	for _, userID := range sortedKeys(s.groupUsers[id]) {
+		if user, ok := s.objects[Users][userID]; ok {
+			users = append(users, user)
+		}
+	}
+	query := r.URL.Query()
+	return page(users, query.Get("limit"), query.Get("offset")), http.StatusOK
+}
+
+func (s *Server) addGroupUser(r *http.Request) (any, int) {
+	id := r.PathValue("id")
+	if _, ok := s.objects[Groups][id]; !ok {
+		return apiError("Not found"), http.StatusNotFound
+	}
+	var body struct {
+		UserID string `json:"user_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return apiError("Invalid JSON: " + err.Error()), http.StatusBadRequest
+	}
+	user, ok := s.objects[Users][body.UserID]
+	if !ok {
+		return apiError("Not found"), http.StatusNotFound
+	}
+	if s.groupUsers[id] == nil {
+		s.groupUsers[id] = make(map[string]bool)
+	}
+	s.groupUsers[id][body.UserID] = true
+	return user, http.StatusOK
+}
+
+func (s *Server) deleteGroupUser(r *http.Request) (any, int) {
+	id, userID := r.PathValue("id"), r.PathValue("user_id")
+	if !s.groupUsers[id][userID] {
+		return apiError("Not found"), http.StatusNotFound
+	}
+	delete(s.groupUsers[id], userID)
+	return "", http.StatusNoContent
+}
+
+func (s *Server) listRoleGroups(r *http.Request) (any, int) {
+	id := r.PathValue("id")
+	if _, ok := s.objects[Roles][id]; !ok {
+		return apiError("Not found"), http.StatusNotFound
+	}
+	groups := []Object{}
+	for _, groupID := range s.roleGroups[id] {
+		if group, ok := s.objects[Groups][groupID]; ok {
+			groups = append(groups, s.expand(Groups, group))
+		}
+	}
+	return groups, http.StatusOK
+}
+
+func (s *Server) setRoleGroups(r *http.Request) (any, int) {
+	id := r.PathValue("id")
+	if _, ok := s.objects[Roles][id]; !ok {
+		return apiError("Not found"), http.StatusNotFound
+	}
+	var groupIDs []string
+	if err := json.NewDecoder(r.Body).Decode(&groupIDs); err != nil {
+		return apiError("Invalid JSON: " + err.Error()), http.StatusBadRequest
+	}
+	for _, groupID := range groupIDs {
+		if _, ok := s.objects[Groups][groupID]; !ok {
+			return validationError("group_ids", "not_found"), http.StatusUnprocessableEntity
+		}
+	}
+	s.roleGroups[id] = groupIDs
+	return s.listRoleGroups(r)
+}
+
+func (s *Server) createFolder(r *http.Request) (any, int) {
+	var folder Object
+	if err := json.NewDecoder(r.Body).Decode(&folder); err != nil {
+		return apiError("Invalid JSON: " + err.Error()), http.StatusBadRequest
+	}
+	name, _ := folder["name"].(string)
+	parentID, _ := folder["parent_id"].(string)
+	if name == "" {
+		return validationError("name", "missing_field"), http.StatusUnprocessableEntity
+	}
+	if _, ok := s.objects[Folders][parentID]; !ok {
+		return validationError("parent_id", "not_found"), http.StatusUnprocessableEntity
+	}
+	for _, sibling := range s.objects[Folders] {
+		if sibling["parent_id"] == parentID && sibling["name"] == name {
+			return validationError("name", "already_exists"), http.StatusUnprocessableEntity
+		}
+	}
+	s.insertFolder(folder)
+	return folder, http.StatusOK
+}
+
+func (s *Server) deleteFolder(r *http.Request) (any, int) {
+	id := r.PathValue("id")
+	if id == SharedFolderID {
+		return apiError("The Shared folder cannot be deleted."), http.StatusUnprocessableEntity
+	}
+	if _, ok := s.objects[Folders][id]; !ok {
+		return apiError("Not found"), http.StatusNotFound
+	}
+	s.removeFolder(id)
+	return "", http.StatusNoContent
+}
+
+// removeFolder deletes a folder with its subfolders, content metadata and
+// access grants, like Looker does.
+func (s *Server) removeFolder(id string) {
+	for _, child := range s.sorted(Folders, func(folder Object) bool { return folder["parent_id"] == id }) {
+		s.removeFolder(fmt.Sprint(child["id"]))
+	}
+	if metadata, ok := s.metadataOf(id); ok {
+		for accessID, access := range s.objects[ContentMetadataAccess] {
+			if access["content_metadata_id"] == metadata["id"] {
+				delete(s.objects[ContentMetadataAccess], accessID)
+			}
+		}
+		delete(s.objects[ContentMetadata], fmt.Sprint(metadata["id"]))
+	}
+	delete(s.objects[Folders], id)
+}
+
+func (s *Server) folderChildren(r *http.Request) (any, int) {
+	id := r.PathValue("id")
+	if _, ok := s.objects[Folders][id]; !ok {
+		return apiError("Not found"), http.StatusNotFound
+	}
+	children := s.sorted(Folders, func(folder Object) bool { return folder["parent_id"] == id })
+	query := r.URL.Query()
+	return page(children, query.Get("limit"), query.Get("offset")), http.StatusOK
+}
+
+// expand returns object as the API returns it, with computed attributes and
+// referenced objects filled in.
+func (s *Server) expand(collection string, object Object) Object {
+	expanded := clone(object)
+	switch collection {
+	case Groups:
+		expanded["user_count"] = len(s.groupUsers[fmt.Sprint(object["id"])])
+		expanded["externally_managed"] = false
+	case Roles:
+		if set, ok := s.objects[PermissionSets][fmt.Sprint(object["permission_set_id"])]; ok {
+			expanded["permission_set"] = clone(set)
+		}
+		if set, ok := s.objects[ModelSets][fmt.Sprint(object["model_set_id"])]; ok {
+			expanded["model_set"] = clone(set)
+		}
+	case PermissionSets, ModelSets:
+		expanded["built_in"] = false
+		expanded["all_access"] = false
+	}
+	return expanded
+}
+
+// nameTaken reports whether another object of collection than id is named
+// name.
+func (s *Server) nameTaken(collection string, name any, id string) bool {
+	for otherID, other := range s.objects[collection] {
+		if otherID != id && strings.EqualFold(fmt.Sprint(other["name"]), fmt.Sprint(name)) {
+			return true
+		}
+	}
+	return false
+}
+
+// sorted returns the objects of collection accepted by keep, in ID order.
+func (s *Server) sorted(collection string, keep func(Object) bool) []Object {
+	results := []Object{}
+	for _, object := range s.objects[collection] {
+		if keep(object) {
+			results = append(results, object)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		a, _ := strconv.Atoi(fmt.Sprint(results[i]["id"]))
+		b, _ := strconv.Atoi(fmt.Sprint(results[j]["id"]))
+		return a < b
+	})
+	return results
+}
+
+// page applies the limit and offset query parameters to results.
+func page(results []Object, limit, offset string) []Object {
+	if n, err := strconv.Atoi(offset); err == nil && n > 0 {
+		results = results[min(n, len(results)):]
+	}
+	if n, err := strconv.Atoi(limit); err == nil && n >= 0 {
+		results = results[:min(n, len(results))]
+	}
+	return results
+}
+
+// wildcard compiles a Looker search value, where % matches any text and _ a
+// single character, into a case-insensitive regexp.
+func wildcard(value string) *regexp.Regexp {
+	var pattern strings.Builder
+	pattern.WriteString("(?i)^")
+	for _, r := range value {
+		switch r {
+		case '%':
+			pattern.WriteString(".*")
+		case '_':
+			pattern.WriteString(".")
+		default:
+			pattern.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	pattern.WriteString("$")
+	return regexp.MustCompile(pattern.String())
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func clone(object Object) Object {
+	if object == nil {
+		return nil
+	}
+	copied := make(Object, len(object))
+	for key, value := range object {
+		copied[key] = value
+	}
+	return copied
+}
+
+func apiError(message string) Object {
+	return Object{"message": message, "documentation_url": "https://cloud.google.com/looker/docs/"}
+}
+
+func validationError(field, code string) Object {
+	return Object{
+		"message":           "Validation Failed",
+		"errors":            []Object{{"field": field, "code": code, "message": field + " " + strings.ReplaceAll(code, "_", " ")}},
+		"documentation_url": "https://cloud.google.com/looker/docs/",
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	if status == http.StatusNoContent {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
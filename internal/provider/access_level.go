@@ -0,0 +1,38 @@
+package provider
+
+import (
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// accessLevels maps every accepted `access_level` value to the Looker
+// permission type it grants. Looker folders only know "view" and "edit"; the
+// descriptive names mirror the labels of the folder sharing dialog.
+var accessLevels = map[string]v4.PermissionType{
+	"view":               v4.PermissionType_View,
+	"edit":               v4.PermissionType_Edit,
+	"edit_content":       v4.PermissionType_Edit,
+	"manage_access_edit": v4.PermissionType_Edit,
+}
+
+// accessLevelValues lists the accepted `access_level` values for validators.
+var accessLevelValues = []string{"view", "edit", "edit_content", "manage_access_edit"}
+
+// accessLevelDescription documents the accepted `access_level` values.
+const accessLevelDescription = "Valid values are: `view` (View), `edit_content` or `manage_access_edit` (Manage Access, Edit). `edit` is accepted as a synonym of `manage_access_edit`."
+
+// permissionTypeFor returns the Looker permission type for an access level.
+func permissionTypeFor(accessLevel string) v4.PermissionType {
+	if permissionType, ok := accessLevels[accessLevel]; ok {
+		return permissionType
+	}
+	return v4.PermissionType(accessLevel)
+}
+
+// accessLevelFor returns the access level to store for a permission type read
+// from the API, keeping the configured spelling when it grants the same type.
+func accessLevelFor(permissionType v4.PermissionType, configured string) string {
+	if configured != "" && permissionTypeFor(configured) == permissionType {
+		return configured
+	}
+	return string(permissionType)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestPermissionTypeFor(t *testing.T) {
+	tests := map[string]v4.PermissionType{
+		"view":               v4.PermissionType_View,
+		"edit":               v4.PermissionType_Edit,
+		"edit_content":       v4.PermissionType_Edit,
+		"manage_access_edit": v4.PermissionType_Edit,
+		// Unknown values are passed through for Looker to reject.
+		"owner": v4.PermissionType("owner"),
+	}
+	for accessLevel, want := range tests {
+		if got := permissionTypeFor(accessLevel); got != want {
+			t.Errorf("permissionTypeFor(%q) = %q, want %q", accessLevel, got, want)
+		}
+	}
+	for _, accessLevel := range accessLevelValues {
+		if _, ok := accessLevels[accessLevel]; !ok {
+			t.Errorf("accepted access level %q has no permission type", accessLevel)
+		}
+	}
+}
+
+func TestAccessLevelFor(t *testing.T) {
+	tests := []struct {
+		permissionType v4.PermissionType
+		configured     string
+		want           string
+	}{
+		{v4.PermissionType_View, "", "view"},
+		{v4.PermissionType_Edit, "", "edit"},
+		{v4.PermissionType_View, "view", "view"},
+		{v4.PermissionType_Edit, "edit_content", "edit_content"},
+		{v4.PermissionType_Edit, "manage_access_edit", "manage_access_edit"},
+		// A grant changed outside Terraform shows up as a diff.
+		{v4.PermissionType_View, "manage_access_edit", "view"},
+		{v4.PermissionType_Edit, "view", "edit"},
+	}
+	for _, test := range tests {
+		if got := accessLevelFor(test.permissionType, test.configured); got != test.want {
+			t.Errorf("accessLevelFor(%q, %q) = %q, want %q", test.permissionType, test.configured, got, test.want)
+		}
+	}
+}
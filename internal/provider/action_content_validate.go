@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// contentValidationReportLimit caps how many broken pieces of content are
+// listed in the diagnostic of a content validation run.
+const contentValidationReportLimit = 20
+
+var (
+	_ action.Action              = &contentValidateAction{}
+	_ action.ActionWithConfigure = &contentValidateAction{}
+)
+
+// contentValidateAction is the action implementation.
+type contentValidateAction struct {
+	baseAction
+}
+
+// contentValidateActionModel maps the action schema data.
+type contentValidateActionModel struct {
+	ProjectNames []string   `tfsdk:"project_names"`
+	FolderIDs    []string   `tfsdk:"folder_ids"`
+	FailOnErrors types.Bool `tfsdk:"fail_on_errors"`
+}
+
+// NewContentValidateAction is a helper function to simplify the provider implementation.
+func NewContentValidateAction() action.Action {
+	return &contentValidateAction{}
+}
+
+// Metadata returns the action type name.
+func (a *contentValidateAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_validate"
+}
+
+// Schema defines the schema for the action.
+func (a *contentValidateAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs the Looker content validator, which checks looks, dashboards, schedules and alerts for references to LookML fields, explores and models that no longer exist. The content with errors is reported as a diagnostic. Content validation can take several minutes on large instances.",
+		Attributes: map[string]schema.Attribute{
+			"project_names": schema.ListAttribute{
+				Description: "Only validate content using these LookML projects. Defaults to all projects.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"folder_ids": schema.ListAttribute{
+				Description: "Only validate content in these folders. Defaults to all folders.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"fail_on_errors": schema.BoolAttribute{
+				Description: "Whether content with errors fails the action. When `false`, it is reported as a warning. Defaults to `true`.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Invoke runs the content validator and reports the content with errors.
+func (a *contentValidateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if !a.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var config contentValidateActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := v4.RequestContentValidation{}
+	if len(config.ProjectNames) > 0 {
+		projectNames := rtl.DelimString(config.ProjectNames)
+		request.ProjectNames = &projectNames
+	}
+	if len(config.FolderIDs) > 0 {
+		folderIDs := rtl.DelimString(config.FolderIDs)
+		request.SpaceIds = &folderIDs
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Running the content validator"})
+	result, err := a.client.SDK(ctx).ContentValidation(request, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to run the content validator: %v", err))
+		return
+	}
+
+	count := func(n *int64) int64 {
+		if n == nil {
+			return 0
+		}
+		return *n
+	}
+	var broken []v4.ContentValidatorError
+	if result.ContentWithErrors != nil {
+		broken = *result.ContentWithErrors
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf(
+		"Validated %d looks, %d dashboard elements, %d dashboard filters, %d scheduled plans and %d alerts; %d with errors",
+		count(result.TotalLooksValidated), count(result.TotalDashboardElementsValidated), count(result.TotalDashboardFiltersValidated),
+		count(result.TotalScheduledPlansValidated), count(result.TotalAlertsValidated), len(broken))})
+	if len(broken) == 0 {
+		return
+	}
+
+	lines := make([]string, 0, contentValidationReportLimit+1)
+	for i, content := range broken {
+		if i == contentValidationReportLimit {
+			lines = append(lines, fmt.Sprintf("... and %d more", len(broken)-i))
+			break
+		}
+		lines = append(lines, "- "+contentValidatorErrorSummary(content))
+	}
+	summary := "Content validation found errors"
+	detail := fmt.Sprintf("%d pieces of content reference LookML that does not exist:\n%s", len(broken), strings.Join(lines, "\n"))
+	if config.FailOnErrors.IsNull() || config.FailOnErrors.ValueBool() {
+		resp.Diagnostics.AddError(summary, detail)
+	} else {
+		resp.Diagnostics.AddWarning(summary, detail)
+	}
+}
+
+// contentValidatorErrorSummary describes a piece of content with errors and
+// the errors found, e.g. `dashboard "Sales" (12): Unknown field "orders.total"`.
+func contentValidatorErrorSummary(content v4.ContentValidatorError) string {
+	value := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+
+	var name string
+	switch {
+	case content.Dashboard != nil:
+		name = fmt.Sprintf("dashboard %q (%s)", value(content.Dashboard.Title), value(content.Dashboard.Id))
+	case content.Look != nil:
+		name = fmt.Sprintf("look %q (%s)", value(content.Look.Title), value(content.Look.Id))
+	case content.LookmlDashboard != nil:
+		name = fmt.Sprintf("LookML dashboard %q (%s)", value(content.LookmlDashboard.Title), value(content.LookmlDashboard.Id))
+	case content.ScheduledPlan != nil:
+		name = fmt.Sprintf("scheduled plan %q (%s)", value(content.ScheduledPlan.Name), value(content.ScheduledPlan.Id))
+	case content.Alert != nil:
+		name = fmt.Sprintf("alert %s", value(content.Alert.Id))
+	default:
+		name = fmt.Sprintf("content %s", value(content.Id))
+	}
+	switch {
+	case content.DashboardElement != nil:
+		name += fmt.Sprintf(", tile %q", value(content.DashboardElement.Title))
+	case content.LookmlDashboardElement != nil:
+		name += fmt.Sprintf(", tile %q", value(content.LookmlDashboardElement.Title))
+	case content.DashboardFilter != nil:
+		name += fmt.Sprintf(", filter %q", value(content.DashboardFilter.Title))
+	}
+
+	var messages []string
+	if content.Errors != nil {
+		for _, e := range *content.Errors {
+			messages = append(messages, value(e.Message))
+		}
+	}
+	if len(messages) == 0 {
+		return name
+	}
+	return name + ": " + strings.Join(messages, "; ")
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestContentValidateActionInvoke(t *testing.T) {
+	broken := []v4.ContentValidatorError{
+		{
+			Dashboard:        &v4.ContentValidationDashboard{Id: ptr("12"), Title: ptr("Sales")},
+			DashboardElement: &v4.ContentValidationDashboardElement{Title: ptr("Revenue")},
+			Errors:           &[]v4.ContentValidationError{{Message: ptr(`Unknown field "orders.total"`)}},
+		},
+	}
+	tests := map[string]struct {
+		values   map[string]any
+		request  v4.RequestContentValidation
+		broken   []v4.ContentValidatorError
+		severity string
+	}{
+		"no errors": {
+			values: map[string]any{},
+		},
+		"filtered": {
+			values: map[string]any{"project_names": []string{"shop", "finance"}, "folder_ids": []string{"3"}},
+			request: v4.RequestContentValidation{
+				ProjectNames: &rtl.DelimString{"shop", "finance"},
+				SpaceIds:     &rtl.DelimString{"3"},
+			},
+		},
+		"errors fail": {
+			values:   map[string]any{},
+			broken:   broken,
+			severity: "error",
+		},
+		"errors warn": {
+			values:   map[string]any{"fail_on_errors": false},
+			broken:   broken,
+			severity: "warning",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, api := newMockClient(t)
+
+			api.EXPECT().ContentValidation(test.request, nil).Return(v4.ContentValidation{
+				ContentWithErrors:   &test.broken,
+				TotalLooksValidated: ptr(int64(5)),
+			}, nil)
+			messages, diags := invokeAction(t, &contentValidateAction{}, client, test.values)
+			if len(messages) != 2 || !strings.HasPrefix(messages[1], "Validated 5 looks") {
+				t.Errorf("progress = %q, want the run and its totals", messages)
+			}
+			switch test.severity {
+			case "":
+				requireNoErrors(t, "Invoke", diags)
+				if diags.WarningsCount() > 0 {
+					t.Errorf("diagnostics = %v, want none", diags)
+				}
+			case "error":
+				if diags.ErrorsCount() != 1 || !strings.Contains(diags.Errors()[0].Detail(), `dashboard "Sales" (12), tile "Revenue": Unknown field "orders.total"`) {
+					t.Errorf("diagnostics = %v, want the broken tile as an error", diags)
+				}
+			case "warning":
+				requireNoErrors(t, "Invoke", diags)
+				if diags.WarningsCount() != 1 {
+					t.Errorf("diagnostics = %v, want the broken tile as a warning", diags)
+				}
+			}
+		})
+	}
+}
+
+func TestContentValidateActionReportLimit(t *testing.T) {
+	client, api := newMockClient(t)
+	broken := make([]v4.ContentValidatorError, contentValidationReportLimit+3)
+	for i := range broken {
+		broken[i] = v4.ContentValidatorError{Look: &v4.ContentValidationLook{Id: ptr(fmt.Sprint(i)), Title: ptr("Orders")}}
+	}
+
+	api.EXPECT().ContentValidation(v4.RequestContentValidation{}, nil).Return(v4.ContentValidation{ContentWithErrors: &broken}, nil)
+	_, diags := invokeAction(t, &contentValidateAction{}, client, map[string]any{})
+	if !diags.HasError() {
+		t.Fatal("Invoke succeeded, want the broken looks as an error")
+	}
+	detail := diags.Errors()[0].Detail()
+	if got := strings.Count(detail, "- look "); got != contentValidationReportLimit {
+		t.Errorf("%d looks listed, want %d", got, contentValidationReportLimit)
+	}
+	if !strings.HasSuffix(detail, "... and 3 more") {
+		t.Errorf("detail = %q, want it to end with the number of looks not listed", detail)
+	}
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var (
+	_ action.Action              = &datagroupResetAction{}
+	_ action.ActionWithConfigure = &datagroupResetAction{}
+)
+
+// datagroupResetAction is the action implementation.
+type datagroupResetAction struct {
+	baseAction
+}
+
+// datagroupResetActionModel maps the action schema data.
+type datagroupResetActionModel struct {
+	DatagroupID types.String `tfsdk:"datagroup_id"`
+	Trigger     types.Bool   `tfsdk:"trigger"`
+}
+
+// NewDatagroupResetAction is a helper function to simplify the provider implementation.
+func NewDatagroupResetAction() action.Action {
+	return &datagroupResetAction{}
+}
+
+// Metadata returns the action type name.
+func (a *datagroupResetAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_datagroup_reset"
+}
+
+// Schema defines the schema for the action.
+func (a *datagroupResetAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resets the cache of a datagroup, as **Reset Cache** on the Datagroups admin page does: query results cached before now are no longer used. With `trigger`, the datagroup is also triggered, rebuilding the persistent derived tables that use it.",
+		Attributes: map[string]schema.Attribute{
+			"datagroup_id": schema.StringAttribute{
+				Description: "The ID of the datagroup.",
+				Required:    true,
+			},
+			"trigger": schema.BoolAttribute{
+				Description: "Whether to also trigger the datagroup, as **Trigger Datagroup** does. Defaults to `false`.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Invoke marks the cache of the datagroup stale as of now.
+func (a *datagroupResetAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if !a.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var config datagroupResetActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	datagroupID := config.DatagroupID.ValueString()
+
+	// Unix truncates to the second, so the timestamp is never in the
+	// future, which Looker rejects.
+	now := time.Now().Unix()
+	body := v4.WriteDatagroup{StaleBefore: &now}
+	if config.Trigger.ValueBool() {
+		body.TriggeredAt = &now
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Resetting and triggering datagroup %s", datagroupID)})
+	} else {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Resetting the cache of datagroup %s", datagroupID)})
+	}
+
+	if _, err := a.client.SDK(ctx).UpdateDatagroup(datagroupID, body, nil); err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to reset datagroup %s: %v", datagroupID, err))
+	}
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDatagroupResetActionInvoke(t *testing.T) {
+	tests := map[string]struct {
+		trigger bool
+		err     error
+	}{
+		"reset":           {},
+		"reset + trigger": {trigger: true},
+		"api error":       {err: errNotFound},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, api := newMockClient(t)
+			values := map[string]any{"datagroup_id": "4"}
+			if test.trigger {
+				values["trigger"] = test.trigger
+			}
+
+			before := time.Now().Unix()
+			api.EXPECT().UpdateDatagroup("4", gomock.Any(), nil).DoAndReturn(func(_ string, body v4.WriteDatagroup, _ any) (v4.Datagroup, error) {
+				if body.StaleBefore == nil || *body.StaleBefore < before || *body.StaleBefore > time.Now().Unix() {
+					t.Errorf("stale_before = %v, want now", body.StaleBefore)
+				}
+				if triggered := body.TriggeredAt != nil; triggered != (test.trigger) {
+					t.Errorf("triggered = %t, want %t", triggered, test.trigger)
+				}
+				return v4.Datagroup{}, test.err
+			})
+			messages, diags := invokeAction(t, &datagroupResetAction{}, client, values)
+			if diags.HasError() != (test.err != nil) {
+				t.Errorf("diagnostics = %v, want error %t", diags, test.err != nil)
+			}
+			if len(messages) != 1 {
+				t.Errorf("progress = %q, want one message", messages)
+			}
+		})
+	}
+}
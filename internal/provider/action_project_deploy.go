@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var (
+	_ action.Action              = &projectDeployAction{}
+	_ action.ActionWithConfigure = &projectDeployAction{}
+)
+
+// projectDeployAction is the action implementation.
+type projectDeployAction struct {
+	baseAction
+}
+
+// projectDeployActionModel maps the action schema data.
+type projectDeployActionModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	Branch    types.String `tfsdk:"branch"`
+	Ref       types.String `tfsdk:"ref"`
+}
+
+// NewProjectDeployAction is a helper function to simplify the provider implementation.
+func NewProjectDeployAction() action.Action {
+	return &projectDeployAction{}
+}
+
+// Metadata returns the action type name.
+func (a *projectDeployAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_deploy"
+}
+
+// Schema defines the schema for the action.
+func (a *projectDeployAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Deploys a branch or commit of the remote git repository of a LookML project to production. If the project has never been deployed, Looker creates its production project first.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the LookML project.",
+				Required:    true,
+			},
+			"branch": schema.StringAttribute{
+				Description: "The remote branch to deploy. Exactly one of `branch` or `ref` must be set.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("ref")),
+				},
+			},
+			"ref": schema.StringAttribute{
+				Description: "The commit SHA or tag to deploy. Exactly one of `branch` or `ref` must be set.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Invoke deploys the configured branch or ref.
+func (a *projectDeployAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if !a.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var config projectDeployActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := config.ProjectID.ValueString()
+
+	target := "ref " + config.Ref.ValueString()
+	if !config.Branch.IsNull() {
+		target = "branch " + config.Branch.ValueString()
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Deploying %s of project %s to production", target, projectID)})
+
+	_, err := a.client.SDK(ctx).DeployRefToProduction(v4.RequestDeployRefToProduction{
+		ProjectId: projectID,
+		Branch:    config.Branch.ValueStringPointer(),
+		Ref:       config.Ref.ValueStringPointer(),
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to deploy %s of project %s to production: %v", target, projectID, err))
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestProjectDeployActionInvoke(t *testing.T) {
+	tests := map[string]struct {
+		values  map[string]any
+		request v4.RequestDeployRefToProduction
+		message string
+	}{
+		"branch": {
+			values:  map[string]any{"project_id": "shop", "branch": "main"},
+			request: v4.RequestDeployRefToProduction{ProjectId: "shop", Branch: ptr("main")},
+			message: "Deploying branch main of project shop to production",
+		},
+		"ref": {
+			values:  map[string]any{"project_id": "shop", "ref": "v1.2.0"},
+			request: v4.RequestDeployRefToProduction{ProjectId: "shop", Ref: ptr("v1.2.0")},
+			message: "Deploying ref v1.2.0 of project shop to production",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, api := newMockClient(t)
+
+			api.EXPECT().DeployRefToProduction(test.request, nil).Return("", nil)
+			messages, diags := invokeAction(t, &projectDeployAction{}, client, test.values)
+			requireNoErrors(t, "Invoke", diags)
+			if len(messages) != 1 || messages[0] != test.message {
+				t.Errorf("progress = %q, want [%q]", messages, test.message)
+			}
+		})
+	}
+}
+
+func TestProjectDeployActionInvokeError(t *testing.T) {
+	client, api := newMockClient(t)
+
+	api.EXPECT().DeployRefToProduction(v4.RequestDeployRefToProduction{ProjectId: "shop", Branch: ptr("main")}, nil).Return("", errNotFound)
+	_, diags := invokeAction(t, &projectDeployAction{}, client, map[string]any{"project_id": "shop", "branch": "main"})
+	if !diags.HasError() || diags.Errors()[0].Summary() != "API error" {
+		t.Errorf("diagnostics = %v, want an API error", diags)
+	}
+}
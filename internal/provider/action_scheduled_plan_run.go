@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var (
+	_ action.Action              = &scheduledPlanRunAction{}
+	_ action.ActionWithConfigure = &scheduledPlanRunAction{}
+)
+
+// scheduledPlanRunAction is the action implementation.
+type scheduledPlanRunAction struct {
+	baseAction
+}
+
+// scheduledPlanRunActionModel maps the action schema data.
+type scheduledPlanRunActionModel struct {
+	ScheduledPlanID types.String `tfsdk:"scheduled_plan_id"`
+}
+
+// NewScheduledPlanRunAction is a helper function to simplify the provider implementation.
+func NewScheduledPlanRunAction() action.Action {
+	return &scheduledPlanRunAction{}
+}
+
+// Metadata returns the action type name.
+func (a *scheduledPlanRunAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduled_plan_run"
+}
+
+// Schema defines the schema for the action.
+func (a *scheduledPlanRunAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs a scheduled plan once now, with its current settings and destinations, without changing its schedule. Looker queues the run; the action does not wait for it to be delivered.",
+		Attributes: map[string]schema.Attribute{
+			"scheduled_plan_id": schema.StringAttribute{
+				Description: "The ID of the scheduled plan.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Invoke queues a run of the scheduled plan.
+func (a *scheduledPlanRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if !a.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var config scheduledPlanRunActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	scheduledPlanID := config.ScheduledPlanID.ValueString()
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Running scheduled plan %s once", scheduledPlanID)})
+	if _, err := a.client.SDK(ctx).ScheduledPlanRunOnceById(scheduledPlanID, v4.WriteScheduledPlan{}, nil); err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to run scheduled plan %s: %v", scheduledPlanID, err))
+	}
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"testing"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestScheduledPlanRunActionInvoke(t *testing.T) {
+	tests := map[string]struct {
+		err error
+	}{
+		"queued":    {},
+		"api error": {err: errNotFound},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, api := newMockClient(t)
+
+			// The plan runs with its current settings.
+			api.EXPECT().ScheduledPlanRunOnceById("8", v4.WriteScheduledPlan{}, nil).Return(v4.ScheduledPlan{}, test.err)
+			messages, diags := invokeAction(t, &scheduledPlanRunAction{}, client, map[string]any{"scheduled_plan_id": "8"})
+			if diags.HasError() != (test.err != nil) {
+				t.Errorf("diagnostics = %v, want error %t", diags, test.err != nil)
+			}
+			if len(messages) != 1 || messages[0] != "Running scheduled plan 8 once" {
+				t.Errorf("progress = %q, want [Running scheduled plan 8 once]", messages)
+			}
+		})
+	}
+}
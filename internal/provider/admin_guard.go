@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"fmt"
+
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// isAdminRole reports whether role is an Admin role: one that uses Looker's
+// built-in Admin permission set, which grants every permission.
+func isAdminRole(role v4.Role) bool {
+	ps := role.PermissionSet
+	return ps != nil && ps.BuiltIn != nil && *ps.BuiltIn && ps.AllAccess != nil && *ps.AllAccess
+}
+
+// adminRoleIDs returns the IDs of the Admin roles of the instance.
+func adminRoleIDs(sdk lookerapi.Client) ([]string, error) {
+	fields := "id,permission_set"
+	sorts := "id"
+	limit := int64(100)
+	search := v4.RequestSearchRoles{Fields: &fields, Sorts: &sorts, Limit: &limit}
+
+	var ids []string
+	for offset := int64(0); ; offset += limit {
+		search.Offset = &offset
+		page, err := sdk.SearchRoles(search, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range page {
+			if role.Id != nil && isAdminRole(role) {
+				ids = append(ids, *role.Id)
+			}
+		}
+		if int64(len(page)) < limit {
+			return ids, nil
+		}
+	}
+}
+
+// refuseAdminRoleDelete reports an error and returns true when roleID is an
+// Admin role. Deleting it could leave nobody able to administer the instance.
+func refuseAdminRoleDelete(sdk lookerapi.Client, roleID string, diags *diag.Diagnostics) bool {
+	role, err := sdk.Role(roleID, nil)
+	if err != nil || !isAdminRole(role) {
+		// A role that cannot be read is left to Delete to report.
+		return false
+	}
+	name := ""
+	if role.Name != nil {
+		name = *role.Name
+	}
+	diags.AddError("Admin role",
+		fmt.Sprintf("Role %s (%q) uses the built-in Admin permission set and is not deleted, since that could leave nobody able to administer the instance. To stop managing it, remove it from the state with `terraform state rm`.", roleID, name))
+	return true
+}
+
+// adminChange is a planned change that may take admin access away: the
+// groups an Admin role will have, or the members a group will have.
+type adminChange struct {
+	roleID     string
+	roleGroups []string
+
+	groupID      string
+	groupMembers []string
+}
+
+// warnNoAdminLeft adds a warning when, after change, no user would hold an
+// Admin role, either directly or through a group. Like the All Users warning
+// it is best effort: API failures skip it, and members of nested groups are
+// not counted.
+func warnNoAdminLeft(sdk lookerapi.Client, change adminChange, attribute path.Path, diags *diag.Diagnostics) {
+	roleIDs, err := adminRoleIDs(sdk)
+	if err != nil {
+		return
+	}
+
+	affected := false
+	var groupIDs []string
+	for _, roleID := range roleIDs {
+		if roleID == change.roleID {
+			affected = true
+			groupIDs = append(groupIDs, change.roleGroups...)
+			continue
+		}
+		groups, err := sdk.RoleGroups(roleID, "id", nil)
+		if err != nil {
+			return
+		}
+		for _, group := range groups {
+			if group.Id == nil {
+				continue
+			}
+			if *group.Id == change.groupID {
+				affected = true
+			}
+			groupIDs = append(groupIDs, *group.Id)
+		}
+	}
+	if !affected {
+		return
+	}
+
+	fields := "id"
+	directOnly := true
+	for _, roleID := range roleIDs {
+		users, err := sdk.RoleUsers(v4.RequestRoleUsers{RoleId: roleID, Fields: &fields, DirectAssociationOnly: &directOnly}, nil)
+		if err != nil || len(users) > 0 {
+			return
+		}
+	}
+	for _, groupID := range groupIDs {
+		if groupID == change.groupID {
+			if len(change.groupMembers) > 0 {
+				return
+			}
+			continue
+		}
+		members, err := allGroupUsers(sdk, groupID, "id")
+		if err != nil || len(members) > 0 {
+			return
+		}
+	}
+
+	diags.AddAttributeWarning(attribute, "No admin left",
+		"After this change no user would hold an Admin role, directly or through a group, and nobody may be able to administer the instance. Make sure another admin exists before applying.")
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// allUsersGroupName is the name of the built-in group every Looker user
+// belongs to.
+const allUsersGroupName = "All Users"
+
+// allowAllUsersAttribute silences the warning raised when a role is given to
+// the All Users group.
+var allowAllUsersAttribute = schema.BoolAttribute{
+	Description: "Set to true to acknowledge that a role is intentionally given to the built-in `All Users` group (or another group every new user joins) and silence the plan warning. Defaults to `false`.",
+	Optional:    true,
+	Computed:    true,
+	Default:     booldefault.StaticBool(false),
+}
+
+// warnAllUsersGroups adds a warning for every group in added that holds every
+// user: the built-in All Users group, or any group new users join by default.
+// Granting a role to such a group grants it to everyone on the instance.
+func warnAllUsersGroups(sdk lookerapi.Client, roleID string, added []string, attribute path.Path, diags *diag.Diagnostics) {
+	if len(added) == 0 {
+		return
+	}
+
+	ids := rtl.DelimString(added)
+	fields := "id,name,include_by_default"
+	groups, err := sdk.AllGroups(v4.RequestAllGroups{Ids: &ids, Fields: &fields}, nil)
+	if err != nil {
+		// The warning is best effort; the apply reports real API problems.
+		return
+	}
+	for _, group := range groups {
+		everyone := group.IncludeByDefault != nil && *group.IncludeByDefault
+		if group.Name != nil && strings.EqualFold(*group.Name, allUsersGroupName) {
+			everyone = true
+		}
+		if !everyone || group.Id == nil {
+			continue
+		}
+		name := ""
+		if group.Name != nil {
+			name = *group.Name
+		}
+		diags.AddAttributeWarning(attribute, "Role granted to every user",
+			fmt.Sprintf("Group %s (%q) contains every user of the instance, so role %s will be granted to everyone. Set allow_all_users = true if this is intended.", *group.Id, name, roleID))
+	}
+}
+
+// stringsNotIn returns the values of planned that are not in current.
+func stringsNotIn(planned, current []string) []string {
+	existing := make(map[string]bool, len(current))
+	for _, id := range current {
+		existing[id] = true
+	}
+	var added []string
+	for _, id := range planned {
+		if !existing[id] {
+			added = append(added, id)
+		}
+	}
+	return added
+}
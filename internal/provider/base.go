@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// baseResource is embedded by every resource. It receives the Looker client
+// from the provider and implements resource.ResourceWithConfigure.
+type baseResource struct {
+	client  *clientBundle
+	pending *pendingConfig
+}
+
+// Configure adds the provider configured client to the resource.
+func (b *baseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	b.client, b.pending = configuredClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// UpgradeState returns the state upgraders of the resource, keyed by the
+// schema version they upgrade from. Every resource starts at version 0 with
+// none. A resource whose schema changes incompatibly, e.g. by renaming an
+// attribute, bumps its schema Version and overrides this method with an
+// upgrader for the prior version, usually built with rawStateUpgrader, so
+// that existing state keeps working without being re-imported.
+func (b *baseResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+// configured reports whether the provider has set the client, adding an error
+// to diags when it has not.
+func (b *baseResource) configured(diags *diag.Diagnostics) bool {
+	return checkClient(b.client, b.pending, diags)
+}
+
+// baseDataSource is embedded by every data source. It receives the Looker
+// client from the provider and implements datasource.DataSourceWithConfigure.
+type baseDataSource struct {
+	client  *clientBundle
+	pending *pendingConfig
+}
+
+// Configure adds the provider configured client to the data source.
+func (b *baseDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	b.client, b.pending = configuredClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// configured reports whether the provider has set the client, adding an error
+// to diags when it has not.
+func (b *baseDataSource) configured(diags *diag.Diagnostics) bool {
+	return checkClient(b.client, b.pending, diags)
+}
+
+// baseAction is embedded by every action. It receives the Looker client from
+// the provider and implements action.ActionWithConfigure.
+type baseAction struct {
+	client  *clientBundle
+	pending *pendingConfig
+}
+
+// Configure adds the provider configured client to the action.
+func (b *baseAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	b.client, b.pending = configuredClient(req.ProviderData, &resp.Diagnostics)
+}
+
+// configured reports whether the provider has set the client, adding an error
+// to diags when it has not.
+func (b *baseAction) configured(diags *diag.Diagnostics) bool {
+	return checkClient(b.client, b.pending, diags)
+}
+
+// pendingConfig is the provider data when the provider configuration depends
+// on values not known until apply and Terraform cannot defer the resources
+// using it.
+type pendingConfig struct {
+	// unknown names the unknown provider attributes.
+	unknown []string
+}
+
+// configuredClient extracts the client, or the reason there is none, from the
+// provider data. The data is nil while the provider itself has not been
+// configured yet, e.g. during validation.
+func configuredClient(providerData any, diags *diag.Diagnostics) (*clientBundle, *pendingConfig) {
+	switch data := providerData.(type) {
+	case *clientBundle:
+		if data != nil {
+			return data, nil
+		}
+	case *pendingConfig:
+		return nil, data
+	}
+	if providerData != nil {
+		diags.AddError("Unexpected provider data", "Missing Looker SDK client")
+	}
+	return nil, nil
+}
+
+// checkClient adds an error to diags when client is nil, explaining how to
+// fix a provider configuration that is not known yet.
+func checkClient(client *clientBundle, pending *pendingConfig, diags *diag.Diagnostics) bool {
+	if client != nil {
+		return true
+	}
+	if pending != nil {
+		verb := "is"
+		if len(pending.unknown) > 1 {
+			verb = "are"
+		}
+		diags.AddError("Provider configuration unknown",
+			fmt.Sprintf("%s %s unknown until apply, so the Looker API cannot be called while planning. Set it to a value known at plan time, e.g. apply the module creating it first with -target, or use a Terraform version supporting deferred actions.",
+				strings.Join(pending.unknown, ", "), verb))
+		return false
+	}
+	diags.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	return false
+}
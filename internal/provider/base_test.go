@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestCheckClient(t *testing.T) {
+	tests := map[string]struct {
+		client  *clientBundle
+		pending *pendingConfig
+		summary string
+		detail  string
+	}{
+		"configured":   {client: &clientBundle{}},
+		"unconfigured": {summary: "Unconfigured client"},
+		"one unknown": {
+			pending: &pendingConfig{unknown: []string{"base_url"}},
+			summary: "Provider configuration unknown",
+			detail:  "base_url is unknown until apply",
+		},
+		"several unknown": {
+			pending: &pendingConfig{unknown: []string{"base_url", "client_secret"}},
+			summary: "Provider configuration unknown",
+			detail:  "base_url, client_secret are unknown until apply",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			ok := checkClient(test.client, test.pending, &diags)
+			if ok != (test.summary == "") {
+				t.Fatalf("got %v, want %v", ok, test.summary == "")
+			}
+			if test.summary == "" {
+				return
+			}
+			if got := diags.Errors()[0].Summary(); got != test.summary {
+				t.Errorf("got summary %q, want %q", got, test.summary)
+			}
+			if got := diags.Errors()[0].Detail(); !strings.Contains(got, test.detail) {
+				t.Errorf("detail %q does not contain %q", got, test.detail)
+			}
+		})
+	}
+}
+
+func TestConfiguredClient(t *testing.T) {
+	var diags diag.Diagnostics
+	pending := &pendingConfig{unknown: []string{"base_url"}}
+	if client, got := configuredClient(pending, &diags); client != nil || got != pending {
+		t.Errorf("got %v, %v for pending config", client, got)
+	}
+	if client, got := configuredClient(nil, &diags); client != nil || got != nil {
+		t.Errorf("got %v, %v for nil provider data", client, got)
+	}
+	if diags.HasError() {
+		t.Errorf("unexpected diagnostics %v", diags)
+	}
+	configuredClient("unexpected", &diags)
+	if !diags.HasError() {
+		t.Error("no error for unexpected provider data")
+	}
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// refuseBuiltIn reports an error and returns true when the object in state,
+// typically imported, is built into Looker, such as the Admin permission set.
+// Looker rejects changes to built-in objects with unhelpful errors, so the
+// provider refuses them itself. operation is "modified" or "deleted".
+func refuseBuiltIn(ctx context.Context, state attributeGetter, kind, operation string, diags *diag.Diagnostics) bool {
+	var builtIn types.Bool
+	var name types.String
+	diags.Append(state.GetAttribute(ctx, path.Root("built_in"), &builtIn)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("name"), &name)...)
+	if !builtIn.ValueBool() {
+		return false
+	}
+	diags.AddError("Built-in "+kind,
+		fmt.Sprintf("The %s %q is built into Looker and cannot be %s. Keep its configuration unchanged, or stop managing it with `terraform state rm` and refer to it through a data source instead.", kind, name.ValueString(), operation))
+	return true
+}
+
+// refuseBuiltInChange is refuseBuiltIn for ModifyPlan: it reports planned
+// updates and deletions of a built-in object, so that they fail at plan time.
+func refuseBuiltInChange(ctx context.Context, req resource.ModifyPlanRequest, kind string, diags *diag.Diagnostics) bool {
+	if req.State.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return false
+	}
+	operation := "modified"
+	if req.Plan.Raw.IsNull() {
+		operation = "deleted"
+	}
+	return refuseBuiltIn(ctx, req.State, kind, operation, diags)
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+const alertSearchPageSize = 500
+
+// alertObjectType is the object type of an entry in the `alerts` list.
+var alertObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":                   types.StringType,
+	"title":                types.StringType,
+	"owner_id":             types.StringType,
+	"owner_display_name":   types.StringType,
+	"is_disabled":          types.BoolType,
+	"dashboard_element_id": types.StringType,
+	"destination_type":     types.StringType,
+	"destination_emails":   types.ListType{ElemType: types.StringType},
+}}
+
+// alertNotificationsDataSource is the data source implementation.
+type alertNotificationsDataSource struct {
+	baseDataSource
+}
+
+// alertNotificationsModel maps the data source schema data.
+type alertNotificationsModel struct {
+	OwnerID          types.String `tfsdk:"owner_id"`
+	DestinationEmail types.String `tfsdk:"destination_email"`
+	Disabled         types.Bool   `tfsdk:"disabled"`
+	Alerts           types.List   `tfsdk:"alerts"`
+}
+
+// alertItemModel maps an entry of the `alerts` list.
+type alertItemModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Title              types.String `tfsdk:"title"`
+	OwnerID            types.String `tfsdk:"owner_id"`
+	OwnerDisplayName   types.String `tfsdk:"owner_display_name"`
+	IsDisabled         types.Bool   `tfsdk:"is_disabled"`
+	DashboardElementID types.String `tfsdk:"dashboard_element_id"`
+	DestinationType    types.String `tfsdk:"destination_type"`
+	DestinationEmails  []string     `tfsdk:"destination_emails"`
+}
+
+// NewAlertNotificationsDataSource is a helper function to simplify the provider implementation.
+func NewAlertNotificationsDataSource() datasource.DataSource {
+	return &alertNotificationsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *alertNotificationsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_alert_notifications"
+}
+
+// Schema defines the schema for the data source.
+func (d *alertNotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the alerts of all owners together with their owner and notification destinations. Requires admin credentials.",
+		Attributes: map[string]schema.Attribute{
+			"owner_id": schema.StringAttribute{
+				Description: "Only return alerts owned by this user.",
+				Optional:    true,
+			},
+			"destination_email": schema.StringAttribute{
+				Description: "Only return alerts that notify this email address (case-insensitive).",
+				Optional:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Only return disabled (`true`) or enabled (`false`) alerts.",
+				Optional:    true,
+			},
+			"alerts": schema.ListNestedAttribute{
+				Description: "The matching alerts.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                   schema.StringAttribute{Computed: true},
+						"title":                schema.StringAttribute{Computed: true},
+						"owner_id":             schema.StringAttribute{Computed: true},
+						"owner_display_name":   schema.StringAttribute{Computed: true},
+						"is_disabled":          schema.BoolAttribute{Computed: true},
+						"dashboard_element_id": schema.StringAttribute{Computed: true},
+						"destination_type":     schema.StringAttribute{Computed: true},
+						"destination_emails":   schema.ListAttribute{ElementType: types.StringType, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *alertNotificationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data alertNotificationsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allOwners := true
+	limit := int64(alertSearchPageSize)
+	search := v4.RequestSearchAlerts{
+		Limit:     &limit,
+		AllOwners: &allOwners,
+		Disabled:  data.Disabled.ValueBoolPointer(),
+	}
+
+	alerts := []alertItemModel{}
+	for offset := int64(0); ; offset += limit {
+		search.Offset = &offset
+		page, err := d.client.SDK(ctx).SearchAlerts(search, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Alert search failed: %v", err))
+			return
+		}
+		for _, alert := range page {
+			if !data.OwnerID.IsNull() && alert.OwnerId != data.OwnerID.ValueString() {
+				continue
+			}
+
+			item := alertItemModel{
+				ID:                 types.StringPointerValue(alert.Id),
+				Title:              types.StringValue(alert.Field.Title),
+				OwnerID:            types.StringValue(alert.OwnerId),
+				OwnerDisplayName:   types.StringPointerValue(alert.OwnerDisplayName),
+				IsDisabled:         types.BoolPointerValue(alert.IsDisabled),
+				DashboardElementID: types.StringPointerValue(alert.DashboardElementId),
+				DestinationType:    types.StringNull(),
+				DestinationEmails:  []string{},
+			}
+			if alert.CustomTitle != nil && *alert.CustomTitle != "" {
+				item.Title = types.StringValue(*alert.CustomTitle)
+			}
+			matched := data.DestinationEmail.IsNull()
+			for _, destination := range alert.Destinations {
+				item.DestinationType = types.StringValue(string(destination.DestinationType))
+				if destination.EmailAddress == nil {
+					continue
+				}
+				item.DestinationEmails = append(item.DestinationEmails, *destination.EmailAddress)
+				if strings.EqualFold(*destination.EmailAddress, data.DestinationEmail.ValueString()) {
+					matched = true
+				}
+			}
+			if matched {
+				alerts = append(alerts, item)
+			}
+		}
+		if int64(len(page)) < limit {
+			break
+		}
+	}
+
+	alertsList, diags := types.ListValueFrom(ctx, alertObjectType, alerts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Alerts = alertsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+const (
+	auditFolderFields   = "id,name,parent_id,content_metadata_id,is_personal,is_personal_descendant"
+	auditFolderPageSize = 500
+)
+
+// accessAuditObjectType is the object type of an entry in the `grants` list.
+var accessAuditObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"folder_id":           types.StringType,
+	"folder_name":         types.StringType,
+	"folder_path":         types.StringType,
+	"content_metadata_id": types.StringType,
+	"principal_type":      types.StringType,
+	"principal_id":        types.StringType,
+	"access_level":        types.StringType,
+	"inherited":           types.BoolType,
+}}
+
+// contentAccessAuditDataSource is the data source implementation.
+type contentAccessAuditDataSource struct {
+	baseDataSource
+}
+
+// contentAccessAuditModel maps the data source schema data.
+type contentAccessAuditModel struct {
+	DirectOnly types.Bool `tfsdk:"direct_only"`
+	Grants     types.List `tfsdk:"grants"`
+}
+
+// accessAuditItemModel maps an entry of the `grants` list.
+type accessAuditItemModel struct {
+	FolderID          types.String `tfsdk:"folder_id"`
+	FolderName        types.String `tfsdk:"folder_name"`
+	FolderPath        types.String `tfsdk:"folder_path"`
+	ContentMetadataID types.String `tfsdk:"content_metadata_id"`
+	PrincipalType     types.String `tfsdk:"principal_type"`
+	PrincipalID       types.String `tfsdk:"principal_id"`
+	AccessLevel       types.String `tfsdk:"access_level"`
+	Inherited         types.Bool   `tfsdk:"inherited"`
+}
+
+// NewContentAccessAuditDataSource is a helper function to simplify the provider implementation.
+func NewContentAccessAuditDataSource() datasource.DataSource {
+	return &contentAccessAuditDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *contentAccessAuditDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_access_audit"
+}
+
+// Schema defines the schema for the data source.
+func (d *contentAccessAuditDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the access grants of every non-personal folder of the instance, one entry per folder and group or user.",
+		Attributes: map[string]schema.Attribute{
+			"direct_only": schema.BoolAttribute{
+				Description: "If true, only list grants set on the folder itself, leaving out those a folder inherits from its parent. Defaults to `false`.",
+				Optional:    true,
+			},
+			"grants": schema.ListNestedAttribute{
+				Description: "The grants, ordered by folder ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"folder_id":           schema.StringAttribute{Computed: true},
+						"folder_name":         schema.StringAttribute{Computed: true},
+						"folder_path":         schema.StringAttribute{Description: "Slash-separated folder names from the top-level folder.", Computed: true},
+						"content_metadata_id": schema.StringAttribute{Description: "The content_metadata_id of the folder.", Computed: true},
+						"principal_type":      schema.StringAttribute{Description: "`group` or `user`.", Computed: true},
+						"principal_id":        schema.StringAttribute{Description: "The ID of the group or user.", Computed: true},
+						"access_level":        schema.StringAttribute{Description: "`view` or `edit`.", Computed: true},
+						"inherited":           schema.BoolAttribute{Description: "Whether the grant is inherited from an ancestor folder.", Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// sharedFolders returns every folder that is neither a personal folder nor
+// inside one, ordered by ID.
+func sharedFolders(sdk lookerapi.Client) ([]v4.Folder, error) {
+	fields := auditFolderFields
+	sorts := "id"
+	limit := int64(auditFolderPageSize)
+	search := v4.RequestSearchFolders{Fields: &fields, Sorts: &sorts, Limit: &limit}
+
+	var folders []v4.Folder
+	for offset := int64(0); ; offset += limit {
+		search.Offset = &offset
+		page, err := sdk.SearchFolders(search, nil)
+		if err != nil {
+			return nil, fmt.Errorf("API error listing folders: %w", err)
+		}
+		for _, folder := range page {
+			if folder.Id == nil || (folder.IsPersonal != nil && *folder.IsPersonal) ||
+				(folder.IsPersonalDescendant != nil && *folder.IsPersonalDescendant) {
+				continue
+			}
+			folders = append(folders, folder)
+		}
+		if int64(len(page)) < limit {
+			return folders, nil
+		}
+	}
+}
+
+// folderPaths returns the slash-separated path of every folder, built from
+// the folders' names. A parent missing from folders ends the path.
+func folderPaths(folders []v4.Folder) map[string]string {
+	byID := make(map[string]v4.Folder, len(folders))
+	for _, folder := range folders {
+		byID[*folder.Id] = folder
+	}
+	paths := make(map[string]string, len(folders))
+	var pathOf func(id string, depth int) string
+	pathOf = func(id string, depth int) string {
+		if p, ok := paths[id]; ok {
+			return p
+		}
+		folder := byID[id]
+		p := folder.Name
+		// The depth guard stops on a malformed parent cycle.
+		if folder.ParentId != nil && depth < len(folders) {
+			if _, ok := byID[*folder.ParentId]; ok {
+				p = pathOf(*folder.ParentId, depth+1) + "/" + p
+			}
+		}
+		paths[id] = p
+		return p
+	}
+	for id := range byID {
+		pathOf(id, 0)
+	}
+	return paths
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *contentAccessAuditDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data contentAccessAuditModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folders, err := sharedFolders(d.client.SDK(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+	paths := folderPaths(folders)
+
+	grants := []accessAuditItemModel{}
+	for _, folder := range folders {
+		if folder.ContentMetadataId == nil {
+			continue
+		}
+		metadataID := *folder.ContentMetadataId
+		err := forEachContentAccess(ctx, d.client, metadataID, func(grant v4.ContentMetaGroupUser) error {
+			inherited := grant.ContentMetadataId != nil && *grant.ContentMetadataId != metadataID
+			if inherited && data.DirectOnly.ValueBool() {
+				return nil
+			}
+			item := accessAuditItemModel{
+				FolderID:          types.StringPointerValue(folder.Id),
+				FolderName:        types.StringValue(folder.Name),
+				FolderPath:        types.StringValue(paths[*folder.Id]),
+				ContentMetadataID: types.StringValue(metadataID),
+				AccessLevel:       types.StringNull(),
+				Inherited:         types.BoolValue(inherited),
+			}
+			switch {
+			case grant.GroupId != nil:
+				item.PrincipalType = types.StringValue("group")
+				item.PrincipalID = types.StringValue(*grant.GroupId)
+			case grant.UserId != nil:
+				item.PrincipalType = types.StringValue("user")
+				item.PrincipalID = types.StringValue(*grant.UserId)
+			default:
+				return nil
+			}
+			if grant.PermissionType != nil {
+				item.AccessLevel = types.StringValue(string(*grant.PermissionType))
+			}
+			grants = append(grants, item)
+			return nil
+		})
+		if err != nil {
+			if isNotFound(err) {
+				// The folder was deleted while the instance was walked.
+				continue
+			}
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to list access grants of folder %s: %v", *folder.Id, err))
+			return
+		}
+	}
+
+	grantsList, diags := types.ListValueFrom(ctx, accessAuditObjectType, grants)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Grants = grantsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// Content types carried by an exported content document.
+const (
+	contentTypeDashboard = "dashboard"
+	contentTypeLook      = "look"
+)
+
+// contentExport is the JSON document produced by looker_content_export and
+// consumed by looker_content_copy. Dashboards travel as their LookML, looks as
+// their query definition.
+type contentExport struct {
+	Type        string         `json:"type"`
+	Title       string         `json:"title"`
+	Description string         `json:"description,omitempty"`
+	LookML      string         `json:"lookml,omitempty"`
+	Query       *v4.WriteQuery `json:"query,omitempty"`
+}
+
+// contentExportDataSource is the data source implementation.
+type contentExportDataSource struct {
+	baseDataSource
+}
+
+// contentExportModel maps the data source schema data.
+type contentExportModel struct {
+	DashboardID types.String `tfsdk:"dashboard_id"`
+	LookID      types.String `tfsdk:"look_id"`
+	ContentType types.String `tfsdk:"content_type"`
+	Title       types.String `tfsdk:"title"`
+	Content     types.String `tfsdk:"content"`
+}
+
+// NewContentExportDataSource is a helper function to simplify the provider implementation.
+func NewContentExportDataSource() datasource.DataSource {
+	return &contentExportDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *contentExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_export"
+}
+
+// Schema defines the schema for the data source.
+func (d *contentExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exports a dashboard or look as a portable JSON document that `looker_content_copy` can import on another Looker instance.",
+		Attributes: map[string]schema.Attribute{
+			"dashboard_id": schema.StringAttribute{
+				Description: "The ID of the user-defined dashboard to export. Exactly one of `dashboard_id` or `look_id` must be set.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("look_id")),
+				},
+			},
+			"look_id": schema.StringAttribute{
+				Description: "The ID of the look to export.",
+				Optional:    true,
+			},
+			"content_type": schema.StringAttribute{
+				Description: "The type of the exported content, `dashboard` or `look`.",
+				Computed:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the exported content.",
+				Computed:    true,
+			},
+			"content": schema.StringAttribute{
+				Description: "The exported content as a JSON document.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *contentExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data contentExportModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var export contentExport
+	if !data.DashboardID.IsNull() {
+		dashboardID := data.DashboardID.ValueString()
+		lookml, err := d.client.SDK(ctx).DashboardLookml(dashboardID, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to export dashboard %s: %v", dashboardID, err))
+			return
+		}
+		dashboard, err := d.client.SDK(ctx).Dashboard(dashboardID, "title,description", nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read dashboard %s: %v", dashboardID, err))
+			return
+		}
+		export = contentExport{Type: contentTypeDashboard, LookML: *lookml.Lookml}
+		if dashboard.Title != nil {
+			export.Title = *dashboard.Title
+		}
+		if dashboard.Description != nil {
+			export.Description = *dashboard.Description
+		}
+	} else {
+		lookID := data.LookID.ValueString()
+		look, err := d.client.SDK(ctx).Look(lookID, "title,description,query", nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to export look %s: %v", lookID, err))
+			return
+		}
+		if look.Query == nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Look %s has no query to export", lookID))
+			return
+		}
+		query := look.Query
+		export = contentExport{Type: contentTypeLook, Query: &v4.WriteQuery{
+			Model:            query.Model,
+			View:             query.View,
+			Fields:           query.Fields,
+			Pivots:           query.Pivots,
+			FillFields:       query.FillFields,
+			Filters:          query.Filters,
+			FilterExpression: query.FilterExpression,
+			Sorts:            query.Sorts,
+			Limit:            query.Limit,
+			ColumnLimit:      query.ColumnLimit,
+			Total:            query.Total,
+			RowTotal:         query.RowTotal,
+			Subtotals:        query.Subtotals,
+			VisConfig:        query.VisConfig,
+			DynamicFields:    query.DynamicFields,
+			QueryTimezone:    query.QueryTimezone,
+		}}
+		if look.Title != nil {
+			export.Title = *look.Title
+		}
+		if look.Description != nil {
+			export.Description = *look.Description
+		}
+	}
+
+	content, err := json.Marshal(export)
+	if err != nil {
+		resp.Diagnostics.AddError("Export error", fmt.Sprintf("Failed to encode exported content: %v", err))
+		return
+	}
+	data.ContentType = types.StringValue(export.Type)
+	data.Title = types.StringValue(export.Title)
+	data.Content = types.StringValue(string(content))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+const contentSearchPageSize = 500
+
+// contentSearchTypes are the content types the search covers.
+var contentSearchTypes = []string{"dashboard", "look", "board"}
+
+// contentObjectType is the object type of an entry in the `content` list.
+var contentObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"type":                types.StringType,
+	"id":                  types.StringType,
+	"title":               types.StringType,
+	"description":         types.StringType,
+	"folder_id":           types.StringType,
+	"user_id":             types.StringType,
+	"content_metadata_id": types.StringType,
+	"web_url":             types.StringType,
+}}
+
+// contentSearchDataSource is the data source implementation.
+type contentSearchDataSource struct {
+	baseDataSource
+}
+
+// contentSearchModel maps the data source schema data.
+type contentSearchModel struct {
+	Term     types.String `tfsdk:"term"`
+	Types    types.Set    `tfsdk:"types"`
+	FolderID types.String `tfsdk:"folder_id"`
+	UserID   types.String `tfsdk:"user_id"`
+	Content  types.List   `tfsdk:"content"`
+}
+
+// contentItemModel maps an entry of the `content` list.
+type contentItemModel struct {
+	Type              types.String `tfsdk:"type"`
+	ID                types.String `tfsdk:"id"`
+	Title             types.String `tfsdk:"title"`
+	Description       types.String `tfsdk:"description"`
+	FolderID          types.String `tfsdk:"folder_id"`
+	UserID            types.String `tfsdk:"user_id"`
+	ContentMetadataID types.String `tfsdk:"content_metadata_id"`
+	WebURL            types.String `tfsdk:"web_url"`
+}
+
+// NewContentSearchDataSource is a helper function to simplify the provider implementation.
+func NewContentSearchDataSource() datasource.DataSource {
+	return &contentSearchDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *contentSearchDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_search"
+}
+
+// Schema defines the schema for the data source.
+func (d *contentSearchDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Searches Looker dashboards, looks and boards. All filters are optional and combined with AND.",
+		Attributes: map[string]schema.Attribute{
+			"term": schema.StringAttribute{
+				Description: "Text the title must contain, case-insensitively. Supports Looker search wildcards, e.g. `Sales%2024`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"types": schema.SetAttribute{
+				Description: "Content types to search: `dashboard`, `look` and/or `board`. Defaults to all of them.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(contentSearchTypes...)),
+				},
+			},
+			"folder_id": schema.StringAttribute{
+				Description: "Only return content stored directly in this folder. Boards do not live in folders and are never returned with this filter.",
+				Optional:    true,
+			},
+			"user_id": schema.StringAttribute{
+				Description: "Only return content owned by this user.",
+				Optional:    true,
+			},
+			"content": schema.ListNestedAttribute{
+				Description: "The matching content, dashboards first, then looks, then boards.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type":                schema.StringAttribute{Computed: true},
+						"id":                  schema.StringAttribute{Computed: true},
+						"title":               schema.StringAttribute{Computed: true},
+						"description":         schema.StringAttribute{Computed: true},
+						"folder_id":           schema.StringAttribute{Computed: true},
+						"user_id":             schema.StringAttribute{Computed: true},
+						"content_metadata_id": schema.StringAttribute{Computed: true},
+						"web_url":             schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *contentSearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data contentSearchModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	wanted := map[string]bool{}
+	if data.Types.IsNull() {
+		for _, t := range contentSearchTypes {
+			wanted[t] = true
+		}
+	} else {
+		var selected []string
+		resp.Diagnostics.Append(data.Types.ElementsAs(ctx, &selected, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, t := range selected {
+			wanted[t] = true
+		}
+	}
+
+	var title *string
+	if !data.Term.IsNull() {
+		pattern := "%" + data.Term.ValueString() + "%"
+		title = &pattern
+	}
+
+	sdk := d.client.SDK(ctx)
+	sorts := "id"
+	limit := int64(contentSearchPageSize)
+	content := []contentItemModel{}
+
+	if wanted["dashboard"] {
+		fields := "id,title,description,folder_id,user_id,content_metadata_id"
+		search := v4.RequestSearchDashboards{
+			Title:    title,
+			FolderId: data.FolderID.ValueStringPointer(),
+			UserId:   data.UserID.ValueStringPointer(),
+			Fields:   &fields,
+			Sorts:    &sorts,
+			Limit:    &limit,
+		}
+		for offset := int64(0); ; offset += limit {
+			search.Offset = &offset
+			page, err := sdk.SearchDashboards(search, nil)
+			if err != nil {
+				resp.Diagnostics.AddError("API error", fmt.Sprintf("Dashboard search failed: %v", err))
+				return
+			}
+			for _, dashboard := range page {
+				content = append(content, contentItemModel{
+					Type:              types.StringValue("dashboard"),
+					ID:                types.StringPointerValue(dashboard.Id),
+					Title:             types.StringPointerValue(dashboard.Title),
+					Description:       types.StringPointerValue(dashboard.Description),
+					FolderID:          types.StringPointerValue(dashboard.FolderId),
+					UserID:            types.StringPointerValue(dashboard.UserId),
+					ContentMetadataID: types.StringPointerValue(dashboard.ContentMetadataId),
+					WebURL:            d.client.webURL("dashboards", dashboard.Id),
+				})
+			}
+			if int64(len(page)) < limit {
+				break
+			}
+		}
+	}
+
+	if wanted["look"] {
+		fields := "id,title,description,folder_id,user_id,content_metadata_id"
+		search := v4.RequestSearchLooks{
+			Title:    title,
+			FolderId: data.FolderID.ValueStringPointer(),
+			UserId:   data.UserID.ValueStringPointer(),
+			Fields:   &fields,
+			Sorts:    &sorts,
+			Limit:    &limit,
+		}
+		for offset := int64(0); ; offset += limit {
+			search.Offset = &offset
+			page, err := sdk.SearchLooks(search, nil)
+			if err != nil {
+				resp.Diagnostics.AddError("API error", fmt.Sprintf("Look search failed: %v", err))
+				return
+			}
+			for _, look := range page {
+				content = append(content, contentItemModel{
+					Type:              types.StringValue("look"),
+					ID:                types.StringPointerValue(look.Id),
+					Title:             types.StringPointerValue(look.Title),
+					Description:       types.StringPointerValue(look.Description),
+					FolderID:          types.StringPointerValue(look.FolderId),
+					UserID:            types.StringPointerValue(look.UserId),
+					ContentMetadataID: types.StringPointerValue(look.ContentMetadataId),
+					WebURL:            d.client.webURL("looks", look.Id),
+				})
+			}
+			if int64(len(page)) < limit {
+				break
+			}
+		}
+	}
+
+	if wanted["board"] && data.FolderID.IsNull() {
+		fields := "id,title,description,user_id,content_metadata_id"
+		search := v4.RequestSearchBoards{
+			Title:     title,
+			CreatorId: data.UserID.ValueStringPointer(),
+			Fields:    &fields,
+			Sorts:     &sorts,
+			Limit:     &limit,
+		}
+		for offset := int64(0); ; offset += limit {
+			search.Offset = &offset
+			page, err := sdk.SearchBoards(search, nil)
+			if err != nil {
+				resp.Diagnostics.AddError("API error", fmt.Sprintf("Board search failed: %v", err))
+				return
+			}
+			for _, board := range page {
+				content = append(content, contentItemModel{
+					Type:              types.StringValue("board"),
+					ID:                types.StringPointerValue(board.Id),
+					Title:             types.StringPointerValue(board.Title),
+					Description:       types.StringPointerValue(board.Description),
+					FolderID:          types.StringNull(),
+					UserID:            types.StringPointerValue(board.UserId),
+					ContentMetadataID: types.StringPointerValue(board.ContentMetadataId),
+					WebURL:            d.client.webURL("boards", board.Id),
+				})
+			}
+			if int64(len(page)) < limit {
+				break
+			}
+		}
+	}
+
+	contentList, diags := types.ListValueFrom(ctx, contentObjectType, content)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Content = contentList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
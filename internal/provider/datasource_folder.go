@@ -3,25 +3,34 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
 )
 
 // folderDataSource is the data source implementation.
 type folderDataSource struct {
-	sdk *v4.LookerSDK
+	baseDataSource
 }
 
 // folderDataSourceModel maps the data source schema data.
 type folderDataSourceModel struct {
 	ID                types.String `tfsdk:"id"`
 	Name              types.String `tfsdk:"name"`
+	Path              types.String `tfsdk:"path"`
 	ParentID          types.String `tfsdk:"parent_id"`
 	ContentMetadataID types.String `tfsdk:"content_metadata_id"`
 	IsPersonal        types.Bool   `tfsdk:"is_personal"`
+	WebURL            types.String `tfsdk:"web_url"`
 }
 
 // NewFolderDataSource is a helper function.
@@ -37,26 +46,39 @@ func (d *folderDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 // Schema defines the schema for the data source.
 func (d *folderDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Provides information about a Looker folder (space). Specify `id` to look up by ID, or both `name` and `parent_id` to look up by name within a parent.",
+		MarkdownDescription: "Provides information about a Looker folder (space). Specify `id` to look up by ID, both `name` and `parent_id` to look up by name within a parent, or `path` to look up by path, e.g. `Shared/Finance/Quarterly`.",
 		Attributes: map[string]schema.Attribute{
-			"id":                  schema.StringAttribute{Optional: true, Computed: true},
-			"name":                schema.StringAttribute{Optional: true, Computed: true},
+			"id":   schema.StringAttribute{Optional: true, Computed: true},
+			"name": schema.StringAttribute{Optional: true, Computed: true},
+			"path": schema.StringAttribute{
+				Description: "Slash-separated folder names, e.g. `Shared/Finance/Quarterly`. The path starts at a top-level folder such as `Shared`, or below `parent_id` when it is set.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(folderTreePathPattern, "must be a slash-separated path without empty segments"),
+				},
+			},
 			"parent_id":           schema.StringAttribute{Optional: true, Computed: true},
 			"content_metadata_id": schema.StringAttribute{Computed: true},
 			"is_personal":         schema.BoolAttribute{Computed: true},
+			"web_url":             schema.StringAttribute{Description: "Link to the folder in the Looker web UI.", Computed: true},
 		},
 	}
 }
 
-// Configure adds the provider configured client to the data source.
-func (d *folderDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if cb, ok := req.ProviderData.(*clientBundle); ok && cb.SDK != nil {
-		d.sdk = cb.SDK
+// ConfigValidators requires exactly one way of identifying the folder, so that
+// conflicting input is rejected at plan time.
+func (d *folderDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name"), path.MatchRoot("path")),
 	}
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *folderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
 	var data folderDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -67,7 +89,7 @@ func (d *folderDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	var err error
 
 	if !data.ID.IsNull() && data.ID.ValueString() != "" {
-		f, e := d.sdk.Folder(data.ID.ValueString(), "", nil)
+		f, e := d.client.SDK(ctx).Folder(data.ID.ValueString(), "", nil)
 		err = e
 		if err == nil {
 			folder = &f
@@ -75,7 +97,7 @@ func (d *folderDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	} else if !data.Name.IsNull() && !data.ParentID.IsNull() {
 		name := data.Name.ValueString()
 		parentID := data.ParentID.ValueString()
-		results, e := d.sdk.SearchFolders(v4.RequestSearchFolders{Name: &name, ParentId: &parentID}, nil)
+		results, e := d.client.SDK(ctx).SearchFolders(v4.RequestSearchFolders{Name: &name, ParentId: &parentID}, nil)
 		err = e
 		if err == nil {
 			if len(results) == 0 {
@@ -88,8 +110,14 @@ func (d *folderDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			}
 			folder = &results[0]
 		}
+	} else if !data.Path.IsNull() {
+		f, e := folderByPath(d.client.SDK(ctx), data.ParentID.ValueStringPointer(), data.Path.ValueString())
+		err = e
+		if err == nil {
+			folder = &f
+		}
 	} else {
-		resp.Diagnostics.AddError("Invalid input", "You must provide either `id` or both `name` and `parent_id`.")
+		resp.Diagnostics.AddError("Invalid input", "You must provide either `id`, both `name` and `parent_id`, or `path`.")
 		return
 	}
 
@@ -104,6 +132,45 @@ func (d *folderDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	data.ParentID = types.StringPointerValue(folder.ParentId)
 	data.ContentMetadataID = types.StringPointerValue(folder.ContentMetadataId)
 	data.IsPersonal = types.BoolPointerValue(folder.IsPersonal)
+	data.WebURL = d.client.webURL("folders", folder.Id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// folderByPath resolves a slash-separated path of folder names one level at a
+// time. The first name is looked up below parentID, or among the top-level
+// folders such as Shared when parentID is nil.
+func folderByPath(sdk lookerapi.Client, parentID *string, folderPath string) (v4.Folder, error) {
+	var folder v4.Folder
+	fields := "id,name,parent_id,content_metadata_id,is_personal"
+	for i, name := range strings.Split(folderPath, "/") {
+		name := name
+		results, err := sdk.SearchFolders(v4.RequestSearchFolders{Name: &name, ParentId: parentID, Fields: &fields}, nil)
+		if err != nil {
+			return folder, err
+		}
+		// The search is case-insensitive and treats % and _ as wildcards, and
+		// without a parent it spans every level.
+		var matches []v4.Folder
+		for _, f := range results {
+			if f.Name != name {
+				continue
+			}
+			if parentID == nil && f.ParentId != nil && *f.ParentId != "" {
+				continue
+			}
+			matches = append(matches, f)
+		}
+		prefix := strings.Join(strings.Split(folderPath, "/")[:i+1], "/")
+		switch len(matches) {
+		case 0:
+			return folder, fmt.Errorf("no folder found at %q", prefix)
+		case 1:
+			folder = matches[0]
+			parentID = folder.Id
+		default:
+			return folder, fmt.Errorf("found %d folders at %q, look the folder up by id instead", len(matches), prefix)
+		}
+	}
+	return folder, nil
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-looker/internal/lookertest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFolderDataSource(t *testing.T) {
+	_, fixtures := testAccSeededServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "looker_folder" "by_path" {
+  path = "Shared/%s"
+}
+
+data "looker_folder" "by_name" {
+  name      = %q
+  parent_id = %q
+}
+`, lookertest.FixtureFolderName, lookertest.FixtureFolderName, lookertest.SharedFolderID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.looker_folder.by_path", "id", fixtures.FolderID),
+					resource.TestCheckResourceAttr("data.looker_folder.by_path", "parent_id", lookertest.SharedFolderID),
+					resource.TestCheckResourceAttrSet("data.looker_folder.by_path", "content_metadata_id"),
+					resource.TestCheckResourceAttr("data.looker_folder.by_name", "id", fixtures.FolderID),
+				),
+			},
+		},
+	})
+}
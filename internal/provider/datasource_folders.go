@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+const (
+	folderChildrenFields   = "id,name,parent_id,content_metadata_id"
+	folderChildrenPageSize = 500
+)
+
+// folderObjectType is the object type of an entry in the `folders` list.
+var folderObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":                  types.StringType,
+	"name":                types.StringType,
+	"parent_id":           types.StringType,
+	"path":                types.StringType,
+	"content_metadata_id": types.StringType,
+	"web_url":             types.StringType,
+}}
+
+// foldersDataSource is the data source implementation.
+type foldersDataSource struct {
+	baseDataSource
+}
+
+// foldersModel maps the data source schema data.
+type foldersModel struct {
+	ParentID  types.String `tfsdk:"parent_id"`
+	Recursive types.Bool   `tfsdk:"recursive"`
+	IDs       types.List   `tfsdk:"ids"`
+	Folders   types.List   `tfsdk:"folders"`
+}
+
+// folderItemModel maps an entry of the `folders` list.
+type folderItemModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	ParentID          types.String `tfsdk:"parent_id"`
+	Path              types.String `tfsdk:"path"`
+	ContentMetadataID types.String `tfsdk:"content_metadata_id"`
+	WebURL            types.String `tfsdk:"web_url"`
+}
+
+// NewFoldersDataSource is a helper function to simplify the provider implementation.
+func NewFoldersDataSource() datasource.DataSource {
+	return &foldersDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *foldersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folders"
+}
+
+// Schema defines the schema for the data source.
+func (d *foldersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the subfolders of a Looker folder, optionally the whole subtree.",
+		Attributes: map[string]schema.Attribute{
+			"parent_id": schema.StringAttribute{
+				Description: "The ID of the folder whose subfolders are listed.",
+				Required:    true,
+			},
+			"recursive": schema.BoolAttribute{
+				Description: "If true, list every descendant instead of only the direct children. Defaults to `false`.",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "IDs of the folders.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"folders": schema.ListNestedAttribute{
+				Description: "The folders, parents before their children.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                  schema.StringAttribute{Computed: true},
+						"name":                schema.StringAttribute{Computed: true},
+						"parent_id":           schema.StringAttribute{Computed: true},
+						"path":                schema.StringAttribute{Description: "Slash-separated folder names relative to parent_id.", Computed: true},
+						"content_metadata_id": schema.StringAttribute{Computed: true},
+						"web_url":             schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *foldersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data foldersModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sdk := d.client.SDK(ctx)
+	fields := folderChildrenFields
+	sorts := "name"
+	limit := int64(folderChildrenPageSize)
+
+	type pending struct{ id, path string }
+	queue := []pending{{id: data.ParentID.ValueString()}}
+	ids := []string{}
+	folders := []folderItemModel{}
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+
+		request := v4.RequestFolderChildren{FolderId: parent.id, Fields: &fields, Sorts: &sorts, Limit: &limit}
+		for offset := int64(0); ; offset += limit {
+			request.Offset = &offset
+			page, err := sdk.FolderChildren(request, nil)
+			if err != nil {
+				resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to list subfolders of folder %s: %v", parent.id, err))
+				return
+			}
+			for _, folder := range page {
+				folderPath := folder.Name
+				if parent.path != "" {
+					folderPath = parent.path + "/" + folder.Name
+				}
+				ids = append(ids, *folder.Id)
+				folders = append(folders, folderItemModel{
+					ID:                types.StringPointerValue(folder.Id),
+					Name:              types.StringValue(folder.Name),
+					ParentID:          types.StringPointerValue(folder.ParentId),
+					Path:              types.StringValue(folderPath),
+					ContentMetadataID: types.StringPointerValue(folder.ContentMetadataId),
+					WebURL:            d.client.webURL("folders", folder.Id),
+				})
+				if data.Recursive.ValueBool() {
+					queue = append(queue, pending{id: *folder.Id, path: folderPath})
+				}
+			}
+			if int64(len(page)) < limit {
+				break
+			}
+		}
+	}
+
+	idsList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	foldersList, diags := types.ListValueFrom(ctx, folderObjectType, folders)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.IDs = idsList
+	data.Folders = foldersList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
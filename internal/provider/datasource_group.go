@@ -4,26 +4,33 @@ import (
 	"context"
 	"fmt"
 
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
 )
 
-const groupDataSourceFields = "id,name,user_count"
+const groupDataSourceFields = "id,name,user_count,externally_managed,external_group_id"
 
 // groupDataSource is the data source implementation.
 type groupDataSource struct {
-	sdk *v4.LookerSDK
+	baseDataSource
 }
 
 // groupModel maps the data source schema data.
-// NOTE: RoleIDs has been removed as it cannot be fetched efficiently.
+// Role IDs are provided by the looker_group_with_roles data source.
 type groupModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	UserCount types.Int64  `tfsdk:"user_count"`
-	UserIDs   types.Set    `tfsdk:"user_ids"`
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	ExternalGroupID   types.String `tfsdk:"external_group_id"`
+	ExternallyManaged types.Bool   `tfsdk:"externally_managed"`
+	UserCount         types.Int64  `tfsdk:"user_count"`
+	UserIDs           types.Set    `tfsdk:"user_ids"`
 }
 
 // NewGroupDataSource is a helper function to simplify the provider implementation.
@@ -39,10 +46,19 @@ func (d *groupDataSource) Metadata(_ context.Context, req datasource.MetadataReq
 // Schema defines the schema for the data source.
 func (d *groupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Provides information about a Looker group and its user membership. Note: Role assignments cannot be read via this data source due to Looker API limitations.",
+		MarkdownDescription: "Provides information about a Looker group and its user membership. Use the `looker_group_with_roles` data source to read the roles assigned to the group.",
 		Attributes: map[string]schema.Attribute{
 			"id":   schema.StringAttribute{Optional: true, Computed: true},
 			"name": schema.StringAttribute{Optional: true, Computed: true},
+			"external_group_id": schema.StringAttribute{
+				Description: "ID of the group in the external system, e.g. the SAML or LDAP group it is mapped to.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"externally_managed": schema.BoolAttribute{
+				Description: "Whether the membership of the group is controlled outside of Looker, e.g. by SAML.",
+				Computed:    true,
+			},
 			"user_count": schema.Int64Attribute{
 				Description: "Number of users in the group.",
 				Computed:    true,
@@ -56,19 +72,17 @@ func (d *groupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 	}
 }
 
-// Configure adds the provider configured client to the data source.
-func (d *groupDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if cb, ok := req.ProviderData.(*clientBundle); ok && cb.SDK != nil {
-		d.sdk = cb.SDK
-	} else if req.ProviderData != nil {
-		resp.Diagnostics.AddError("Unexpected provider data", "Missing Looker SDK client")
+// ConfigValidators requires exactly one way of identifying the group, so that
+// conflicting input is rejected at plan time.
+func (d *groupDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name"), path.MatchRoot("external_group_id")),
 	}
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	if d.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !d.configured(&resp.Diagnostics) {
 		return
 	}
 
@@ -78,39 +92,19 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	var group v4.Group
-	var err error
-
-	if !data.ID.IsNull() && data.ID.ValueString() != "" {
-		group, err = d.sdk.Group(data.ID.ValueString(), groupDataSourceFields, nil)
-	} else if !data.Name.IsNull() && data.Name.ValueString() != "" {
-		name := data.Name.ValueString()
-		fields := groupDataSourceFields
-		results, e := d.sdk.SearchGroups(v4.RequestSearchGroups{Name: &name, Fields: &fields}, nil)
-		err = e
-		if err == nil {
-			if len(results) == 0 {
-				resp.Diagnostics.AddError("Not found", fmt.Sprintf("No group named %q", name))
-				return
-			}
-			group = results[0]
-		}
-	} else {
-		resp.Diagnostics.AddError("Invalid input", "You must provide either `id` or `name`.")
-		return
-	}
-
-	if err != nil {
-		resp.Diagnostics.AddError("API error", fmt.Sprintf("Group lookup failed: %v", err))
+	group, ok := lookupGroup(d.client.SDK(ctx), data.ID, data.Name, data.ExternalGroupID, groupDataSourceFields, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 
 	data.ID = types.StringPointerValue(group.Id)
 	data.Name = types.StringPointerValue(group.Name)
+	data.ExternalGroupID = types.StringPointerValue(group.ExternalGroupId)
+	data.ExternallyManaged = types.BoolValue(group.ExternallyManaged != nil && *group.ExternallyManaged)
 	data.UserCount = types.Int64PointerValue(group.UserCount)
 
 	// Fetch users, which is available directly
-	groupUsers, err := d.sdk.AllGroupUsers(v4.RequestAllGroupUsers{GroupId: *group.Id}, nil)
+	groupUsers, err := allGroupUsers(d.client.SDK(ctx), *group.Id, "id")
 	if err != nil {
 		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to get users for group %s: %v", *group.Id, err))
 		return
@@ -128,3 +122,57 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// lookupGroup finds a group by ID, by name or by external group ID, in that
+// order of preference, and reports any problem in diags.
+func lookupGroup(sdk lookerapi.Client, id, name, externalGroupID types.String, fields string, diags *diag.Diagnostics) (v4.Group, bool) {
+	var group v4.Group
+	var err error
+
+	if !id.IsNull() && id.ValueString() != "" {
+		group, err = sdk.Group(id.ValueString(), fields, nil)
+	} else if !name.IsNull() && name.ValueString() != "" {
+		name := name.ValueString()
+		results, e := sdk.SearchGroups(v4.RequestSearchGroups{Name: &name, Fields: &fields}, nil)
+		err = e
+		if err == nil {
+			if len(results) == 0 {
+				diags.AddError("Not found", fmt.Sprintf("No group named %q", name))
+				return group, false
+			}
+			group = results[0]
+		}
+	} else if !externalGroupID.IsNull() && externalGroupID.ValueString() != "" {
+		externalID := externalGroupID.ValueString()
+		results, e := sdk.SearchGroups(v4.RequestSearchGroups{ExternalGroupId: &externalID, Fields: &fields}, nil)
+		err = e
+		if err == nil {
+			// The search treats _ and % as wildcards, so only exact matches
+			// are kept.
+			var matches []v4.Group
+			for _, g := range results {
+				if g.ExternalGroupId != nil && *g.ExternalGroupId == externalID {
+					matches = append(matches, g)
+				}
+			}
+			if len(matches) == 0 {
+				diags.AddError("Not found", fmt.Sprintf("No group with external group ID %q", externalID))
+				return group, false
+			}
+			if len(matches) > 1 {
+				diags.AddError("Multiple found", fmt.Sprintf("Found %d groups with external group ID %q", len(matches), externalID))
+				return group, false
+			}
+			group = matches[0]
+		}
+	} else {
+		diags.AddError("Invalid input", "You must provide either `id`, `name` or `external_group_id`.")
+		return group, false
+	}
+
+	if err != nil {
+		diags.AddError("API error", fmt.Sprintf("Group lookup failed: %v", err))
+		return group, false
+	}
+	return group, true
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-looker/internal/lookertest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGroupDataSource(t *testing.T) {
+	_, fixtures := testAccSeededServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "looker_group" "by_name" {
+  name = %q
+}
+
+data "looker_group" "by_id" {
+  id = %q
+}
+`, lookertest.FixtureGroupName, fixtures.GroupID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.looker_group.by_name", "id", fixtures.GroupID),
+					resource.TestCheckResourceAttr("data.looker_group.by_name", "user_count", "1"),
+					resource.TestCheckTypeSetElemAttr("data.looker_group.by_name", "user_ids.*", fixtures.UserID),
+					resource.TestCheckResourceAttr("data.looker_group.by_id", "name", lookertest.FixtureGroupName),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// groupUsersCountDataSource is the data source implementation.
+type groupUsersCountDataSource struct {
+	baseDataSource
+}
+
+// groupUsersCountModel maps the data source schema data.
+type groupUsersCountModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	UserCount types.Int64  `tfsdk:"user_count"`
+}
+
+// NewGroupUsersCountDataSource is a helper function to simplify the provider implementation.
+func NewGroupUsersCountDataSource() datasource.DataSource {
+	return &groupUsersCountDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *groupUsersCountDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_users_count"
+}
+
+// Schema defines the schema for the data source.
+func (d *groupUsersCountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the number of users in a Looker group without fetching the member list. Use it instead of `looker_group` when only the group size matters.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the group. Either `id` or `name` must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the group. Either `id` or `name` must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"user_count": schema.Int64Attribute{
+				Description: "Number of users in the group.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *groupUsersCountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data groupUsersCountModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, ok := lookupGroup(d.client.SDK(ctx), data.ID, data.Name, types.StringNull(), "id,name,user_count", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	data.ID = types.StringPointerValue(group.Id)
+	data.Name = types.StringPointerValue(group.Name)
+	data.UserCount = types.Int64PointerValue(group.UserCount)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+const groupWithRolesFields = "id,name,user_count,roles"
+
+// groupWithRolesDataSource is the data source implementation.
+type groupWithRolesDataSource struct {
+	baseDataSource
+}
+
+// groupWithRolesModel maps the data source schema data.
+type groupWithRolesModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	UserCount types.Int64  `tfsdk:"user_count"`
+	RoleIDs   types.Set    `tfsdk:"role_ids"`
+	RoleNames types.Set    `tfsdk:"role_names"`
+}
+
+// NewGroupWithRolesDataSource is a helper function to simplify the provider implementation.
+func NewGroupWithRolesDataSource() datasource.DataSource {
+	return &groupWithRolesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *groupWithRolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_with_roles"
+}
+
+// Schema defines the schema for the data source.
+func (d *groupWithRolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides information about a Looker group and the roles assigned to it.",
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Optional: true, Computed: true},
+			"name": schema.StringAttribute{Optional: true, Computed: true},
+			"user_count": schema.Int64Attribute{
+				Description: "Number of users in the group.",
+				Computed:    true,
+			},
+			"role_ids": schema.SetAttribute{
+				Description: "IDs of the roles assigned to the group.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"role_names": schema.SetAttribute{
+				Description: "Names of the roles assigned to the group.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators requires exactly one way of identifying the group.
+func (d *groupWithRolesDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *groupWithRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data groupWithRolesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields := groupWithRolesFields
+	search := v4.RequestSearchGroupsWithRoles{Fields: &fields}
+	if !data.ID.IsNull() {
+		search.Id = data.ID.ValueStringPointer()
+	} else {
+		search.Name = data.Name.ValueStringPointer()
+	}
+	results, err := d.client.SDK(ctx).SearchGroupsWithRoles(search, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Group lookup failed: %v", err))
+		return
+	}
+	// The name search treats _ and % as wildcards, so only exact matches are
+	// kept.
+	var matches []v4.GroupSearch
+	for _, group := range results {
+		if data.ID.IsNull() && (group.Name == nil || *group.Name != data.Name.ValueString()) {
+			continue
+		}
+		matches = append(matches, group)
+	}
+	if len(matches) == 0 {
+		if data.ID.IsNull() {
+			resp.Diagnostics.AddError("Not found", fmt.Sprintf("No group named %q", data.Name.ValueString()))
+		} else {
+			resp.Diagnostics.AddError("Not found", fmt.Sprintf("No group with ID %s", data.ID.ValueString()))
+		}
+		return
+	}
+	group := matches[0]
+
+	roleIDs := []string{}
+	roleNames := []string{}
+	if group.Roles != nil {
+		for _, role := range *group.Roles {
+			if role.Id != nil {
+				roleIDs = append(roleIDs, *role.Id)
+			}
+			if role.Name != nil {
+				roleNames = append(roleNames, *role.Name)
+			}
+		}
+	}
+
+	data.ID = types.StringPointerValue(group.Id)
+	data.Name = types.StringPointerValue(group.Name)
+	data.UserCount = types.Int64PointerValue(group.UserCount)
+	roleIDsSet, diags := types.SetValueFrom(ctx, types.StringType, roleIDs)
+	resp.Diagnostics.Append(diags...)
+	roleNamesSet, diags := types.SetValueFrom(ctx, types.StringType, roleNames)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.RoleIDs = roleIDsSet
+	data.RoleNames = roleNamesSet
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
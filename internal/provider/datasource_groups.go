@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+const (
+	groupSearchFields   = "id,name,user_count,externally_managed"
+	groupSearchPageSize = 500
+)
+
+// groupObjectType is the object type of an entry in the `groups` list.
+var groupObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":                 types.StringType,
+	"name":               types.StringType,
+	"user_count":         types.Int64Type,
+	"externally_managed": types.BoolType,
+}}
+
+// groupsDataSource is the data source implementation.
+type groupsDataSource struct {
+	baseDataSource
+}
+
+// groupsModel maps the data source schema data.
+type groupsModel struct {
+	Name              types.String `tfsdk:"name"`
+	ExternallyManaged types.Bool   `tfsdk:"externally_managed"`
+	IDs               types.List   `tfsdk:"ids"`
+	Groups            types.List   `tfsdk:"groups"`
+}
+
+// groupItemModel maps an entry of the `groups` list.
+type groupItemModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	UserCount         types.Int64  `tfsdk:"user_count"`
+	ExternallyManaged types.Bool   `tfsdk:"externally_managed"`
+}
+
+// NewGroupsDataSource is a helper function to simplify the provider implementation.
+func NewGroupsDataSource() datasource.DataSource {
+	return &groupsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *groupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+// Schema defines the schema for the data source.
+func (d *groupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Searches Looker groups. All filters are optional and combined with AND.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Group name to match. Supports Looker search wildcards, e.g. `Finance%`.",
+				Optional:    true,
+			},
+			"externally_managed": schema.BoolAttribute{
+				Description: "Only return groups whose membership is (`true`) or is not (`false`) controlled outside of Looker, e.g. by SAML.",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "IDs of the matching groups.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"groups": schema.ListNestedAttribute{
+				Description: "The matching groups.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                 schema.StringAttribute{Computed: true},
+						"name":               schema.StringAttribute{Computed: true},
+						"user_count":         schema.Int64Attribute{Computed: true},
+						"externally_managed": schema.BoolAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data groupsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields := groupSearchFields
+	sorts := "id"
+	limit := int64(groupSearchPageSize)
+	search := v4.RequestSearchGroups{
+		Fields:            &fields,
+		Sorts:             &sorts,
+		Limit:             &limit,
+		Name:              data.Name.ValueStringPointer(),
+		ExternallyManaged: data.ExternallyManaged.ValueBoolPointer(),
+	}
+
+	ids := []string{}
+	groups := []groupItemModel{}
+	for offset := int64(0); ; offset += limit {
+		search.Offset = &offset
+		page, err := d.client.SDK(ctx).SearchGroups(search, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Group search failed: %v", err))
+			return
+		}
+		for _, group := range page {
+			ids = append(ids, *group.Id)
+			groups = append(groups, groupItemModel{
+				ID:                types.StringPointerValue(group.Id),
+				Name:              types.StringPointerValue(group.Name),
+				UserCount:         types.Int64PointerValue(group.UserCount),
+				ExternallyManaged: types.BoolValue(group.ExternallyManaged != nil && *group.ExternallyManaged),
+			})
+		}
+		if int64(len(page)) < limit {
+			break
+		}
+	}
+
+	idsList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	groupsList, diags := types.ListValueFrom(ctx, groupObjectType, groups)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.IDs = idsList
+	data.Groups = groupsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const homepageSectionFields = "id,title,description,is_header,item_order,homepage_items"
+
+// homepageItemObjectType is the object type of an entry in the `items` list.
+var homepageItemObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":                  types.StringType,
+	"section_id":          types.StringType,
+	"section_title":       types.StringType,
+	"title":               types.StringType,
+	"url":                 types.StringType,
+	"dashboard_id":        types.StringType,
+	"look_id":             types.StringType,
+	"lookml_dashboard_id": types.StringType,
+	"order":               types.Int64Type,
+}}
+
+// homepageItemsDataSource is the data source implementation.
+type homepageItemsDataSource struct {
+	baseDataSource
+}
+
+// homepageItemsModel maps the data source schema data.
+type homepageItemsModel struct {
+	AllowUnsupported types.Bool `tfsdk:"allow_unsupported"`
+	Supported        types.Bool `tfsdk:"supported"`
+	Items            types.List `tfsdk:"items"`
+}
+
+// homepageItemModel maps an entry of the `items` list.
+type homepageItemModel struct {
+	ID                types.String `tfsdk:"id"`
+	SectionID         types.String `tfsdk:"section_id"`
+	SectionTitle      types.String `tfsdk:"section_title"`
+	Title             types.String `tfsdk:"title"`
+	URL               types.String `tfsdk:"url"`
+	DashboardID       types.String `tfsdk:"dashboard_id"`
+	LookID            types.String `tfsdk:"look_id"`
+	LookmlDashboardID types.String `tfsdk:"lookml_dashboard_id"`
+	Order             types.Int64  `tfsdk:"order"`
+}
+
+// NewHomepageItemsDataSource is a helper function to simplify the provider implementation.
+func NewHomepageItemsDataSource() datasource.DataSource {
+	return &homepageItemsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *homepageItemsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_homepage_items"
+}
+
+// Schema defines the schema for the data source.
+func (d *homepageItemsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the items of the primary legacy homepage. Instances that have moved to boards no longer serve legacy homepages; reading this data source there fails with a capability error unless `allow_unsupported` is set, in which case `supported` is `false` and `items` is empty.",
+		Attributes: map[string]schema.Attribute{
+			"allow_unsupported": schema.BoolAttribute{
+				Description: "Return an empty result instead of an error on instances without legacy homepages.",
+				Optional:    true,
+			},
+			"supported": schema.BoolAttribute{
+				Description: "Whether the instance serves legacy homepages.",
+				Computed:    true,
+			},
+			"items": schema.ListNestedAttribute{
+				Description: "Items of the primary homepage, in section order.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                  schema.StringAttribute{Computed: true},
+						"section_id":          schema.StringAttribute{Computed: true},
+						"section_title":       schema.StringAttribute{Computed: true},
+						"title":               schema.StringAttribute{Computed: true},
+						"url":                 schema.StringAttribute{Computed: true},
+						"dashboard_id":        schema.StringAttribute{Computed: true},
+						"look_id":             schema.StringAttribute{Computed: true},
+						"lookml_dashboard_id": schema.StringAttribute{Computed: true},
+						"order":               schema.Int64Attribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *homepageItemsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data homepageItemsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items := []homepageItemModel{}
+	sections, err := d.client.SDK(ctx).AllPrimaryHomepageSections(homepageSectionFields, nil)
+	switch {
+	case isNotFound(err) && data.AllowUnsupported.ValueBool():
+		data.Supported = types.BoolValue(false)
+	case isNotFound(err):
+		resp.Diagnostics.AddError("Legacy homepages not supported",
+			"This Looker instance does not serve legacy homepages (they have been replaced by boards). "+
+				"Set `allow_unsupported = true` to read an empty result instead of failing.")
+		return
+	case err != nil:
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to list homepage sections: %v", err))
+		return
+	default:
+		data.Supported = types.BoolValue(true)
+		for _, section := range sections {
+			if section.HomepageItems == nil {
+				continue
+			}
+			for _, item := range *section.HomepageItems {
+				items = append(items, homepageItemModel{
+					ID:                types.StringPointerValue(item.Id),
+					SectionID:         types.StringPointerValue(section.Id),
+					SectionTitle:      types.StringPointerValue(section.Title),
+					Title:             types.StringPointerValue(item.Title),
+					URL:               types.StringPointerValue(item.Url),
+					DashboardID:       types.StringPointerValue(item.DashboardId),
+					LookID:            types.StringPointerValue(item.LookId),
+					LookmlDashboardID: types.StringPointerValue(item.LookmlDashboardId),
+					Order:             types.Int64PointerValue(item.Order),
+				})
+			}
+		}
+	}
+
+	itemsList, diags := types.ListValueFrom(ctx, homepageItemObjectType, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Items = itemsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
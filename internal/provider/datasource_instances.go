@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+)
+
+// instanceObjectType is the object type of an entry in the `instances` list.
+var instanceObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"base_url":               types.StringType,
+	"reachable":              types.BoolType,
+	"error":                  types.StringType,
+	"looker_release_version": types.StringType,
+	"api_server_url":         types.StringType,
+	"web_server_url":         types.StringType,
+	"user_id":                types.StringType,
+}}
+
+// instancesDataSource is the data source implementation.
+type instancesDataSource struct {
+	baseDataSource
+}
+
+// instancesModel maps the data source schema data.
+type instancesModel struct {
+	BaseURLs     types.List   `tfsdk:"base_urls"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	FailOnError  types.Bool   `tfsdk:"fail_on_error"`
+	Instances    types.List   `tfsdk:"instances"`
+}
+
+// instanceItemModel maps an entry of the `instances` list.
+type instanceItemModel struct {
+	BaseURL              types.String `tfsdk:"base_url"`
+	Reachable            types.Bool   `tfsdk:"reachable"`
+	Error                types.String `tfsdk:"error"`
+	LookerReleaseVersion types.String `tfsdk:"looker_release_version"`
+	APIServerURL         types.String `tfsdk:"api_server_url"`
+	WebServerURL         types.String `tfsdk:"web_server_url"`
+	UserID               types.String `tfsdk:"user_id"`
+}
+
+// NewInstancesDataSource is a helper function to simplify the provider implementation.
+func NewInstancesDataSource() datasource.DataSource {
+	return &instancesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *instancesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instances"
+}
+
+// Schema defines the schema for the data source.
+func (d *instancesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Verifies a fleet of Looker instances with a shared set of API credentials and returns normalized connection metadata for each, e.g. to template one provider alias per instance.",
+		Attributes: map[string]schema.Attribute{
+			"base_urls": schema.ListAttribute{
+				Description: "Base URLs of the instances to verify.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"client_id": schema.StringAttribute{
+				Description: "Client ID used for every instance. Defaults to the provider's client ID.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"client_secret": schema.StringAttribute{
+				Description: "Client secret used for every instance. Defaults to the provider's client secret.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"fail_on_error": schema.BoolAttribute{
+				Description: "If true, an instance that is invalid, unreachable or rejects the credentials fails the read. Otherwise the failure is reported in the instance's `error`.",
+				Optional:    true,
+			},
+			"instances": schema.ListNestedAttribute{
+				Description: "One entry per base URL, in the same order.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"base_url":               schema.StringAttribute{Computed: true, Description: "The normalized base URL."},
+						"reachable":              schema.BoolAttribute{Computed: true, Description: "Whether the instance accepted the credentials."},
+						"error":                  schema.StringAttribute{Computed: true, Description: "Why the instance could not be verified."},
+						"looker_release_version": schema.StringAttribute{Computed: true},
+						"api_server_url":         schema.StringAttribute{Computed: true},
+						"web_server_url":         schema.StringAttribute{Computed: true},
+						"user_id":                schema.StringAttribute{Computed: true, Description: "The ID of the API user the credentials authenticate as."},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *instancesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data instancesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var baseURLs []string
+	resp.Diagnostics.Append(data.BaseURLs.ElementsAs(ctx, &baseURLs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := d.client.settings
+	options := d.client.options
+	if !data.ClientID.IsNull() || !data.ClientSecret.IsNull() {
+		// Explicit credentials replace the provider's access token.
+		options.accessToken = ""
+	}
+	if !data.ClientID.IsNull() {
+		settings.ClientId = data.ClientID.ValueString()
+	}
+	if !data.ClientSecret.IsNull() {
+		settings.ClientSecret = data.ClientSecret.ValueString()
+	}
+
+	instances := make([]instanceItemModel, 0, len(baseURLs))
+	for _, raw := range baseURLs {
+		instance := instanceItemModel{
+			BaseURL:              types.StringValue(raw),
+			Reachable:            types.BoolValue(false),
+			Error:                types.StringNull(),
+			LookerReleaseVersion: types.StringNull(),
+			APIServerURL:         types.StringNull(),
+			WebServerURL:         types.StringNull(),
+			UserID:               types.StringNull(),
+		}
+		err := d.verify(ctx, settings, options, raw, &instance)
+		if err != nil {
+			if data.FailOnError.ValueBool() {
+				resp.Diagnostics.AddError("Instance verification failed", fmt.Sprintf("%s: %v", raw, err))
+				return
+			}
+			instance.Error = types.StringValue(err.Error())
+		}
+		instances = append(instances, instance)
+	}
+
+	instancesList, diags := types.ListValueFrom(ctx, instanceObjectType, instances)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Instances = instancesList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// verify logs in to the instance at raw and fills in its metadata.
+func (d *instancesDataSource) verify(ctx context.Context, settings rtl.ApiSettings, options clientOptions, raw string, instance *instanceItemModel) error {
+	baseURL, err := normalizeBaseURL(raw)
+	if err != nil {
+		return fmt.Errorf("invalid base URL: %w", err)
+	}
+	instance.BaseURL = types.StringValue(baseURL)
+
+	settings.BaseUrl = baseURL
+	sdk := newClientBundle(settings, options).SDK(ctx)
+
+	versions, err := sdk.Versions("", nil)
+	if err != nil {
+		return fmt.Errorf("failed to read versions: %w", err)
+	}
+	instance.LookerReleaseVersion = types.StringPointerValue(versions.LookerReleaseVersion)
+	instance.APIServerURL = types.StringPointerValue(versions.ApiServerUrl)
+	instance.WebServerURL = types.StringPointerValue(versions.WebServerUrl)
+
+	me, err := sdk.Me("id", nil)
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+	instance.UserID = types.StringPointerValue(me.Id)
+	instance.Reachable = types.BoolValue(true)
+	return nil
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// localeObjectType is the object type of an entry in the `locales` list.
+var localeObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"code":         types.StringType,
+	"native_name":  types.StringType,
+	"english_name": types.StringType,
+}}
+
+// localesDataSource is the data source implementation.
+type localesDataSource struct {
+	baseDataSource
+}
+
+// localesModel maps the data source schema data.
+type localesModel struct {
+	Codes   types.List `tfsdk:"codes"`
+	Locales types.List `tfsdk:"locales"`
+}
+
+// localeItemModel maps an entry of the `locales` list.
+type localeItemModel struct {
+	Code        types.String `tfsdk:"code"`
+	NativeName  types.String `tfsdk:"native_name"`
+	EnglishName types.String `tfsdk:"english_name"`
+}
+
+// NewLocalesDataSource is a helper function to simplify the provider implementation.
+func NewLocalesDataSource() datasource.DataSource {
+	return &localesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *localesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_locales"
+}
+
+// Schema defines the schema for the data source.
+func (d *localesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the locales supported by the Looker instance.",
+		Attributes: map[string]schema.Attribute{
+			"codes": schema.ListAttribute{
+				Description: "Codes of the supported locales, e.g. `en` or `pt_BR`.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"locales": schema.ListNestedAttribute{
+				Description: "The supported locales.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code":         schema.StringAttribute{Computed: true},
+						"native_name":  schema.StringAttribute{Computed: true},
+						"english_name": schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *localesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	results, err := d.client.SDK(ctx).AllLocales(nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to list locales: %v", err))
+		return
+	}
+
+	codes := []string{}
+	locales := []localeItemModel{}
+	for _, locale := range results {
+		if locale.Code == nil {
+			continue
+		}
+		codes = append(codes, *locale.Code)
+		locales = append(locales, localeItemModel{
+			Code:        types.StringPointerValue(locale.Code),
+			NativeName:  types.StringPointerValue(locale.NativeName),
+			EnglishName: types.StringPointerValue(locale.EnglishName),
+		})
+	}
+
+	codesList, diags := types.ListValueFrom(ctx, types.StringType, codes)
+	resp.Diagnostics.Append(diags...)
+	localesList, diags := types.ListValueFrom(ctx, localeObjectType, locales)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data := localesModel{Codes: codesList, Locales: localesList}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// lookmlDashboardElementObjectType is the object type of an entry in the `elements` list.
+var lookmlDashboardElementObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":       types.StringType,
+	"title":    types.StringType,
+	"type":     types.StringType,
+	"look_id":  types.StringType,
+	"query_id": types.StringType,
+	"edit_uri": types.StringType,
+}}
+
+// lookmlDashboardFilterObjectType is the object type of an entry in the `filters` list.
+var lookmlDashboardFilterObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"name":          types.StringType,
+	"title":         types.StringType,
+	"type":          types.StringType,
+	"default_value": types.StringType,
+	"model":         types.StringType,
+	"explore":       types.StringType,
+	"dimension":     types.StringType,
+}}
+
+// lookmlDashboardDataSource is the data source implementation.
+type lookmlDashboardDataSource struct {
+	baseDataSource
+}
+
+// lookmlDashboardModel maps the data source schema data.
+type lookmlDashboardModel struct {
+	ID       types.String `tfsdk:"id"`
+	Title    types.String `tfsdk:"title"`
+	Model    types.String `tfsdk:"model"`
+	LookML   types.String `tfsdk:"lookml"`
+	Elements types.List   `tfsdk:"elements"`
+	Filters  types.List   `tfsdk:"filters"`
+}
+
+// lookmlDashboardElementModel maps an entry of the `elements` list.
+type lookmlDashboardElementModel struct {
+	ID      types.String `tfsdk:"id"`
+	Title   types.String `tfsdk:"title"`
+	Type    types.String `tfsdk:"type"`
+	LookID  types.String `tfsdk:"look_id"`
+	QueryID types.String `tfsdk:"query_id"`
+	EditURI types.String `tfsdk:"edit_uri"`
+}
+
+// lookmlDashboardFilterModel maps an entry of the `filters` list.
+type lookmlDashboardFilterModel struct {
+	Name         types.String `tfsdk:"name"`
+	Title        types.String `tfsdk:"title"`
+	Type         types.String `tfsdk:"type"`
+	DefaultValue types.String `tfsdk:"default_value"`
+	Model        types.String `tfsdk:"model"`
+	Explore      types.String `tfsdk:"explore"`
+	Dimension    types.String `tfsdk:"dimension"`
+}
+
+// NewLookMLDashboardDataSource is a helper function to simplify the provider implementation.
+func NewLookMLDashboardDataSource() datasource.DataSource {
+	return &lookmlDashboardDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *lookmlDashboardDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lookml_dashboard"
+}
+
+// Schema defines the schema for the data source.
+func (d *lookmlDashboardDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a LookML dashboard defined in a project `.dashboard.lookml` file and exposes its elements and filters. The Looker API does not serve raw project files, so the dashboard is read as Looker parsed it from the deployed project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The LookML dashboard ID in the form `<model>::<dashboard_file_name>`.",
+				Required:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the dashboard.",
+				Computed:    true,
+			},
+			"model": schema.StringAttribute{
+				Description: "The model the dashboard file belongs to.",
+				Computed:    true,
+			},
+			"lookml": schema.StringAttribute{
+				Description: "The LookML definition of the dashboard.",
+				Computed:    true,
+			},
+			"elements": schema.ListNestedAttribute{
+				Description: "The dashboard elements (tiles).",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":       schema.StringAttribute{Computed: true},
+						"title":    schema.StringAttribute{Computed: true},
+						"type":     schema.StringAttribute{Computed: true},
+						"look_id":  schema.StringAttribute{Computed: true},
+						"query_id": schema.StringAttribute{Computed: true},
+						"edit_uri": schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+			"filters": schema.ListNestedAttribute{
+				Description: "The dashboard filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name":          schema.StringAttribute{Computed: true},
+						"title":         schema.StringAttribute{Computed: true},
+						"type":          schema.StringAttribute{Computed: true},
+						"default_value": schema.StringAttribute{Computed: true},
+						"model":         schema.StringAttribute{Computed: true},
+						"explore":       schema.StringAttribute{Computed: true},
+						"dimension":     schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *lookmlDashboardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data lookmlDashboardModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	dashboardID := data.ID.ValueString()
+
+	dashboard, err := d.client.SDK(ctx).Dashboard(dashboardID, "id,title,model,dashboard_elements,dashboard_filters", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read LookML dashboard %s: %v", dashboardID, err))
+		return
+	}
+	lookml, err := d.client.SDK(ctx).DashboardLookml(dashboardID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read LookML of dashboard %s: %v", dashboardID, err))
+		return
+	}
+
+	data.Title = types.StringPointerValue(dashboard.Title)
+	data.Model = types.StringNull()
+	if dashboard.Model != nil {
+		data.Model = types.StringPointerValue(dashboard.Model.Id)
+	}
+	data.LookML = types.StringPointerValue(lookml.Lookml)
+
+	elements := []lookmlDashboardElementModel{}
+	if dashboard.DashboardElements != nil {
+		for _, element := range *dashboard.DashboardElements {
+			elements = append(elements, lookmlDashboardElementModel{
+				ID:      types.StringPointerValue(element.Id),
+				Title:   types.StringPointerValue(element.Title),
+				Type:    types.StringPointerValue(element.Type),
+				LookID:  types.StringPointerValue(element.LookId),
+				QueryID: types.StringPointerValue(element.QueryId),
+				EditURI: types.StringPointerValue(element.EditUri),
+			})
+		}
+	}
+	filters := []lookmlDashboardFilterModel{}
+	if dashboard.DashboardFilters != nil {
+		for _, filter := range *dashboard.DashboardFilters {
+			filters = append(filters, lookmlDashboardFilterModel{
+				Name:         types.StringPointerValue(filter.Name),
+				Title:        types.StringPointerValue(filter.Title),
+				Type:         types.StringPointerValue(filter.Type),
+				DefaultValue: types.StringPointerValue(filter.DefaultValue),
+				Model:        types.StringPointerValue(filter.Model),
+				Explore:      types.StringPointerValue(filter.Explore),
+				Dimension:    types.StringPointerValue(filter.Dimension),
+			})
+		}
+	}
+
+	elementsList, diags := types.ListValueFrom(ctx, lookmlDashboardElementObjectType, elements)
+	resp.Diagnostics.Append(diags...)
+	filtersList, diags := types.ListValueFrom(ctx, lookmlDashboardFilterObjectType, filters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Elements = elementsList
+	data.Filters = filtersList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
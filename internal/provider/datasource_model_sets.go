@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// modelSetObjectType is the object type of an entry in the `model_sets` list.
+var modelSetObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":         types.StringType,
+	"name":       types.StringType,
+	"built_in":   types.BoolType,
+	"all_access": types.BoolType,
+	"models":     types.ListType{ElemType: types.StringType},
+}}
+
+// modelSetsDataSource is the data source implementation.
+type modelSetsDataSource struct {
+	baseDataSource
+}
+
+// modelSetsModel maps the data source schema data.
+type modelSetsModel struct {
+	Name      types.String `tfsdk:"name"`
+	BuiltIn   types.Bool   `tfsdk:"built_in"`
+	AllAccess types.Bool   `tfsdk:"all_access"`
+	IDs       types.List   `tfsdk:"ids"`
+	ModelSets types.List   `tfsdk:"model_sets"`
+}
+
+// modelSetItemModel maps an entry of the `model_sets` list.
+type modelSetItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	BuiltIn   types.Bool   `tfsdk:"built_in"`
+	AllAccess types.Bool   `tfsdk:"all_access"`
+	Models    []string     `tfsdk:"models"`
+}
+
+// NewModelSetsDataSource is a helper function to simplify the provider implementation.
+func NewModelSetsDataSource() datasource.DataSource {
+	return &modelSetsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *modelSetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model_sets"
+}
+
+// Schema defines the schema for the data source.
+func (d *modelSetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Searches Looker model sets. All filters are optional and combined with AND.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Model set name to match. Supports Looker search wildcards, e.g. `Legacy%`.",
+				Optional:    true,
+			},
+			"built_in": schema.BoolAttribute{
+				Description: "Only return built-in (`true`) or custom (`false`) model sets.",
+				Optional:    true,
+			},
+			"all_access": schema.BoolAttribute{
+				Description: "Only return model sets that do (`true`) or do not (`false`) grant access to every model.",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "IDs of the matching model sets.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"model_sets": schema.ListNestedAttribute{
+				Description: "The matching model sets.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":         schema.StringAttribute{Computed: true},
+						"name":       schema.StringAttribute{Computed: true},
+						"built_in":   schema.BoolAttribute{Computed: true},
+						"all_access": schema.BoolAttribute{Computed: true},
+						"models":     schema.ListAttribute{ElementType: types.StringType, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *modelSetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data modelSetsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields := "id,name,built_in,all_access,models"
+	sorts := "id"
+	limit := int64(setSearchPageSize)
+	search := v4.RequestSearchModelSets{
+		Fields:    &fields,
+		Sorts:     &sorts,
+		Limit:     &limit,
+		Name:      data.Name.ValueStringPointer(),
+		BuiltIn:   data.BuiltIn.ValueBoolPointer(),
+		AllAccess: data.AllAccess.ValueBoolPointer(),
+	}
+
+	ids := []string{}
+	sets := []modelSetItemModel{}
+	for offset := int64(0); ; offset += limit {
+		search.Offset = &offset
+		page, err := d.client.SDK(ctx).SearchModelSets(search, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Model set search failed: %v", err))
+			return
+		}
+		for _, set := range page {
+			models := []string{}
+			if set.Models != nil {
+				models = append(models, *set.Models...)
+			}
+			sort.Strings(models)
+			ids = append(ids, *set.Id)
+			sets = append(sets, modelSetItemModel{
+				ID:        types.StringPointerValue(set.Id),
+				Name:      types.StringPointerValue(set.Name),
+				BuiltIn:   types.BoolPointerValue(set.BuiltIn),
+				AllAccess: types.BoolPointerValue(set.AllAccess),
+				Models:    models,
+			})
+		}
+		if int64(len(page)) < limit {
+			break
+		}
+	}
+
+	idsList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	setsList, diags := types.ListValueFrom(ctx, modelSetObjectType, sets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.IDs = idsList
+	data.ModelSets = setsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
@@ -15,7 +17,7 @@ const modelSetFields = "id,name,models,built_in,all_access,url"
 
 // modelSetDataSource is the data source implementation.
 type modelSetDataSource struct {
-	sdk *v4.LookerSDK
+	baseDataSource
 }
 
 // modelSetModel maps the data source schema data.
@@ -53,19 +55,17 @@ func (d *modelSetDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 	}
 }
 
-// Configure adds the provider configured client to the data source.
-func (d *modelSetDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if cb, ok := req.ProviderData.(*clientBundle); ok && cb.SDK != nil {
-		d.sdk = cb.SDK
-	} else if req.ProviderData != nil {
-		resp.Diagnostics.AddError("Unexpected provider data", "Missing Looker SDK client")
+// ConfigValidators requires exactly one way of identifying the model set, so that
+// conflicting input is rejected at plan time.
+func (d *modelSetDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
 	}
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *modelSetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	if d.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !d.configured(&resp.Diagnostics) {
 		return
 	}
 
@@ -79,11 +79,11 @@ func (d *modelSetDataSource) Read(ctx context.Context, req datasource.ReadReques
 	var err error
 
 	if !data.ID.IsNull() && data.ID.ValueString() != "" {
-		ms, err = d.sdk.ModelSet(data.ID.ValueString(), modelSetFields, nil)
+		ms, err = d.client.SDK(ctx).ModelSet(data.ID.ValueString(), modelSetFields, nil)
 	} else if !data.Name.IsNull() && data.Name.ValueString() != "" {
 		name := data.Name.ValueString()
 		fields := modelSetFields
-		results, e := d.sdk.SearchModelSets(v4.RequestSearchModelSets{
+		results, e := d.client.SDK(ctx).SearchModelSets(v4.RequestSearchModelSets{
 			Name:   &name,
 			Fields: &fields,
 		}, nil)
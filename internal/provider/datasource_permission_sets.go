@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+const setSearchPageSize = 500
+
+// permissionSetObjectType is the object type of an entry in the `permission_sets` list.
+var permissionSetObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":          types.StringType,
+	"name":        types.StringType,
+	"built_in":    types.BoolType,
+	"all_access":  types.BoolType,
+	"permissions": types.ListType{ElemType: types.StringType},
+}}
+
+// permissionSetsDataSource is the data source implementation.
+type permissionSetsDataSource struct {
+	baseDataSource
+}
+
+// permissionSetsModel maps the data source schema data.
+type permissionSetsModel struct {
+	Name           types.String `tfsdk:"name"`
+	BuiltIn        types.Bool   `tfsdk:"built_in"`
+	AllAccess      types.Bool   `tfsdk:"all_access"`
+	IDs            types.List   `tfsdk:"ids"`
+	PermissionSets types.List   `tfsdk:"permission_sets"`
+}
+
+// permissionSetItemModel maps an entry of the `permission_sets` list.
+type permissionSetItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	BuiltIn     types.Bool   `tfsdk:"built_in"`
+	AllAccess   types.Bool   `tfsdk:"all_access"`
+	Permissions []string     `tfsdk:"permissions"`
+}
+
+// NewPermissionSetsDataSource is a helper function to simplify the provider implementation.
+func NewPermissionSetsDataSource() datasource.DataSource {
+	return &permissionSetsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *permissionSetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_sets"
+}
+
+// Schema defines the schema for the data source.
+func (d *permissionSetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Searches Looker permission sets. All filters are optional and combined with AND.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Permission set name to match. Supports Looker search wildcards, e.g. `Legacy%`.",
+				Optional:    true,
+			},
+			"built_in": schema.BoolAttribute{
+				Description: "Only return built-in (`true`) or custom (`false`) permission sets.",
+				Optional:    true,
+			},
+			"all_access": schema.BoolAttribute{
+				Description: "Only return permission sets that do (`true`) or do not (`false`) grant every permission.",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "IDs of the matching permission sets.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"permission_sets": schema.ListNestedAttribute{
+				Description: "The matching permission sets.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":          schema.StringAttribute{Computed: true},
+						"name":        schema.StringAttribute{Computed: true},
+						"built_in":    schema.BoolAttribute{Computed: true},
+						"all_access":  schema.BoolAttribute{Computed: true},
+						"permissions": schema.ListAttribute{ElementType: types.StringType, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *permissionSetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data permissionSetsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields := "id,name,built_in,all_access,permissions"
+	sorts := "id"
+	limit := int64(setSearchPageSize)
+	search := v4.RequestSearchPermissionSets{
+		Fields:    &fields,
+		Sorts:     &sorts,
+		Limit:     &limit,
+		Name:      data.Name.ValueStringPointer(),
+		BuiltIn:   data.BuiltIn.ValueBoolPointer(),
+		AllAccess: data.AllAccess.ValueBoolPointer(),
+	}
+
+	ids := []string{}
+	sets := []permissionSetItemModel{}
+	for offset := int64(0); ; offset += limit {
+		search.Offset = &offset
+		page, err := d.client.SDK(ctx).SearchPermissionSets(search, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Permission set search failed: %v", err))
+			return
+		}
+		for _, set := range page {
+			permissions := []string{}
+			if set.Permissions != nil {
+				permissions = append(permissions, *set.Permissions...)
+			}
+			sort.Strings(permissions)
+			ids = append(ids, *set.Id)
+			sets = append(sets, permissionSetItemModel{
+				ID:          types.StringPointerValue(set.Id),
+				Name:        types.StringPointerValue(set.Name),
+				BuiltIn:     types.BoolPointerValue(set.BuiltIn),
+				AllAccess:   types.BoolPointerValue(set.AllAccess),
+				Permissions: permissions,
+			})
+		}
+		if int64(len(page)) < limit {
+			break
+		}
+	}
+
+	idsList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	setsList, diags := types.ListValueFrom(ctx, permissionSetObjectType, sets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.IDs = idsList
+	data.PermissionSets = setsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
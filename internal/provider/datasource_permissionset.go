@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
@@ -14,7 +16,7 @@ import (
 const permissionSetFields = "id,name,permissions,built_in,all_access,url"
 
 type permissionSetDataSource struct {
-	sdk *v4.LookerSDK
+	baseDataSource
 }
 
 type permissionSetModel struct {
@@ -31,7 +33,7 @@ func NewPermissionSetDataSource() datasource.DataSource {
 }
 
 func (d *permissionSetDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_permissionset"
+	resp.TypeName = req.ProviderTypeName + "_permission_set"
 }
 
 func (d *permissionSetDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -48,17 +50,16 @@ func (d *permissionSetDataSource) Schema(_ context.Context, _ datasource.SchemaR
 	}
 }
 
-func (d *permissionSetDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if cb, ok := req.ProviderData.(*clientBundle); ok && cb.SDK != nil {
-		d.sdk = cb.SDK
-	} else if req.ProviderData != nil {
-		resp.Diagnostics.AddError("Unexpected provider data", "Missing Looker SDK client")
+// ConfigValidators requires exactly one way of identifying the permission set, so that
+// conflicting input is rejected at plan time.
+func (d *permissionSetDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
 	}
 }
 
 func (d *permissionSetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	if d.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !d.configured(&resp.Diagnostics) {
 		return
 	}
 
@@ -72,12 +73,12 @@ func (d *permissionSetDataSource) Read(ctx context.Context, req datasource.ReadR
 	var err error
 
 	if !data.ID.IsNull() && data.ID.ValueString() != "" {
-		ps, err = d.sdk.PermissionSet(data.ID.ValueString(), permissionSetFields, nil)
+		ps, err = d.client.SDK(ctx).PermissionSet(data.ID.ValueString(), permissionSetFields, nil)
 
 	} else if !data.Name.IsNull() && data.Name.ValueString() != "" {
 		name := data.Name.ValueString()
 		fields := permissionSetFields
-		results, e := d.sdk.SearchPermissionSets(v4.RequestSearchPermissionSets{
+		results, e := d.client.SDK(ctx).SearchPermissionSets(v4.RequestSearchPermissionSets{
 			Name:   &name,
 			Fields: &fields,
 		}, nil)
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// projectGitDeployKeyDataSource is the data source implementation.
+type projectGitDeployKeyDataSource struct {
+	baseDataSource
+}
+
+// NewProjectGitDeployKeyDataSource is a helper function to simplify the provider implementation.
+func NewProjectGitDeployKeyDataSource() datasource.DataSource {
+	return &projectGitDeployKeyDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *projectGitDeployKeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_git_deploy_key"
+}
+
+// Schema defines the schema for the data source.
+func (d *projectGitDeployKeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the SSH deploy key of a LookML project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the project.",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the LookML project.",
+				Required:    true,
+			},
+			"public_key": schema.StringAttribute{
+				Description: "The SSH public key of the project's deploy key.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *projectGitDeployKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data projectGitDeployKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := data.ProjectID.ValueString()
+
+	publicKey, err := d.client.SDK(ctx).GitDeployKey(projectID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read deploy key of project %s: %v", projectID, err))
+		return
+	}
+
+	data.ID = data.ProjectID
+	data.PublicKey = types.StringValue(strings.TrimSpace(publicKey))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
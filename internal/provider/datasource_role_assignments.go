@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// roleAssignmentObjectType is the object type of an entry in the
+// `assignments` list.
+var roleAssignmentObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"role_id":   types.StringType,
+	"role_name": types.StringType,
+	"user_id":   types.StringType,
+	"source":    types.StringType,
+	"group_id":  types.StringType,
+}}
+
+// roleAssignmentsDataSource is the data source implementation.
+type roleAssignmentsDataSource struct {
+	baseDataSource
+}
+
+// roleAssignmentsModel maps the data source schema data.
+type roleAssignmentsModel struct {
+	RoleID      types.String `tfsdk:"role_id"`
+	UserIDs     types.Set    `tfsdk:"user_ids"`
+	Assignments types.List   `tfsdk:"assignments"`
+}
+
+// roleAssignmentItemModel maps an entry of the `assignments` list.
+type roleAssignmentItemModel struct {
+	RoleID   types.String `tfsdk:"role_id"`
+	RoleName types.String `tfsdk:"role_name"`
+	UserID   types.String `tfsdk:"user_id"`
+	Source   types.String `tfsdk:"source"`
+	GroupID  types.String `tfsdk:"group_id"`
+}
+
+// NewRoleAssignmentsDataSource is a helper function to simplify the provider implementation.
+func NewRoleAssignmentsDataSource() datasource.DataSource {
+	return &roleAssignmentsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *roleAssignmentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_assignments"
+}
+
+// Schema defines the schema for the data source.
+func (d *roleAssignmentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the users holding a Looker role, or every role, either directly or through a group.",
+		Attributes: map[string]schema.Attribute{
+			"role_id": schema.StringAttribute{
+				Description: "The ID of the role. Defaults to every role of the instance.",
+				Optional:    true,
+			},
+			"user_ids": schema.SetAttribute{
+				Description: "IDs of the users holding any of the roles.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"assignments": schema.ListNestedAttribute{
+				Description: "One entry per role, user and way the user holds the role, ordered by role ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role_id":   schema.StringAttribute{Computed: true},
+						"role_name": schema.StringAttribute{Computed: true},
+						"user_id":   schema.StringAttribute{Computed: true},
+						"source":    schema.StringAttribute{Description: "`direct` when the role is assigned to the user, `group` when it is assigned to one of the user's groups.", Computed: true},
+						"group_id":  schema.StringAttribute{Description: "The group the role is assigned to, for `group` assignments.", Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// auditedRoles returns the role with roleID, or every role when it is empty.
+func auditedRoles(sdk lookerapi.Client, roleID string) ([]v4.Role, error) {
+	if roleID != "" {
+		role, err := sdk.Role(roleID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("API error reading role %s: %w", roleID, err)
+		}
+		return []v4.Role{role}, nil
+	}
+
+	fields := "id,name"
+	sorts := "id"
+	limit := int64(rolesSearchPageSize)
+	search := v4.RequestSearchRoles{Fields: &fields, Sorts: &sorts, Limit: &limit}
+	var roles []v4.Role
+	for offset := int64(0); ; offset += limit {
+		search.Offset = &offset
+		page, err := sdk.SearchRoles(search, nil)
+		if err != nil {
+			return nil, fmt.Errorf("API error listing roles: %w", err)
+		}
+		roles = append(roles, page...)
+		if int64(len(page)) < limit {
+			return roles, nil
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *roleAssignmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data roleAssignmentsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sdk := d.client.SDK(ctx)
+	roles, err := auditedRoles(sdk, data.RoleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+
+	fields := "id"
+	directOnly := true
+	// Groups often hold several roles, so their members are listed once.
+	groupMembers := make(map[string][]v4.User)
+	userIDs := make(map[string]bool)
+	assignments := []roleAssignmentItemModel{}
+	for _, role := range roles {
+		if role.Id == nil {
+			continue
+		}
+		roleID := *role.Id
+		assignment := roleAssignmentItemModel{
+			RoleID:   types.StringValue(roleID),
+			RoleName: types.StringPointerValue(role.Name),
+		}
+
+		users, err := sdk.RoleUsers(v4.RequestRoleUsers{RoleId: roleID, Fields: &fields, DirectAssociationOnly: &directOnly}, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to list users of role %s: %v", roleID, err))
+			return
+		}
+		for _, user := range users {
+			if user.Id == nil {
+				continue
+			}
+			userIDs[*user.Id] = true
+			assignment.UserID = types.StringValue(*user.Id)
+			assignment.Source = types.StringValue("direct")
+			assignment.GroupID = types.StringNull()
+			assignments = append(assignments, assignment)
+		}
+
+		groups, err := sdk.RoleGroups(roleID, "id", nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to list groups of role %s: %v", roleID, err))
+			return
+		}
+		for _, group := range groups {
+			if group.Id == nil {
+				continue
+			}
+			members, ok := groupMembers[*group.Id]
+			if !ok {
+				if members, err = allGroupUsers(sdk, *group.Id, "id"); err != nil {
+					resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to list users of group %s: %v", *group.Id, err))
+					return
+				}
+				groupMembers[*group.Id] = members
+			}
+			for _, user := range members {
+				if user.Id == nil {
+					continue
+				}
+				userIDs[*user.Id] = true
+				assignment.UserID = types.StringValue(*user.Id)
+				assignment.Source = types.StringValue("group")
+				assignment.GroupID = types.StringValue(*group.Id)
+				assignments = append(assignments, assignment)
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(userIDs))
+	for id := range userIDs {
+		ids = append(ids, id)
+	}
+	userIDsSet, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	assignmentsList, diags := types.ListValueFrom(ctx, roleAssignmentObjectType, assignments)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.UserIDs = userIDsSet
+	data.Assignments = assignmentsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
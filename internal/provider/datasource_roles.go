@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
@@ -15,7 +17,7 @@ const roleSearchFields = "id,name,permission_set,model_set,url"
 
 // roleDataSource is the data source implementation.
 type roleDataSource struct {
-	sdk *v4.LookerSDK
+	baseDataSource
 }
 
 // roleModel maps the data source schema data.
@@ -51,19 +53,17 @@ func (d *roleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 	}
 }
 
-// Configure adds the provider configured client to the data source.
-func (d *roleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if cb, ok := req.ProviderData.(*clientBundle); ok && cb.SDK != nil {
-		d.sdk = cb.SDK
-	} else if req.ProviderData != nil {
-		resp.Diagnostics.AddError("Unexpected provider data", "Missing Looker SDK client")
+// ConfigValidators requires exactly one way of identifying the role, so that
+// conflicting input is rejected at plan time.
+func (d *roleDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name")),
 	}
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	if d.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !d.configured(&resp.Diagnostics) {
 		return
 	}
 
@@ -78,11 +78,11 @@ func (d *roleDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	if !data.ID.IsNull() && data.ID.ValueString() != "" {
 		// CORRECTED: The Role() function does not take a 'fields' argument.
-		role, err = d.sdk.Role(data.ID.ValueString(), nil)
+		role, err = d.client.SDK(ctx).Role(data.ID.ValueString(), nil)
 	} else if !data.Name.IsNull() && data.Name.ValueString() != "" {
 		name := data.Name.ValueString()
 		fields := roleSearchFields
-		results, e := d.sdk.SearchRoles(v4.RequestSearchRoles{
+		results, e := d.client.SDK(ctx).SearchRoles(v4.RequestSearchRoles{
 			Name:   &name,
 			Fields: &fields,
 		}, nil)
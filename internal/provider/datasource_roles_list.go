@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+const rolesSearchPageSize = 500
+
+// roleObjectType is the object type of an entry in the `roles` list.
+var roleObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":                  types.StringType,
+	"name":                types.StringType,
+	"permission_set_id":   types.StringType,
+	"permission_set_name": types.StringType,
+	"model_set_id":        types.StringType,
+	"model_set_name":      types.StringType,
+}}
+
+// rolesDataSource is the data source implementation.
+type rolesDataSource struct {
+	baseDataSource
+}
+
+// rolesModel maps the data source schema data.
+type rolesModel struct {
+	Name    types.String `tfsdk:"name"`
+	BuiltIn types.Bool   `tfsdk:"built_in"`
+	IDs     types.List   `tfsdk:"ids"`
+	Roles   types.List   `tfsdk:"roles"`
+}
+
+// roleItemModel maps an entry of the `roles` list.
+type roleItemModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	PermissionSetID   types.String `tfsdk:"permission_set_id"`
+	PermissionSetName types.String `tfsdk:"permission_set_name"`
+	ModelSetID        types.String `tfsdk:"model_set_id"`
+	ModelSetName      types.String `tfsdk:"model_set_name"`
+}
+
+// NewRolesDataSource is a helper function to simplify the provider implementation.
+func NewRolesDataSource() datasource.DataSource {
+	return &rolesDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *rolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+// Schema defines the schema for the data source.
+func (d *rolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Searches Looker roles. All filters are optional and combined with AND.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Role name to match. Supports Looker search wildcards, e.g. `%Viewer`.",
+				Optional:    true,
+			},
+			"built_in": schema.BoolAttribute{
+				Description: "Only return built-in (`true`) or custom (`false`) roles.",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "IDs of the matching roles.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"roles": schema.ListNestedAttribute{
+				Description: "The matching roles.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":                  schema.StringAttribute{Computed: true},
+						"name":                schema.StringAttribute{Computed: true},
+						"permission_set_id":   schema.StringAttribute{Computed: true},
+						"permission_set_name": schema.StringAttribute{Computed: true},
+						"model_set_id":        schema.StringAttribute{Computed: true},
+						"model_set_name":      schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *rolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data rolesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields := "id,name,permission_set(id,name),model_set(id,name)"
+	sorts := "id"
+	limit := int64(rolesSearchPageSize)
+	search := v4.RequestSearchRoles{
+		Fields:  &fields,
+		Sorts:   &sorts,
+		Limit:   &limit,
+		Name:    data.Name.ValueStringPointer(),
+		BuiltIn: data.BuiltIn.ValueBoolPointer(),
+	}
+
+	ids := []string{}
+	roles := []roleItemModel{}
+	for offset := int64(0); ; offset += limit {
+		search.Offset = &offset
+		page, err := d.client.SDK(ctx).SearchRoles(search, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Role search failed: %v", err))
+			return
+		}
+		for _, role := range page {
+			item := roleItemModel{
+				ID:                types.StringPointerValue(role.Id),
+				Name:              types.StringPointerValue(role.Name),
+				PermissionSetID:   types.StringNull(),
+				PermissionSetName: types.StringNull(),
+				ModelSetID:        types.StringNull(),
+				ModelSetName:      types.StringNull(),
+			}
+			if role.PermissionSet != nil {
+				item.PermissionSetID = types.StringPointerValue(role.PermissionSet.Id)
+				item.PermissionSetName = types.StringPointerValue(role.PermissionSet.Name)
+			}
+			if role.ModelSet != nil {
+				item.ModelSetID = types.StringPointerValue(role.ModelSet.Id)
+				item.ModelSetName = types.StringPointerValue(role.ModelSet.Name)
+			}
+			ids = append(ids, *role.Id)
+			roles = append(roles, item)
+		}
+		if int64(len(page)) < limit {
+			break
+		}
+	}
+
+	idsList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	rolesList, diags := types.ListValueFrom(ctx, roleObjectType, roles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.IDs = idsList
+	data.Roles = rolesList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
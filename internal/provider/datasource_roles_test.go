@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-looker/internal/lookertest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoleDataSource(t *testing.T) {
+	_, fixtures := testAccSeededServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "looker_role" "test" {
+  id = %q
+}
+`, fixtures.RoleID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.looker_role.test", "name", lookertest.FixtureRoleName),
+					resource.TestCheckResourceAttr("data.looker_role.test", "permission_set_id", fixtures.PermissionSetID),
+					resource.TestCheckResourceAttr("data.looker_role.test", "model_set_id", fixtures.ModelSetID),
+				),
+			},
+		},
+	})
+}
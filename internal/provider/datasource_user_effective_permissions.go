@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+const userRoleFields = "id,name,permission_set,model_set"
+
+// userEffectivePermissionsDataSource is the data source implementation.
+type userEffectivePermissionsDataSource struct {
+	baseDataSource
+}
+
+// userEffectivePermissionsModel maps the data source schema data.
+type userEffectivePermissionsModel struct {
+	UserID          types.String `tfsdk:"user_id"`
+	RoleIDs         types.Set    `tfsdk:"role_ids"`
+	Permissions     types.Set    `tfsdk:"permissions"`
+	Models          types.Set    `tfsdk:"models"`
+	AllModels       types.Bool   `tfsdk:"all_models"`
+	PermissionRoles types.Map    `tfsdk:"permission_roles"`
+}
+
+// NewUserEffectivePermissionsDataSource is a helper function to simplify the provider implementation.
+func NewUserEffectivePermissionsDataSource() datasource.DataSource {
+	return &userEffectivePermissionsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *userEffectivePermissionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_effective_permissions"
+}
+
+// Schema defines the schema for the data source.
+func (d *userEffectivePermissionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Aggregates the roles of a Looker user, both direct and inherited through groups, into the effective list of permissions and models the user has access to.",
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user.",
+				Required:    true,
+			},
+			"role_ids": schema.SetAttribute{
+				Description: "IDs of all roles held by the user, directly or through groups.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"permissions": schema.SetAttribute{
+				Description: "Union of the permissions granted by the user's roles, including the parents Looker grants along with each permission and, for `administer`, every permission.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"models": schema.SetAttribute{
+				Description: "Union of the models the user's roles grant access to.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"all_models": schema.BoolAttribute{
+				Description: "Whether any of the user's roles grants access to all models.",
+				Computed:    true,
+			},
+			"permission_roles": schema.MapAttribute{
+				Description: "Map of permission to the IDs of the roles that grant it.",
+				ElementType: types.SetType{ElemType: types.StringType},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *userEffectivePermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data userEffectivePermissionsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	userID := data.UserID.ValueString()
+
+	fields := userRoleFields
+	roles, err := d.client.SDK(ctx).UserRoles(v4.RequestUserRoles{UserId: userID, Fields: &fields}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to get roles for user %s: %v", userID, err))
+		return
+	}
+
+	tree, err := loadPermissionTree(d.client.SDK(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+
+	var roleIDs []string
+	models := make(map[string]bool)
+	permissionRoles := make(map[string][]string)
+	allModels := false
+	for _, role := range roles {
+		if role.Id == nil {
+			continue
+		}
+		roleIDs = append(roleIDs, *role.Id)
+		if role.PermissionSet != nil && role.PermissionSet.Permissions != nil {
+			// Expanded, so that a role with administer is listed for every
+			// permission it implies.
+			for permission := range tree.expand(*role.PermissionSet.Permissions) {
+				permissionRoles[permission] = append(permissionRoles[permission], *role.Id)
+			}
+		}
+		if role.ModelSet != nil {
+			if role.ModelSet.AllAccess != nil && *role.ModelSet.AllAccess {
+				allModels = true
+			}
+			if role.ModelSet.Models != nil {
+				for _, model := range *role.ModelSet.Models {
+					models[model] = true
+				}
+			}
+		}
+	}
+
+	permissions := make([]string, 0, len(permissionRoles))
+	for permission := range permissionRoles {
+		permissions = append(permissions, permission)
+	}
+	modelNames := make([]string, 0, len(models))
+	for model := range models {
+		modelNames = append(modelNames, model)
+	}
+
+	roleIDsSet, diags := types.SetValueFrom(ctx, types.StringType, roleIDs)
+	resp.Diagnostics.Append(diags...)
+	permissionsSet, diags := types.SetValueFrom(ctx, types.StringType, permissions)
+	resp.Diagnostics.Append(diags...)
+	modelsSet, diags := types.SetValueFrom(ctx, types.StringType, modelNames)
+	resp.Diagnostics.Append(diags...)
+	permissionRolesMap, diags := types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, permissionRoles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.RoleIDs = roleIDsSet
+	data.Permissions = permissionsSet
+	data.Models = modelsSet
+	data.AllModels = types.BoolValue(allModels)
+	data.PermissionRoles = permissionRolesMap
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
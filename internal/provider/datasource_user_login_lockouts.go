@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// loginLockoutObjectType is the object type of an entry in the `lockouts` list.
+var loginLockoutObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"key":        types.StringType,
+	"user_id":    types.StringType,
+	"email":      types.StringType,
+	"full_name":  types.StringType,
+	"remote_id":  types.StringType,
+	"auth_type":  types.StringType,
+	"ip":         types.StringType,
+	"fail_count": types.Int64Type,
+	"lockout_at": types.StringType,
+}}
+
+// userLoginLockoutsDataSource is the data source implementation.
+type userLoginLockoutsDataSource struct {
+	baseDataSource
+}
+
+// userLoginLockoutsModel maps the data source schema data.
+type userLoginLockoutsModel struct {
+	Email    types.String `tfsdk:"email"`
+	AuthType types.String `tfsdk:"auth_type"`
+	Lockouts types.List   `tfsdk:"lockouts"`
+}
+
+// loginLockoutItemModel maps an entry of the `lockouts` list.
+type loginLockoutItemModel struct {
+	Key       types.String `tfsdk:"key"`
+	UserID    types.String `tfsdk:"user_id"`
+	Email     types.String `tfsdk:"email"`
+	FullName  types.String `tfsdk:"full_name"`
+	RemoteID  types.String `tfsdk:"remote_id"`
+	AuthType  types.String `tfsdk:"auth_type"`
+	IP        types.String `tfsdk:"ip"`
+	FailCount types.Int64  `tfsdk:"fail_count"`
+	LockoutAt types.String `tfsdk:"lockout_at"`
+}
+
+// NewUserLoginLockoutsDataSource is a helper function to simplify the provider implementation.
+func NewUserLoginLockoutsDataSource() datasource.DataSource {
+	return &userLoginLockoutsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *userLoginLockoutsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_login_lockouts"
+}
+
+// Schema defines the schema for the data source.
+func (d *userLoginLockoutsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the users currently locked out of Looker after too many failed logins.",
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Description: "Only list lockouts of users whose email matches. Supports Looker search wildcards such as `%`.",
+				Optional:    true,
+			},
+			"auth_type": schema.StringAttribute{
+				Description: "Only list lockouts for this authentication method: `email`, `ldap`, `totp` or `api`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("email", "ldap", "totp", "api"),
+				},
+			},
+			"lockouts": schema.ListNestedAttribute{
+				Description: "The current login lockouts.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key":        schema.StringAttribute{Computed: true, Description: "Key of the lockout, a hash of the user's client ID."},
+						"user_id":    schema.StringAttribute{Computed: true, Description: "ID of the locked out user."},
+						"email":      schema.StringAttribute{Computed: true, Description: "Email of the user."},
+						"full_name":  schema.StringAttribute{Computed: true, Description: "Name of the user."},
+						"remote_id":  schema.StringAttribute{Computed: true, Description: "Remote ID of the user when using LDAP."},
+						"auth_type":  schema.StringAttribute{Computed: true, Description: "Authentication method of the failed logins."},
+						"ip":         schema.StringAttribute{Computed: true, Description: "IP address of the most recent failed attempt."},
+						"fail_count": schema.Int64Attribute{Computed: true, Description: "Number of failures that triggered the lockout."},
+						"lockout_at": schema.StringAttribute{Computed: true, Description: "RFC 3339 time when the lockout was triggered."},
+					},
+				},
+			},
+		},
+	}
+}
+
+// searchLoginLockouts returns the lockouts matching request, following pages.
+func searchLoginLockouts(sdk lookerapi.Client, request v4.RequestSearchUserLoginLockouts) ([]v4.UserLoginLockout, error) {
+	var lockouts []v4.UserLoginLockout
+	limit := int64(500)
+	for offset := int64(0); ; offset += limit {
+		request.Limit, request.Offset = &limit, &offset
+		page, err := sdk.SearchUserLoginLockouts(request, nil)
+		if err != nil {
+			return nil, err
+		}
+		lockouts = append(lockouts, page...)
+		if int64(len(page)) < limit {
+			return lockouts, nil
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *userLoginLockoutsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data userLoginLockoutsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, err := searchLoginLockouts(d.client.SDK(ctx), v4.RequestSearchUserLoginLockouts{
+		Email:    data.Email.ValueStringPointer(),
+		AuthType: data.AuthType.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to list login lockouts: %v", err))
+		return
+	}
+
+	lockouts := []loginLockoutItemModel{}
+	for _, lockout := range results {
+		lockoutAt := types.StringNull()
+		if lockout.LockoutAt != nil {
+			lockoutAt = types.StringValue(lockout.LockoutAt.Format(time.RFC3339))
+		}
+		lockouts = append(lockouts, loginLockoutItemModel{
+			Key:       types.StringPointerValue(lockout.Key),
+			UserID:    types.StringPointerValue(lockout.UserId),
+			Email:     types.StringPointerValue(lockout.Email),
+			FullName:  types.StringPointerValue(lockout.FullName),
+			RemoteID:  types.StringPointerValue(lockout.RemoteId),
+			AuthType:  types.StringPointerValue(lockout.AuthType),
+			IP:        types.StringPointerValue(lockout.Ip),
+			FailCount: types.Int64PointerValue(lockout.FailCount),
+			LockoutAt: lockoutAt,
+		})
+	}
+
+	lockoutsList, diags := types.ListValueFrom(ctx, loginLockoutObjectType, lockouts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Lockouts = lockoutsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
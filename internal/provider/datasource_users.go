@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+const (
+	userSearchFields   = "id,email,first_name,last_name,display_name,is_disabled,credentials_email"
+	userSearchPageSize = 500
+)
+
+// userObjectType is the object type of an entry in the `users` list.
+var userObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":           types.StringType,
+	"email":        types.StringType,
+	"first_name":   types.StringType,
+	"last_name":    types.StringType,
+	"display_name": types.StringType,
+	"is_disabled":  types.BoolType,
+}}
+
+// usersDataSource is the data source implementation.
+type usersDataSource struct {
+	baseDataSource
+}
+
+// usersModel maps the data source schema data.
+type usersModel struct {
+	Email         types.String `tfsdk:"email"`
+	GroupID       types.String `tfsdk:"group_id"`
+	IsDisabled    types.Bool   `tfsdk:"is_disabled"`
+	VerifiedEmail types.Bool   `tfsdk:"verified_email"`
+	IDs           types.List   `tfsdk:"ids"`
+	Users         types.List   `tfsdk:"users"`
+}
+
+// userItemModel maps an entry of the `users` list.
+type userItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Email       types.String `tfsdk:"email"`
+	FirstName   types.String `tfsdk:"first_name"`
+	LastName    types.String `tfsdk:"last_name"`
+	DisplayName types.String `tfsdk:"display_name"`
+	IsDisabled  types.Bool   `tfsdk:"is_disabled"`
+}
+
+// NewUsersDataSource is a helper function to simplify the provider implementation.
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema defines the schema for the data source.
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Searches Looker users. All filters are optional and combined with AND.",
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Description: "Email address to match. Supports Looker search wildcards, e.g. `%@example.com`.",
+				Optional:    true,
+			},
+			"group_id": schema.StringAttribute{
+				Description: "Only return direct members of this group.",
+				Optional:    true,
+			},
+			"is_disabled": schema.BoolAttribute{
+				Description: "Only return disabled (`true`) or enabled (`false`) users.",
+				Optional:    true,
+			},
+			"verified_email": schema.BoolAttribute{
+				Description: "Only return users whose email credential has (`true`) or has not (`false`) been used to log in, i.e. whose address has been confirmed through account setup.",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "IDs of the matching users.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "The matching users.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":           schema.StringAttribute{Computed: true},
+						"email":        schema.StringAttribute{Computed: true},
+						"first_name":   schema.StringAttribute{Computed: true},
+						"last_name":    schema.StringAttribute{Computed: true},
+						"display_name": schema.StringAttribute{Computed: true},
+						"is_disabled":  schema.BoolAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if !d.configured(&resp.Diagnostics) {
+		return
+	}
+
+	var data usersModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields := userSearchFields
+	sorts := "id"
+	limit := int64(userSearchPageSize)
+	search := v4.RequestSearchUsers{
+		Fields:     &fields,
+		Sorts:      &sorts,
+		Limit:      &limit,
+		Email:      data.Email.ValueStringPointer(),
+		GroupId:    data.GroupID.ValueStringPointer(),
+		IsDisabled: data.IsDisabled.ValueBoolPointer(),
+	}
+
+	ids := []string{}
+	users := []userItemModel{}
+	for offset := int64(0); ; offset += limit {
+		search.Offset = &offset
+		page, err := d.client.SDK(ctx).SearchUsers(search, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("User search failed: %v", err))
+			return
+		}
+		for _, user := range page {
+			if !data.VerifiedEmail.IsNull() {
+				verified := user.CredentialsEmail != nil && user.CredentialsEmail.LoggedInAt != nil && *user.CredentialsEmail.LoggedInAt != ""
+				if verified != data.VerifiedEmail.ValueBool() {
+					continue
+				}
+			}
+			ids = append(ids, *user.Id)
+			users = append(users, userItemModel{
+				ID:          types.StringPointerValue(user.Id),
+				Email:       types.StringPointerValue(user.Email),
+				FirstName:   types.StringPointerValue(user.FirstName),
+				LastName:    types.StringPointerValue(user.LastName),
+				DisplayName: types.StringPointerValue(user.DisplayName),
+				IsDisabled:  types.BoolPointerValue(user.IsDisabled),
+			})
+		}
+		if int64(len(page)) < limit {
+			break
+		}
+	}
+
+	idsList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	usersList, diags := types.ListValueFrom(ctx, userObjectType, users)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.IDs = idsList
+	data.Users = usersList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
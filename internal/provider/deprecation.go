@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// Renames go through a full release cycle: the old name keeps working with a
+// deprecation warning for at least one minor release, and is removed in the
+// next major release. The helpers below keep the wording and the mechanics
+// the same everywhere. A renamed attribute stays in the schema next to the
+// new one, with a DeprecationMessage from renamedMessage.
+
+// renamedMessage is the deprecation message for something that was renamed.
+func renamedMessage(kind, oldName, newName string) string {
+	return fmt.Sprintf("The %s %q is deprecated and will be removed in the next major release. Use %q instead, which behaves the same.", kind, oldName, newName)
+}
+
+// deprecatedDataSourceAlias serves a data source under its former type name,
+// with a deprecation warning pointing to the current one.
+type deprecatedDataSourceAlias struct {
+	datasource.DataSourceWithConfigure
+	oldName string
+	newName string
+}
+
+// deprecatedDataSource returns a constructor for the data source built by
+// newDataSource under its former type name. Names are given without the
+// provider prefix. Register it in DataSources next to the current constructor.
+func deprecatedDataSource(oldName, newName string, newDataSource func() datasource.DataSource) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &deprecatedDataSourceAlias{
+			DataSourceWithConfigure: newDataSource().(datasource.DataSourceWithConfigure),
+			oldName:                 oldName,
+			newName:                 newName,
+		}
+	}
+}
+
+// Metadata returns the former data source type name.
+func (d *deprecatedDataSourceAlias) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.oldName
+}
+
+// Schema returns the schema of the current data source, marked deprecated.
+func (d *deprecatedDataSourceAlias) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	d.DataSourceWithConfigure.Schema(ctx, req, resp)
+	resp.Schema.DeprecationMessage = renamedMessage("data source", "looker_"+d.oldName, "looker_"+d.newName)
+}
+
+// ConfigValidators returns the config validators of the current data source,
+// if any.
+func (d *deprecatedDataSourceAlias) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	if v, ok := d.DataSourceWithConfigure.(datasource.DataSourceWithConfigValidators); ok {
+		return v.ConfigValidators(ctx)
+	}
+	return nil
+}
+
+// rawStateUpgrader returns a state upgrader that lets upgrade edit the
+// attributes of the prior state as raw JSON values. Attributes it leaves out
+// are null in the upgraded state.
+func rawStateUpgrader(upgrade func(state map[string]json.RawMessage)) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil || req.RawState.JSON == nil {
+				resp.Diagnostics.AddError("Unable to upgrade state", "The prior state is not stored as JSON and cannot be upgraded.")
+				return
+			}
+			var state map[string]json.RawMessage
+			if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+				resp.Diagnostics.AddError("Unable to upgrade state", fmt.Sprintf("The prior state could not be decoded: %v", err))
+				return
+			}
+			upgrade(state)
+			upgraded, err := json.Marshal(state)
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to upgrade state", fmt.Sprintf("The upgraded state could not be encoded: %v", err))
+				return
+			}
+			resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestDeprecatedDataSource(t *testing.T) {
+	ctx := context.Background()
+	d := deprecatedDataSource("permissionset", "permission_set", NewPermissionSetDataSource)()
+
+	var metadata datasource.MetadataResponse
+	d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "looker"}, &metadata)
+	if metadata.TypeName != "looker_permissionset" {
+		t.Errorf("type name = %q, want looker_permissionset", metadata.TypeName)
+	}
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	if got := schemaResp.Schema.DeprecationMessage; !strings.Contains(got, `"looker_permissionset"`) || !strings.Contains(got, `"looker_permission_set"`) {
+		t.Errorf("deprecation message = %q, want both names", got)
+	}
+	if _, ok := schemaResp.Schema.Attributes["permissions"]; !ok {
+		t.Error("schema is not the one of looker_permission_set")
+	}
+	if got := len(d.(datasource.DataSourceWithConfigValidators).ConfigValidators(ctx)); got != 1 {
+		t.Errorf("%d config validators, want those of looker_permission_set", got)
+	}
+
+	// Configure and Read go to the current data source.
+	client, api := newMockClient(t)
+	var configure datasource.ConfigureResponse
+	d.(datasource.DataSourceWithConfigure).Configure(ctx, datasource.ConfigureRequest{ProviderData: client}, &configure)
+	requireNoErrors(t, "Configure", configure.Diagnostics)
+	api.EXPECT().PermissionSet("3", permissionSetFields, nil).
+		Return(v4.PermissionSet{Id: ptr("3"), Name: ptr("Admin"), Permissions: &[]string{"access_data"}}, nil)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	attributes["id"] = tftypes.NewValue(tftypes.String, "3")
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+	read := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: config.Raw}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, &read)
+	requireNoErrors(t, "Read", read.Diagnostics)
+
+	var name string
+	if diags := read.State.GetAttribute(ctx, path.Root("name"), &name); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if name != "Admin" {
+		t.Errorf("name = %q, want Admin", name)
+	}
+}
@@ -0,0 +1,11 @@
+package provider
+
+import (
+	"strings"
+)
+
+// isNotFound reports whether err is a 404 response from the Looker API. The
+// rtl client only surfaces the status code in the error message.
+func isNotFound(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "status=404")
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// wholeFolder is the group claimed by resources that manage every grant of a
+// folder, such as looker_folder_access_policy.
+const wholeFolder = ""
+
+// folderAccessClaimant describes the resource making a claim. Providers are
+// not told resource addresses, so it is named by its ID, or by its folder for
+// a resource that is not created yet.
+type folderAccessClaimant struct {
+	resourceType string
+	id           string
+	folderID     string
+}
+
+func (c folderAccessClaimant) String() string {
+	if c.id != "" {
+		return fmt.Sprintf("%s with id %s", c.resourceType, c.id)
+	}
+	return fmt.Sprintf("a new %s with folder_id %s", c.resourceType, c.folderID)
+}
+
+// folderAccessClaims records, for one Terraform run, which resources manage
+// the access of which group on which folder. Every resource registers its
+// folder and group once while planning, so that two resources fighting over
+// the same grant, of the same type or not, are reported before anything is
+// applied.
+type folderAccessClaims struct {
+	mu     sync.Mutex
+	claims map[string]map[string][]folderAccessClaimant // folder -> group -> claimants
+}
+
+func newFolderAccessClaims() *folderAccessClaims {
+	return &folderAccessClaims{claims: make(map[string]map[string][]folderAccessClaimant)}
+}
+
+// claim registers that claimant manages the grants of groupID on folderID and
+// returns the other claimants already managing them. A groupID of wholeFolder
+// claims every group of the folder. An existing resource claiming the same
+// grant again is not a conflict, whereas two new resources are, even when
+// they describe alike.
+func (c *folderAccessClaims) claim(claimant folderAccessClaimant, folderID, groupID string) []folderAccessClaimant {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	groups, ok := c.claims[folderID]
+	if !ok {
+		groups = make(map[string][]folderAccessClaimant)
+		c.claims[folderID] = groups
+	}
+
+	var others []folderAccessClaimant
+	for group, claimants := range groups {
+		if group != groupID && group != wholeFolder && groupID != wholeFolder {
+			continue
+		}
+		for _, other := range claimants {
+			if claimant.id != "" && other == claimant {
+				continue
+			}
+			others = append(others, other)
+		}
+	}
+	if claimant.id == "" || !containsClaimant(groups[groupID], claimant) {
+		groups[groupID] = append(groups[groupID], claimant)
+	}
+	sort.Slice(others, func(i, j int) bool { return others[i].String() < others[j].String() })
+	return others
+}
+
+func containsClaimant(claimants []folderAccessClaimant, claimant folderAccessClaimant) bool {
+	for _, c := range claimants {
+		if c == claimant {
+			return true
+		}
+	}
+	return false
+}
+
+// claimFolderAccess registers the grant in the claims of client and reports a
+// conflict naming every other resource managing it. Unknown IDs are skipped,
+// they are checked again when the resource is planned during apply. A
+// resource being replaced must not claim with its prior state: Terraform
+// plans the replacement again as a new resource, which claims instead.
+func claimFolderAccess(client *clientBundle, claimant folderAccessClaimant, folderID, groupID string, diags *diag.Diagnostics) {
+	if client == nil || client.folderAccessClaims == nil || folderID == "" {
+		return
+	}
+	others := client.folderAccessClaims.claim(claimant, folderID, groupID)
+	if len(others) == 0 {
+		return
+	}
+
+	subject := fmt.Sprintf("the access of group %s on folder %s", groupID, folderID)
+	if groupID == wholeFolder {
+		subject = fmt.Sprintf("the access grants on folder %s", folderID)
+	}
+	names := make([]string, len(others))
+	for i, other := range others {
+		names[i] = other.String()
+	}
+	diags.AddError("Conflicting folder access resources",
+		fmt.Sprintf("Both %s and %s manage %s, so each apply would undo the other. Manage it with only one resource.",
+			claimant, strings.Join(names, " and "), subject))
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestFolderAccessClaims(t *testing.T) {
+	access := func(id string) folderAccessClaimant {
+		return folderAccessClaimant{resourceType: "looker_folder_access", id: id, folderID: "10"}
+	}
+	policy := folderAccessClaimant{resourceType: "looker_folder_access_policy", id: "7", folderID: "7"}
+
+	type claim struct {
+		claimant  folderAccessClaimant
+		folderID  string
+		groupID   string
+		conflicts []string
+	}
+	tests := map[string][]claim{
+		"different groups": {
+			{access("1"), "7", "a", nil},
+			{access("2"), "7", "b", nil},
+		},
+		"same resource planned twice": {
+			{access("1"), "7", "a", nil},
+			{access("1"), "7", "a", nil},
+		},
+		"same type, same grant": {
+			{access("1"), "7", "a", nil},
+			{access("2"), "7", "a", []string{"looker_folder_access with id 1"}},
+		},
+		"two new resources": {
+			{access(""), "7", "a", nil},
+			{access(""), "7", "a", []string{"a new looker_folder_access with folder_id 10"}},
+		},
+		"policy after grant": {
+			{access("1"), "7", "a", nil},
+			{policy, "7", wholeFolder, []string{"looker_folder_access with id 1"}},
+		},
+		"grant after policy": {
+			{policy, "7", wholeFolder, nil},
+			{access("1"), "7", "a", []string{"looker_folder_access_policy with id 7"}},
+		},
+		"other folder": {
+			{policy, "7", wholeFolder, nil},
+			{access("1"), "8", "a", nil},
+		},
+	}
+	for name, claims := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := newFolderAccessClaims()
+			for i, cl := range claims {
+				var got []string
+				for _, other := range c.claim(cl.claimant, cl.folderID, cl.groupID) {
+					got = append(got, other.String())
+				}
+				if strings.Join(got, ",") != strings.Join(cl.conflicts, ",") {
+					t.Errorf("claim %d: got conflicts %q, want %q", i, got, cl.conflicts)
+				}
+			}
+		})
+	}
+}
+
+func TestClaimFolderAccessNamesBothSides(t *testing.T) {
+	client := &clientBundle{folderAccessClaims: newFolderAccessClaims()}
+	var diags diag.Diagnostics
+	first := folderAccessClaimant{resourceType: "looker_folder_access", id: "1", folderID: "10"}
+	second := folderAccessClaimant{resourceType: "looker_folder_permission_override", id: "2", folderID: "7"}
+	claimFolderAccess(client, first, "7", "a", &diags)
+	claimFolderAccess(client, second, "7", "a", &diags)
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("got %d errors, want 1", diags.ErrorsCount())
+	}
+	detail := diags.Errors()[0].Detail()
+	for _, want := range []string{first.String(), second.String(), "group a on folder 7"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail %q does not mention %q", detail, want)
+		}
+	}
+}
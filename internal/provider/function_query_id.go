@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var _ function.Function = &queryIDFunction{}
+
+// queryIDFunction computes a stable identifier for a query definition.
+type queryIDFunction struct{}
+
+// NewQueryIDFunction is a helper function to simplify the provider implementation.
+func NewQueryIDFunction() function.Function {
+	return &queryIDFunction{}
+}
+
+// Metadata returns the function name.
+func (f *queryIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "query_id"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *queryIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Computes a stable identifier for a query definition.",
+		MarkdownDescription: "Returns a hex identifier derived from the canonical form of a query definition, given as JSON in the format of the Looker API `WriteQuery`. " +
+			"`fields`, `pivots`, `fill_fields` and `subtotals` are sorted, empty filters are dropped, and presentation settings (`vis_config`, `filter_config`, `visible_ui_sections`, `client_id`) are ignored, " +
+			"so two definitions that return the same data get the same identifier. `sorts` keep their order since it changes the result.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "query",
+				MarkdownDescription: "Query definition as JSON, e.g. `jsonencode({ model = \"ecommerce\", view = \"orders\", fields = [\"orders.count\"] })`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run computes the identifier.
+func (f *queryIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var raw string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &raw))
+	if resp.Error != nil {
+		return
+	}
+
+	canonical, err := canonicalQuery(raw)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	sum := sha256.Sum256(canonical)
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, hex.EncodeToString(sum[:16])))
+}
+
+// canonicalQuery returns the canonical JSON encoding of a query definition.
+func canonicalQuery(raw string) ([]byte, error) {
+	var query v4.WriteQuery
+	decoder := json.NewDecoder(bytes.NewReader([]byte(raw)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&query); err != nil {
+		return nil, fmt.Errorf("query must be a JSON query definition: %v", err)
+	}
+	if query.Model == "" || query.View == "" {
+		return nil, fmt.Errorf("query must set model and view")
+	}
+
+	for _, list := range []**[]string{&query.Fields, &query.Pivots, &query.FillFields, &query.Subtotals} {
+		if *list == nil {
+			continue
+		}
+		if len(**list) == 0 {
+			*list = nil
+			continue
+		}
+		sort.Strings(**list)
+	}
+	if query.Filters != nil {
+		for field, value := range *query.Filters {
+			if value == nil || value == "" {
+				delete(*query.Filters, field)
+			}
+		}
+		if len(*query.Filters) == 0 {
+			query.Filters = nil
+		}
+	}
+	query.VisConfig = nil
+	query.FilterConfig = nil
+	query.VisibleUiSections = nil
+	query.ClientId = nil
+
+	// encoding/json writes struct fields in declaration order and map keys
+	// sorted, so the encoding is deterministic.
+	return json.Marshal(query)
+}
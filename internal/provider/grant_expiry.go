@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// grantExpired reports whether an `expires_at` timestamp lies before now. A
+// null or unknown timestamp never expires.
+func grantExpired(expiresAt types.String, now time.Time) (bool, error) {
+	if expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return false, nil
+	}
+	t, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+	if err != nil {
+		return false, err
+	}
+	return !now.Before(t), nil
+}
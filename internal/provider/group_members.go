@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"terraform-provider-looker/internal/lookerapi"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// groupMemberWorkers is how many membership calls of one group run at once.
+// The Looker API has no bulk endpoint for group members, so large changes are
+// spread over parallel calls; the provider-wide max_concurrent_requests and
+// max_requests_per_second limits still apply on top.
+const groupMemberWorkers = 8
+
+// changeGroupMembers adds the users in add to a group and removes those in
+// remove, with up to groupMemberWorkers calls in flight. Removing a user who is
+// no longer a member is not an error. Once a call fails no further calls are
+// started, and every failure is returned.
+func changeGroupMembers(sdk lookerapi.Client, groupID string, add, remove []string) error {
+	type change struct {
+		userID string
+		add    bool
+	}
+	changes := make(chan change)
+
+	var (
+		mu   sync.Mutex
+		errs []error
+		wg   sync.WaitGroup
+	)
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(errs) > 0
+	}
+	for i := 0; i < groupMemberWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range changes {
+				var err error
+				if c.add {
+					userID := c.userID
+					if _, e := sdk.AddGroupUser(groupID, v4.GroupIdForGroupUserInclusion{UserId: &userID}, nil); e != nil {
+						err = fmt.Errorf("failed to add user %s to group %s: %w", c.userID, groupID, e)
+					}
+				} else if e := sdk.DeleteGroupUser(groupID, c.userID, nil); e != nil && !isNotFound(e) {
+					err = fmt.Errorf("failed to remove user %s from group %s: %w", c.userID, groupID, e)
+				}
+				if err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for _, userID := range add {
+		if failed() {
+			break
+		}
+		changes <- change{userID: userID, add: true}
+	}
+	for _, userID := range remove {
+		if failed() {
+			break
+		}
+		changes <- change{userID: userID}
+	}
+	close(changes)
+	wg.Wait()
+	return errors.Join(errs...)
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// Looker groups have no description field. The provider keeps a description
+// and labels per group as a JSON document in the Looker artifact store, under
+// artifactNamespace with the key "group/<id>".
+const artifactNamespace = "terraform-provider-looker"
+
+// groupMetadata is the document stored for a group.
+type groupMetadata struct {
+	Description string            `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+func groupMetadataKey(groupID string) string {
+	return "group/" + groupID
+}
+
+// empty reports whether there is nothing worth storing.
+func (m groupMetadata) empty() bool {
+	return m.Description == "" && len(m.Labels) == 0
+}
+
+// readGroupMetadata returns the stored metadata of a group and the version of
+// the artifact, which is nil when nothing is stored.
+func readGroupMetadata(sdk lookerapi.Client, groupID string) (groupMetadata, *int64, error) {
+	var metadata groupMetadata
+	artifacts, err := sdk.Artifact(v4.RequestArtifact{Namespace: artifactNamespace, Key: groupMetadataKey(groupID)}, nil)
+	if err != nil {
+		if isNotFound(err) {
+			return metadata, nil, nil
+		}
+		return metadata, nil, fmt.Errorf("failed to read metadata of group %s: %w", groupID, err)
+	}
+	if len(artifacts) == 0 {
+		return metadata, nil, nil
+	}
+	if err := json.Unmarshal([]byte(artifacts[0].Value), &metadata); err != nil {
+		return metadata, nil, fmt.Errorf("metadata of group %s is not valid JSON: %w", groupID, err)
+	}
+	return metadata, artifacts[0].Version, nil
+}
+
+// writeGroupMetadata stores the metadata of a group, deleting the artifact
+// when the metadata is empty.
+func writeGroupMetadata(sdk lookerapi.Client, groupID string, metadata groupMetadata) error {
+	_, version, err := readGroupMetadata(sdk, groupID)
+	if err != nil {
+		return err
+	}
+	if metadata.empty() {
+		return deleteGroupMetadata(sdk, groupID)
+	}
+
+	value, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	contentType := "application/json"
+	_, err = sdk.UpdateArtifacts(artifactNamespace, []v4.UpdateArtifact{{
+		Key:         groupMetadataKey(groupID),
+		Value:       string(value),
+		ContentType: &contentType,
+		Version:     version,
+	}}, "", nil)
+	if err != nil {
+		return fmt.Errorf("failed to store metadata of group %s: %w", groupID, err)
+	}
+	return nil
+}
+
+// deleteGroupMetadata removes the stored metadata of a group, if any.
+func deleteGroupMetadata(sdk lookerapi.Client, groupID string) error {
+	if err := sdk.DeleteArtifact(artifactNamespace, groupMetadataKey(groupID), nil); err != nil && !isNotFound(err) {
+		return fmt.Errorf("failed to delete metadata of group %s: %w", groupID, err)
+	}
+	return nil
+}
+
+// planGroupMetadata builds the metadata document from the configured values.
+func planGroupMetadata(ctx context.Context, description types.String, labels types.Map) (groupMetadata, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	metadata := groupMetadata{Description: description.ValueString()}
+	if !labels.IsNull() && !labels.IsUnknown() {
+		diags.Append(labels.ElementsAs(ctx, &metadata.Labels, false)...)
+	}
+	return metadata, diags
+}
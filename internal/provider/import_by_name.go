@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// importNamePrefix marks an import identifier that is a name rather than an
+// ID, e.g. `terraform import looker_group.sales name=Sales`.
+const importNamePrefix = "name="
+
+// namedObject is an object found by a name search.
+type namedObject struct {
+	id   *string
+	name *string
+}
+
+// importByName imports an object by ID, or by exact name when the identifier
+// has the form name=<value>. search returns the objects whose name matches the
+// Looker search pattern; since that match is case-insensitive and treats % and
+// _ as wildcards, only exact matches are kept. kind names the object in
+// errors.
+func importByName(ctx context.Context, client *clientBundle, kind string, search func(sdk lookerapi.Client, name string) ([]namedObject, error), req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name, byName := strings.CutPrefix(req.ID, importNamePrefix)
+	if !byName {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+	if name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <id> or name=<name>. Got: %q", req.ID),
+		)
+		return
+	}
+	if !checkClient(client, nil, &resp.Diagnostics) {
+		return
+	}
+
+	id, diags := findByName(client.SDK(ctx), kind, name, search)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// findByName returns the ID of the only object of kind named exactly name,
+// searching with search as described for importByName.
+func findByName(sdk lookerapi.Client, kind, name string, search func(sdk lookerapi.Client, name string) ([]namedObject, error)) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	results, err := search(sdk, name)
+	if err != nil {
+		diags.AddError("API error", fmt.Sprintf("Failed to search %ss named %q: %v", kind, name, err))
+		return "", diags
+	}
+	var ids []string
+	for _, result := range results {
+		if result.id != nil && result.name != nil && *result.name == name {
+			ids = append(ids, *result.id)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		diags.AddError("Not found", fmt.Sprintf("No %s named %q found", kind, name))
+		return "", diags
+	case 1:
+		return ids[0], diags
+	default:
+		diags.AddError("Multiple found",
+			fmt.Sprintf("Found %d %ss named %q (IDs %s); use the ID instead", len(ids), kind, name, strings.Join(ids, ", ")))
+		return "", diags
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// membershipSnapshotAttrTypes are the attribute types of `membership_snapshot`.
+var membershipSnapshotAttrTypes = map[string]attr.Type{
+	"user_ids":    types.ListType{ElemType: types.StringType},
+	"user_emails": types.ListType{ElemType: types.StringType},
+	"hash":        types.StringType,
+}
+
+// membershipSnapshotAttribute is the schema of `membership_snapshot`, shared by
+// the resources that manage group membership.
+var membershipSnapshotAttribute = schema.SingleNestedAttribute{
+	Description: "The members of the group as read back after each apply, sorted so that state diffs show exactly who was added or removed.",
+	Computed:    true,
+	Attributes: map[string]schema.Attribute{
+		"user_ids": schema.ListAttribute{
+			Description: "Sorted IDs of the group members.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"user_emails": schema.ListAttribute{
+			Description: "Sorted emails of the group members that have one.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"hash": schema.StringAttribute{
+			Description: "SHA-256 of the sorted member IDs, handy to compare memberships across applies.",
+			Computed:    true,
+		},
+	},
+}
+
+// newMembershipSnapshot builds the `membership_snapshot` value from the users
+// of a group.
+func newMembershipSnapshot(ctx context.Context, users []v4.User) (types.Object, diag.Diagnostics) {
+	userIDs := []string{}
+	userEmails := []string{}
+	for _, user := range users {
+		if user.Id != nil {
+			userIDs = append(userIDs, *user.Id)
+		}
+		if user.Email != nil && *user.Email != "" {
+			userEmails = append(userEmails, *user.Email)
+		}
+	}
+	sort.Strings(userIDs)
+	sort.Strings(userEmails)
+	sum := sha256.Sum256([]byte(strings.Join(userIDs, "\n")))
+
+	return types.ObjectValueFrom(ctx, membershipSnapshotAttrTypes, struct {
+		UserIDs    []string `tfsdk:"user_ids"`
+		UserEmails []string `tfsdk:"user_emails"`
+		Hash       string   `tfsdk:"hash"`
+	}{userIDs, userEmails, hex.EncodeToString(sum[:])})
+}
+
+// groupMemberFields are the user fields requested when listing members: enough
+// for user_ids and the membership snapshot, and nothing more, so that reading
+// very large groups stays cheap.
+const groupMemberFields = "id,email"
+
+// groupMemberPageSize is the number of members fetched per AllGroupUsers call.
+const groupMemberPageSize = 500
+
+// allGroupUsers lists the members of a group with only the given fields, in a
+// stable order. It pages through the members until exhausted, since a single
+// call stops at the API's default page size and would silently truncate large
+// groups.
+func allGroupUsers(sdk lookerapi.Client, groupID, fields string) ([]v4.User, error) {
+	sorts := "id"
+	limit := int64(groupMemberPageSize)
+	request := v4.RequestAllGroupUsers{GroupId: groupID, Fields: &fields, Sorts: &sorts, Limit: &limit}
+
+	users := []v4.User{}
+	for offset := int64(0); ; offset += limit {
+		request.Offset = &offset
+		page, err := sdk.AllGroupUsers(request, nil)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, page...)
+		if int64(len(page)) < limit {
+			return users, nil
+		}
+	}
+}
+
+// groupMembershipSnapshot reads the users of a group and returns their snapshot.
+func groupMembershipSnapshot(ctx context.Context, sdk lookerapi.Client, groupID string) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	users, err := allGroupUsers(sdk, groupID, groupMemberFields)
+	if err != nil {
+		diags.AddError("API error", fmt.Sprintf("Failed to get users for group %s: %v", groupID, err))
+		return types.ObjectNull(membershipSnapshotAttrTypes), diags
+	}
+	return newMembershipSnapshot(ctx, users)
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+
+	"terraform-provider-looker/internal/lookerapi/lookerapimock"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+// errNotFound is the error the SDK returns for a missing object.
+var errNotFound = errors.New(`response error. status=404 Not Found. error={"message":"Not found"}`)
+
+// newMockClient returns a client whose API calls go to a MockClient, which
+// fails the test on every call that was not expected.
+func newMockClient(t *testing.T) (*clientBundle, *lookerapimock.MockClient) {
+	api := lookerapimock.NewMockClient(gomock.NewController(t))
+	return &clientBundle{
+		api:                api,
+		folderAccessClaims: newFolderAccessClaims(),
+		users:              newUserEmailCache(),
+		sudo:               newSudoSessions(),
+	}, api
+}
+
+// mockStreamer makes the streamed listings of client go to a MockStreamer.
+func mockStreamer(t *testing.T, client *clientBundle) *lookerapimock.MockStreamer {
+	streamer := lookerapimock.NewMockStreamer(gomock.NewController(t))
+	client.streamer = streamer
+	return streamer
+}
+
+// expectContentAccess expects the grants on the content with content metadata
+// ID contentMetadataID to be listed once, returning grants.
+func expectContentAccess(t *testing.T, streamer *lookerapimock.MockStreamer, contentMetadataID string, grants ...v4.ContentMetaGroupUser) *gomock.Call {
+	query := url.Values{"content_metadata_id": {contentMetadataID}, "fields": {contentAccessFields}}
+	if grants == nil {
+		grants = []v4.ContentMetaGroupUser{}
+	}
+	return streamer.EXPECT().Stream(gomock.Any(), "/content_metadata_access", query).Return(jsonBody(t, grants), nil)
+}
+
+// jsonBody returns v encoded as JSON, as the body of a streamed listing.
+func jsonBody(t *testing.T, v any) io.ReadCloser {
+	t.Helper()
+	body, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return io.NopCloser(bytes.NewReader(body))
+}
+
+// testResource calls the CRUD methods of a resource directly, with the plan
+// and state the framework would pass after planning. Values are given per
+// top-level attribute as Go values, e.g. []string for a set of strings.
+type testResource struct {
+	t      *testing.T
+	r      resource.Resource
+	schema schema.Schema
+}
+
+func newTestResource(t *testing.T, r resource.Resource) *testResource {
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema: %v", resp.Diagnostics)
+	}
+	return &testResource{t: t, r: r, schema: resp.Schema}
+}
+
+// object returns values as an object of the schema. Missing attributes are
+// null, or unknown when computed and unknownComputed is set, as in the plan
+// of a new resource.
+func (tr *testResource) object(values map[string]any, unknownComputed bool) tftypes.Value {
+	tr.t.Helper()
+	ctx := context.Background()
+	objectType := tr.schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		var value any
+		if attribute, ok := tr.schema.Attributes[name]; ok && unknownComputed && attribute.IsComputed() {
+			value = tftypes.UnknownValue
+		}
+		attributes[name] = tftypes.NewValue(attributeType, value)
+	}
+
+	state := tfsdk.State{Schema: tr.schema, Raw: tftypes.NewValue(objectType, attributes)}
+	for name, value := range values {
+		if diags := state.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			tr.t.Fatalf("setting %s: %v", name, diags)
+		}
+	}
+	return state.Raw
+}
+
+// state returns a prior state holding values.
+func (tr *testResource) state(values map[string]any) tfsdk.State {
+	tr.t.Helper()
+	return tfsdk.State{Schema: tr.schema, Raw: tr.object(values, false)}
+}
+
+func (tr *testResource) emptyState() tfsdk.State {
+	return tfsdk.State{Schema: tr.schema, Raw: tftypes.NewValue(tr.schema.Type().TerraformType(context.Background()), nil)}
+}
+
+// modifyPlan calls ModifyPlan of the resource for planning values over the
+// prior state.
+func (tr *testResource) modifyPlan(state tfsdk.State, values map[string]any) (tfsdk.Plan, diag.Diagnostics) {
+	tr.t.Helper()
+	modifier, ok := tr.r.(resource.ResourceWithModifyPlan)
+	if !ok {
+		tr.t.Fatalf("%T does not modify plans", tr.r)
+	}
+	plan := tfsdk.Plan{Schema: tr.schema, Raw: tr.object(values, state.Raw.IsNull())}
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: tr.schema, Raw: tr.object(values, false)},
+		Plan:   plan,
+		State:  state,
+	}
+	resp := resource.ModifyPlanResponse{Plan: plan}
+	modifier.ModifyPlan(context.Background(), req, &resp)
+	return resp.Plan, resp.Diagnostics
+}
+
+func (tr *testResource) create(values map[string]any) (tfsdk.State, diag.Diagnostics) {
+	tr.t.Helper()
+	req := resource.CreateRequest{
+		Config: tfsdk.Config{Schema: tr.schema, Raw: tr.object(values, false)},
+		Plan:   tfsdk.Plan{Schema: tr.schema, Raw: tr.object(values, true)},
+	}
+	resp := resource.CreateResponse{State: tr.emptyState()}
+	tr.r.Create(context.Background(), req, &resp)
+	return resp.State, resp.Diagnostics
+}
+
+func (tr *testResource) read(state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	tr.t.Helper()
+	resp := resource.ReadResponse{State: state}
+	tr.r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+	return resp.State, resp.Diagnostics
+}
+
+// update applies values, which must hold the attributes the plan keeps from
+// state such as `id`, to the prior state.
+func (tr *testResource) update(state tfsdk.State, values map[string]any) (tfsdk.State, diag.Diagnostics) {
+	tr.t.Helper()
+	req := resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: tr.schema, Raw: tr.object(values, false)},
+		Plan:   tfsdk.Plan{Schema: tr.schema, Raw: tr.object(values, true)},
+		State:  state,
+	}
+	resp := resource.UpdateResponse{State: state}
+	tr.r.Update(context.Background(), req, &resp)
+	return resp.State, resp.Diagnostics
+}
+
+func (tr *testResource) delete(state tfsdk.State) diag.Diagnostics {
+	tr.t.Helper()
+	resp := resource.DeleteResponse{State: state}
+	tr.r.Delete(context.Background(), resource.DeleteRequest{State: state}, &resp)
+	return resp.Diagnostics
+}
+
+// get reads attribute name of state into target. Attributes of nested
+// objects are named as in Terraform, e.g. "timeouts.create".
+func (tr *testResource) get(state tfsdk.State, name string, target any) {
+	tr.t.Helper()
+	names := strings.Split(name, ".")
+	attribute := path.Root(names[0])
+	for _, name := range names[1:] {
+		attribute = attribute.AtName(name)
+	}
+	if diags := state.GetAttribute(context.Background(), attribute, target); diags.HasError() {
+		tr.t.Fatalf("reading %s: %v", name, diags)
+	}
+}
+
+// invokeAction configures a with client and invokes it with values, given as
+// for testResource. It returns the progress messages sent.
+func invokeAction(t *testing.T, a action.Action, client *clientBundle, values map[string]any) ([]string, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Schema: %v", schemaResp.Diagnostics)
+	}
+	var configure action.ConfigureResponse
+	a.(action.ActionWithConfigure).Configure(ctx, action.ConfigureRequest{ProviderData: client}, &configure)
+	requireNoErrors(t, "Configure", configure.Diagnostics)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+	state := tfsdk.State(config)
+	for name, value := range values {
+		if diags := state.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("setting %s: %v", name, diags)
+		}
+	}
+	config.Raw = state.Raw
+
+	var messages []string
+	resp := action.InvokeResponse{SendProgress: func(event action.InvokeProgressEvent) {
+		messages = append(messages, event.Message)
+	}}
+	a.Invoke(ctx, action.InvokeRequest{Config: config}, &resp)
+	return messages, resp.Diagnostics
+}
+
+// requireNoErrors fails the test when diags hold an error.
+func requireNoErrors(t *testing.T, operation string, diags diag.Diagnostics) {
+	t.Helper()
+	if diags.HasError() {
+		t.Fatalf("%s: %v", operation, diags)
+	}
+}
+
+// ptr returns a pointer to v, for the optional fields of SDK structs.
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// administerPermission grants every other permission.
+const administerPermission = "administer"
+
+// permissionTree maps every permission of the instance to its parent, or to ""
+// for top-level permissions. Looker grants a permission's parents along with
+// it, so permission sets read back with more permissions than were written.
+type permissionTree map[string]string
+
+// loadPermissionTree returns the permission tree of the instance.
+func loadPermissionTree(sdk lookerapi.Client) (permissionTree, error) {
+	permissions, err := sdk.AllPermissions(nil)
+	if err != nil {
+		return nil, fmt.Errorf("API error listing permissions: %w", err)
+	}
+	tree := make(permissionTree, len(permissions))
+	for _, p := range permissions {
+		if p.Permission == nil {
+			continue
+		}
+		parent := ""
+		if p.Parent != nil {
+			parent = *p.Parent
+		}
+		tree[*p.Permission] = parent
+	}
+	return tree, nil
+}
+
+// expand returns the permissions effectively granted by permissions: each one
+// with its parents, or every permission if administer is among them.
+func (t permissionTree) expand(permissions []string) map[string]bool {
+	expanded := make(map[string]bool, len(permissions))
+	for _, p := range permissions {
+		if p == administerPermission {
+			for q := range t {
+				expanded[q] = true
+			}
+		}
+		// The depth bound guards against a cycle in the tree.
+		for depth := 0; p != "" && !expanded[p] && depth <= len(t); depth++ {
+			expanded[p] = true
+			p = t[p]
+		}
+	}
+	return expanded
+}
+
+// equivalent reports whether a and b grant the same permissions.
+func (t permissionTree) equivalent(a, b []string) bool {
+	ea, eb := t.expand(a), t.expand(b)
+	if len(ea) != len(eb) {
+		return false
+	}
+	for p := range ea {
+		if !eb[p] {
+			return false
+		}
+	}
+	return true
+}
+
+// refreshPermissions returns the permissions read from Looker as the value of
+// a permissions attribute whose prior value is current. Looker expands the
+// permissions it stores, adding the parents of each one and everything for
+// administer, so current is kept when it grants the same access; stable
+// configurations then show no diff.
+func refreshPermissions(ctx context.Context, sdk lookerapi.Client, current types.Set, read []string) (types.Set, diag.Diagnostics) {
+	if !current.IsNull() && !current.IsUnknown() {
+		var configured []string
+		if diags := current.ElementsAs(ctx, &configured, false); diags.HasError() {
+			return current, diags
+		}
+		if !sameStrings(configured, read) {
+			tree, err := loadPermissionTree(sdk)
+			if err != nil {
+				var diags diag.Diagnostics
+				diags.AddError("API error", err.Error())
+				return current, diags
+			}
+			if tree.equivalent(configured, read) {
+				return current, nil
+			}
+		}
+	}
+	return types.SetValueFrom(ctx, types.StringType, read)
+}
+
+// sameStrings reports whether a and b hold the same strings, in any order.
+func sameStrings(a, b []string) bool {
+	seen := make(map[string]int, len(a))
+	for _, s := range a {
+		seen[s]++
+	}
+	for _, s := range b {
+		seen[s]--
+	}
+	for _, n := range seen {
+		if n != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// testPermissionTree is a small excerpt of Looker's permission tree.
+var testPermissionTree = permissionTree{
+	"access_data":           "",
+	"see_lookml_dashboards": "access_data",
+	"see_looks":             "access_data",
+	"see_user_dashboards":   "see_looks",
+	"explore":               "see_looks",
+	"save_content":          "",
+	"administer":            "",
+}
+
+func TestPermissionTreeExpand(t *testing.T) {
+	tests := map[string]struct {
+		permissions []string
+		want        []string
+	}{
+		"none":      {nil, []string{}},
+		"top level": {[]string{"save_content"}, []string{"save_content"}},
+		"with parents": {
+			[]string{"explore"},
+			[]string{"access_data", "explore", "see_looks"},
+		},
+		"shared parents": {
+			[]string{"explore", "see_user_dashboards"},
+			[]string{"access_data", "explore", "see_looks", "see_user_dashboards"},
+		},
+		"administer": {
+			[]string{"administer"},
+			[]string{"access_data", "administer", "explore", "save_content", "see_lookml_dashboards", "see_looks", "see_user_dashboards"},
+		},
+		"unknown permission": {
+			[]string{"future_permission"},
+			[]string{"future_permission"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := []string{}
+			for p := range testPermissionTree.expand(test.permissions) {
+				got = append(got, p)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("expand(%v) = %v, want %v", test.permissions, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPermissionTreeExpandCycle(t *testing.T) {
+	tree := permissionTree{"a": "b", "b": "a"}
+	if got := tree.expand([]string{"a"}); len(got) != 2 {
+		t.Errorf("expand on a cycle = %v, want a and b", got)
+	}
+}
+
+func TestPermissionTreeEquivalent(t *testing.T) {
+	tests := map[string]struct {
+		a, b []string
+		want bool
+	}{
+		"same":              {[]string{"explore"}, []string{"explore"}, true},
+		"parents read back": {[]string{"explore"}, []string{"access_data", "see_looks", "explore"}, true},
+		"administer read back": {
+			[]string{"administer"},
+			[]string{"access_data", "administer", "explore", "save_content", "see_lookml_dashboards", "see_looks", "see_user_dashboards"},
+			true,
+		},
+		"missing child":    {[]string{"explore"}, []string{"access_data", "see_looks"}, false},
+		"extra permission": {[]string{"explore"}, []string{"explore", "save_content"}, false},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := testPermissionTree.equivalent(test.a, test.b); got != test.want {
+				t.Errorf("equivalent(%v, %v) = %v, want %v", test.a, test.b, got, test.want)
+			}
+		})
+	}
+}
+
+func TestSameStrings(t *testing.T) {
+	tests := []struct {
+		a, b []string
+		want bool
+	}{
+		{nil, nil, true},
+		{[]string{"a", "b"}, []string{"b", "a"}, true},
+		{[]string{"a", "a"}, []string{"a"}, false},
+		{[]string{"a"}, []string{"b"}, false},
+	}
+	for _, test := range tests {
+		if got := sameStrings(test.a, test.b); got != test.want {
+			t.Errorf("sameStrings(%v, %v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
@@ -3,22 +3,38 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
-	"os"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/looker-open-source/sdk-codegen/go/rtl"
 	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
 )
 
-var _ provider.Provider = &lookerProvider{}
+var (
+	_ provider.Provider              = &lookerProvider{}
+	_ provider.ProviderWithActions   = &lookerProvider{}
+	_ provider.ProviderWithFunctions = &lookerProvider{}
+)
 
 type lookerProvider struct{ version string }
 
@@ -27,13 +43,153 @@ func New(version string) func() provider.Provider {
 }
 
 type providerModel struct {
-	BaseURL      types.String `tfsdk:"base_url"`
-	ClientID     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
+	BaseURL             types.String `tfsdk:"base_url"`
+	ClientID            types.String `tfsdk:"client_id"`
+	ClientSecret        types.String `tfsdk:"client_secret"`
+	ClientSecretCommand types.String `tfsdk:"client_secret_command"`
+	AccessToken         types.String `tfsdk:"access_token"`
+	ConfigFile          types.String `tfsdk:"config_file"`
+	ConfigSection       types.String `tfsdk:"config_section"`
+	SudoUserID          types.String `tfsdk:"sudo_user_id"`
+	AgentTag            types.String `tfsdk:"agent_tag"`
+	Timeout             types.Int64  `tfsdk:"timeout"`
+	WebBaseURL          types.String `tfsdk:"web_base_url"`
+	MaxRetries          types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMax        types.Int64  `tfsdk:"retry_wait_max"`
+	OperationTimeout    types.String `tfsdk:"operation_timeout"`
+	MaxConcurrent       types.Int64  `tfsdk:"max_concurrent_requests"`
+	MaxPerSecond        types.Int64  `tfsdk:"max_requests_per_second"`
+	SSLVerify           types.Bool   `tfsdk:"ssl_verify"`
+	CACertPEM           types.String `tfsdk:"ca_cert_pem"`
+	Headers             types.Map    `tfsdk:"headers"`
+
+	SkipCredentialsValidation types.Bool `tfsdk:"skip_credentials_validation"`
+	PrefetchUsers             types.Bool `tfsdk:"prefetch_users"`
+	LogHTTPBodies             types.Bool `tfsdk:"log_http_bodies"`
 }
 
 type clientBundle struct {
-	SDK *v4.LookerSDK
+	session *rtl.AuthSession
+
+	// api, when set, is returned by SDK instead of a client of session, e.g.
+	// a mock in unit tests.
+	api lookerapi.Client
+
+	// streamer, when set, is returned by Streamer instead of a streamer of
+	// session, e.g. a mock in unit tests.
+	streamer lookerapi.Streamer
+
+	// settings and options are the API and transport settings the session
+	// was created with.
+	settings rtl.ApiSettings
+	options  clientOptions
+
+	// webBaseURL is the root of the Looker web UI, used to build `web_url`.
+	webBaseURL string
+
+	// operationTimeout bounds resource operations without their own timeouts.
+	operationTimeout time.Duration
+
+	// folderAccessClaims detects folder access resources that overlap.
+	folderAccessClaims *folderAccessClaims
+
+	// users caches the user IDs of resolved emails.
+	users *userEmailCache
+
+	// transport sends the requests of the session before authentication.
+	transport http.RoundTripper
+
+	// sudo holds the clients acting as other users. admin is the client they
+	// log in through and sudoUserID the user they act as; both are empty for
+	// the admin client itself.
+	sudo       *sudoSessions
+	admin      *clientBundle
+	sudoUserID string
+}
+
+// clientOptions configures the HTTP transport of a clientBundle.
+type clientOptions struct {
+	retry retryPolicy
+
+	// maxConcurrent and maxPerSecond limit the requests of the session, zero
+	// means unlimited.
+	maxConcurrent int
+	maxPerSecond  int
+
+	// caCertPEM holds certificates trusted in addition to the system roots.
+	caCertPEM string
+
+	// headers are added to every request.
+	headers map[string]string
+
+	// accessToken, when set, authenticates every request instead of logging in
+	// with the client ID and secret of the settings.
+	accessToken string
+
+	// logBodies logs the redacted request and response bodies.
+	logBodies bool
+}
+
+// newClientBundle creates the authenticated session for settings.
+func newClientBundle(settings rtl.ApiSettings, options clientOptions) *clientBundle {
+	tlsConfig := &tls.Config{InsecureSkipVerify: !settings.VerifySsl}
+	if options.caCertPEM != "" {
+		roots, err := x509.SystemCertPool()
+		if err != nil {
+			roots = x509.NewCertPool()
+		}
+		roots.AppendCertsFromPEM([]byte(options.caCertPEM))
+		tlsConfig.RootCAs = roots
+	}
+	base := &http.Transport{TLSClientConfig: tlsConfig}
+	// Retries pass through the limits again.
+	transport := newRateLimitTransport(newConcurrencyTransport(newHeaderTransport(&logTransport{base: base, bodies: options.logBodies}, settings.AgentTag, options.headers), options.maxConcurrent, options.maxPerSecond), options.retry)
+	var auth http.RoundTripper = &tokenTransport{base: transport, token: options.accessToken}
+	if options.accessToken == "" {
+		auth = newReloginTransport(func() http.RoundTripper {
+			return rtl.NewAuthSessionWithTransport(settings, transport).Client.Transport
+		})
+	}
+	session := &rtl.AuthSession{Config: settings, Client: http.Client{Transport: auth}}
+	return &clientBundle{
+		session:            session,
+		settings:           settings,
+		options:            options,
+		folderAccessClaims: newFolderAccessClaims(),
+		users:              newUserEmailCache(),
+		transport:          transport,
+		sudo:               newSudoSessions(),
+	}
+}
+
+// SDK returns a Looker SDK client whose HTTP requests are bound to ctx, so
+// that cancelling the Terraform operation (Ctrl-C, timeouts) aborts in-flight
+// calls instead of letting them run to completion.
+func (c *clientBundle) SDK(ctx context.Context) lookerapi.Client {
+	if c.api != nil {
+		return c.api
+	}
+	session := *c.session
+	session.Client.Transport = &contextTransport{ctx: ctx, base: c.session.Client.Transport}
+	return v4.NewLookerSDK(&session)
+}
+
+// Streamer returns the streamer for listings too large to read through the
+// SDK. Requests are bound to the context passed to Stream.
+func (c *clientBundle) Streamer() lookerapi.Streamer {
+	if c.streamer != nil {
+		return c.streamer
+	}
+	return lookerapi.NewStreamer(c.session)
+}
+
+// webURL returns the link to a piece of content in the Looker web UI, e.g.
+// kind "dashboards", or null when id is not known.
+func (c *clientBundle) webURL(kind string, id *string) types.String {
+	if id == nil || *id == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(c.webBaseURL + "/" + kind + "/" + url.PathEscape(*id))
 }
 
 func (p *lookerProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -46,19 +202,120 @@ func (p *lookerProvider) Schema(ctx context.Context, _ provider.SchemaRequest, r
 		MarkdownDescription: "Provider for Looker (Google Cloud core) API 4.0",
 		Attributes: map[string]schema.Attribute{
 			"base_url": schema.StringAttribute{
-				MarkdownDescription: "Looker host base URL (no `/api/*`). Example: `https://myinstance.looker.com:19999`",
+				MarkdownDescription: "Looker host base URL (no `/api/*`). Example: `https://myinstance.looker.com:19999`. Can also be set via the `LOOKER_BASE_URL` environment variable.",
 				Optional:            true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(8),
 				},
 			},
 			"client_id": schema.StringAttribute{
-				Optional:  true,
-				Sensitive: true,
+				MarkdownDescription: "Client ID for authentication. Can also be set via the `LOOKER_CLIENT_ID` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
 			},
 			"client_secret": schema.StringAttribute{
-				Optional:  true,
-				Sensitive: true,
+				MarkdownDescription: "Client Secret for authentication. Can also be set via the `LOOKER_CLIENT_SECRET` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"client_secret_command": schema.StringAttribute{
+				MarkdownDescription: "Shell command whose standard output is used as the client secret, e.g. `vault kv get -field=client_secret secret/looker`. Runs when the provider is configured, so the secret never appears in variables or state. Conflicts with `client_secret`. Can also be set via the `LOOKER_CLIENT_SECRET_COMMAND` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "API access token used instead of `client_id` and `client_secret`, e.g. a short-lived token minted by a secrets broker. The provider does not renew it, so it must stay valid for the whole run. Can also be set via the `LOOKER_ACCESS_TOKEN` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"config_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a `looker.ini` file, as used by the Looker SDKs, to read `base_url`, `client_id`, `client_secret` and `verify_ssl` from. Settings of the provider block and the environment take precedence over the file. Can also be set via the `LOOKER_CONFIG_FILE` environment variable.",
+				Optional:            true,
+			},
+			"config_section": schema.StringAttribute{
+				MarkdownDescription: "Section of `config_file` to read. Defaults to `Looker`. Can also be set via the `LOOKER_CONFIG_SECTION` environment variable.",
+				Optional:            true,
+			},
+			"sudo_user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of a user every API call runs as, so that e.g. folders and dashboards are created with that user as owner. The provider logs in as an admin and obtains a token for the user through `login_user`, renewing it before it expires. `looker_folder` and `looker_dashboard` can override it. Can also be set via the `LOOKER_SUDO_USER_ID` environment variable.",
+				Optional:            true,
+			},
+			"agent_tag": schema.StringAttribute{
+				MarkdownDescription: "Extra text appended to the `User-Agent` of every API call, e.g. a workspace name. The `User-Agent` always starts with `terraform-provider-looker/<version>`, so Terraform traffic can be identified in the Looker API usage logs. Can also be set via the `LOOKER_AGENT_TAG` environment variable.",
+				Optional:            true,
+			},
+			"web_base_url": schema.StringAttribute{
+				MarkdownDescription: "Root URL of the Looker web UI, used to build the `web_url` of content, e.g. a vanity domain such as `https://analytics.example.com`. Defaults to `base_url` without the legacy API port `19999`. Can also be set via the `LOOKER_WEB_BASE_URL` environment variable.",
+				Optional:            true,
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout of a single API call in seconds. Defaults to `120`. Can also be set via the `LOOKER_TIMEOUT` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtMost(math.MaxInt32),
+				},
+			},
+			"operation_timeout": schema.StringAttribute{
+				MarkdownDescription: "Default deadline of a resource operation (create, read, update or delete) as a duration such as `10m`, covering every API call it makes. A resource's `timeouts` attribute overrides it per operation. Unset means no deadline beyond the per-call `timeout`. Can also be set via the `LOOKER_OPERATION_TIMEOUT` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(durationPattern, "must be a duration such as 30s, 10m or 1h30m"),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of API calls in flight at once, shared by all resources and data sources whatever Terraform's `-parallelism`. Unlimited by default. Can also be set via the `LOOKER_MAX_CONCURRENT_REQUESTS` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
+			},
+			"max_requests_per_second": schema.Int64Attribute{
+				MarkdownDescription: "Maximum average number of API calls per second, shared by all resources and data sources. Short bursts up to this number are allowed after a quiet period. Unlimited by default. Can also be set via the `LOOKER_MAX_REQUESTS_PER_SECOND` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10000),
+				},
+			},
+			"ssl_verify": schema.BoolAttribute{
+				MarkdownDescription: "Whether to verify the TLS certificate of the Looker API. Only disable it for testing. Defaults to `true`. Can also be set via the `LOOKER_VERIFY_SSL` environment variable.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates trusted in addition to the system roots, e.g. the root of an internal PKI issuing the certificate of a self-hosted instance. Can also be set via the `LOOKER_CA_CERT_PEM` environment variable.",
+				Optional:            true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Extra HTTP headers sent with every API call, including the login, e.g. the service token headers required by an access gateway in front of Looker. `Authorization`, `User-Agent` and `X-Looker-Appid` are set by the provider and cannot be overridden.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"log_http_bodies": schema.BoolAttribute{
+				MarkdownDescription: "Log the body of every API request and response at `DEBUG` level, e.g. to attach a transcript to a Looker support ticket. Values of keys such as `client_secret`, `password`, `access_token` and `embed_secret` are replaced by `[REDACTED]`, and bodies that are not JSON are only summarized. Defaults to `false`. Can also be set via the `LOOKER_LOG_HTTP_BODIES` environment variable.",
+				Optional:            true,
+			},
+			"prefetch_users": schema.BoolAttribute{
+				MarkdownDescription: "Load the ID and email of every user once, on the first `user_emails` lookup, instead of searching for each email. Faster when many groups list members by email; slower on instances with many users and few emails to resolve. Emails are cached for the run either way. Defaults to `false`. Can also be set via the `LOOKER_PREFETCH_USERS` environment variable.",
+				Optional:            true,
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				MarkdownDescription: "Skip the `/me` call that checks the credentials when the provider is configured. Bad credentials then surface on the first resource or data source that calls the API. Defaults to `false`. Can also be set via the `LOOKER_SKIP_CREDENTIALS_VALIDATION` environment variable.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "How often an API call is retried when Looker answers `429 Too Many Requests`, or a server error (5xx) for calls other than `POST`. `0` disables retries. Defaults to `5`. Can also be set via the `LOOKER_MAX_RETRIES` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				MarkdownDescription: "Longest wait between two attempts of an API call in seconds. Waits start at one second and double on every retry; a `Retry-After` header sent by Looker replaces the computed wait. Defaults to `30`. Can also be set via the `LOOKER_RETRY_WAIT_MAX` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 3600),
+				},
 			},
 		},
 	}
@@ -71,55 +328,97 @@ func (p *lookerProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	baseURL := os.Getenv("LOOKER_BASE_URL")
-	clientID := os.Getenv("LOOKER_CLIENT_ID")
-	clientSecret := os.Getenv("LOOKER_CLIENT_SECRET")
-
-	if !cfg.BaseURL.IsNull() {
-		baseURL = cfg.BaseURL.ValueString()
-	}
-	if !cfg.ClientID.IsNull() {
-		clientID = cfg.ClientID.ValueString()
-	}
-	if !cfg.ClientSecret.IsNull() {
-		clientSecret = cfg.ClientSecret.ValueString()
+	// Settings depending on values not known until apply, e.g. outputs of the
+	// module creating the instance, leave the provider unconfigured for the
+	// plan. Terraform versions supporting deferred actions defer everything
+	// using it; otherwise resources plan without API calls and the client is
+	// created once the values are known.
+	if unknown := unknownConfig(cfg); len(unknown) > 0 {
+		tflog.Info(ctx, fmt.Sprintf("Provider configuration not known yet (%s), not creating the Looker API client", strings.Join(unknown, ", ")))
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown}
+			return
+		}
+		// Anything that does need the API then explains why it cannot.
+		pending := &pendingConfig{unknown: unknown}
+		resp.DataSourceData = pending
+		resp.ResourceData = pending
+		resp.ActionData = pending
+		return
 	}
 
-	if baseURL == "" || clientID == "" || clientSecret == "" {
-		resp.Diagnostics.AddError("Missing configuration",
-			"base_url, client_id, and client_secret must be set (or via LOOKER_* env).")
+	resolved, diags := resolveConfig(ctx, cfg)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	settings := &rtl.ApiSettings{
-		BaseUrl:      baseURL,
-		ClientId:     clientID,
-		ClientSecret: clientSecret,
+		BaseUrl:      resolved.BaseURL,
+		ApiVersion:   "4.0",
+		ClientId:     resolved.ClientID,
+		ClientSecret: resolved.ClientSecret,
+		AgentTag:     agentTag(p.version, resolved.AgentTag),
+		Timeout:      resolved.Timeout,
+		VerifySsl:    resolved.VerifySSL,
 	}
 
-	authSession := rtl.NewAuthSession(*settings)
+	client := newClientBundle(*settings, clientOptions{
+		retry:         resolved.Retry,
+		maxConcurrent: resolved.MaxConcurrent,
+		maxPerSecond:  resolved.MaxPerSecond,
+		caCertPEM:     resolved.CACertPEM,
+		headers:       resolved.Headers,
+		accessToken:   resolved.AccessToken,
+		logBodies:     resolved.LogHTTPBodies,
+	})
+	client.webBaseURL = resolved.WebBaseURL
+	client.operationTimeout = resolved.OperationTimeout
+	client.users.prefetch = resolved.PrefetchUsers
+	client = client.asUser(resolved.SudoUserID)
 
-	// Initialize the SDK
-	sdk := v4.NewLookerSDK(authSession)
-
-	// optional: quick ping to fail-fast on bad creds
-	if _, err := sdk.Me("", nil); err != nil {
-		resp.Diagnostics.AddError("Looker authentication failed",
-			fmt.Sprintf("Failed calling /me with provided credentials: %v", err))
-		return
+	// Fail fast on bad credentials unless disabled.
+	if !resolved.SkipCredentialsValidation {
+		if _, err := client.SDK(ctx).Me("", nil); err != nil {
+			resp.Diagnostics.AddError("Looker authentication failed",
+				fmt.Sprintf("Failed calling /me with provided credentials: %v", err))
+			return
+		}
 	}
 
-	resp.DataSourceData = &clientBundle{SDK: sdk}
-	resp.ResourceData = &clientBundle{SDK: sdk}
+	resp.DataSourceData = client
+	resp.ResourceData = client
+	resp.ActionData = client
 }
 
 func (p *lookerProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewPermissionSetDataSource,
+		NewPermissionSetsDataSource,
+		deprecatedDataSource("permissionset", "permission_set", NewPermissionSetDataSource),
 		NewModelSetDataSource,
+		NewModelSetsDataSource,
 		NewRoleDataSource,
+		NewRolesDataSource,
+		NewRoleAssignmentsDataSource,
 		NewGroupDataSource,
+		NewGroupWithRolesDataSource,
+		NewGroupUsersCountDataSource,
+		NewGroupsDataSource,
 		NewFolderDataSource,
+		NewFoldersDataSource,
+		NewContentAccessAuditDataSource,
+		NewUserEffectivePermissionsDataSource,
+		NewUsersDataSource,
+		NewHomepageItemsDataSource,
+		NewAlertNotificationsDataSource,
+		NewLookMLDashboardDataSource,
+		NewContentExportDataSource,
+		NewContentSearchDataSource,
+		NewProjectGitDeployKeyDataSource,
+		NewLocalesDataSource,
+		NewInstancesDataSource,
+		NewUserLoginLockoutsDataSource,
 	}
 }
 
@@ -128,11 +427,46 @@ func (p *lookerProvider) Resources(_ context.Context) []func() resource.Resource
 		NewPermissionSetResource,
 		NewModelSetResource,
 		NewRoleResource,
+		NewRoleBundleResource,
 		NewGroupResource,
+		NewGroupMembershipResource,
 		NewRoleGroupsResource,
+		NewRoleGroupResource,
+		NewRoleUsersResource,
 		NewFolderResource,
+		NewFolderTreeResource,
 		NewFolderAccessResource,
 		NewFolderPermissionOverrideResource,
+		NewFolderAccessPolicyResource,
+		NewFolderPermissionsResource,
+		NewFolderAccessTemplateResource,
+		NewUserResource,
+		NewGroupRoleAssignmentsResource,
+		NewUserAttributeUserValueResource,
+		NewContentCopyResource,
+		NewProjectGitDeployKeyResource,
+		NewProjectDeploySecretResource,
+		NewExternalOauthApplicationResource,
+		NewConnectionOauthApplicationResource,
+		NewOIDCConfigResource,
+		NewDashboardResource,
+		NewUserLoginLockoutResetResource,
+		NewContentMetadataResource,
 	}
 
 }
+
+func (p *lookerProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewProjectDeployAction,
+		NewDatagroupResetAction,
+		NewContentValidateAction,
+		NewScheduledPlanRunAction,
+	}
+}
+
+func (p *lookerProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewQueryIDFunction,
+	}
+}
@@ -0,0 +1,527 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Environment variables read when the matching provider attribute is not set.
+const (
+	envBaseURL      = "LOOKER_BASE_URL"
+	envClientID     = "LOOKER_CLIENT_ID"
+	envClientSecret = "LOOKER_CLIENT_SECRET"
+
+	envClientSecretCommand = "LOOKER_CLIENT_SECRET_COMMAND"
+	envAgentTag            = "LOOKER_AGENT_TAG"
+	envTimeout             = "LOOKER_TIMEOUT"
+	envWebBaseURL          = "LOOKER_WEB_BASE_URL"
+	envMaxRetries          = "LOOKER_MAX_RETRIES"
+	envRetryWaitMax        = "LOOKER_RETRY_WAIT_MAX"
+	envOperationTimeout    = "LOOKER_OPERATION_TIMEOUT"
+	envMaxConcurrent       = "LOOKER_MAX_CONCURRENT_REQUESTS"
+	envMaxPerSecond        = "LOOKER_MAX_REQUESTS_PER_SECOND"
+	envVerifySSL           = "LOOKER_VERIFY_SSL"
+	envCACertPEM           = "LOOKER_CA_CERT_PEM"
+	envAccessToken         = "LOOKER_ACCESS_TOKEN"
+	envConfigFile          = "LOOKER_CONFIG_FILE"
+	envConfigSection       = "LOOKER_CONFIG_SECTION"
+	envSudoUserID          = "LOOKER_SUDO_USER_ID"
+	envSkipValidation      = "LOOKER_SKIP_CREDENTIALS_VALIDATION"
+	envPrefetchUsers       = "LOOKER_PREFETCH_USERS"
+	envLogHTTPBodies       = "LOOKER_LOG_HTTP_BODIES"
+)
+
+// defaultConfigSection is the looker.ini section read when none is configured,
+// as in the Looker SDKs.
+const defaultConfigSection = "Looker"
+
+// legacyAPIPort is the port the API is served on by older Looker-hosted
+// instances, while the web UI uses the default port.
+const legacyAPIPort = "19999"
+
+// defaultTimeout is the per-request timeout in seconds used by the Looker SDK
+// when none is configured.
+const defaultTimeout = 120
+
+// resolvedConfig holds the provider settings after merging configuration and
+// environment.
+type resolvedConfig struct {
+	BaseURL      string
+	ClientID     string
+	ClientSecret string
+	AccessToken  string
+	SudoUserID   string
+	AgentTag     string
+	Timeout      int32
+	WebBaseURL   string
+	Retry        retryPolicy
+
+	// VerifySSL enables verification of the server certificate, against the
+	// system roots plus CACertPEM when set.
+	VerifySSL bool
+	CACertPEM string
+
+	// Headers are added to every request.
+	Headers map[string]string
+
+	// MaxConcurrent and MaxPerSecond limit the API calls, zero means unlimited.
+	MaxConcurrent int
+	MaxPerSecond  int
+
+	// OperationTimeout bounds every resource operation without a `timeouts`
+	// entry of its own. Zero means no deadline.
+	OperationTimeout time.Duration
+
+	// SkipCredentialsValidation disables the /me call made when the provider
+	// is configured.
+	SkipCredentialsValidation bool
+
+	// PrefetchUsers loads all users on the first email lookup.
+	PrefetchUsers bool
+
+	// LogHTTPBodies adds the redacted bodies to the request logs.
+	LogHTTPBodies bool
+}
+
+// configValue resolves a single provider attribute. A value set in the
+// configuration always wins; the environment variable is only consulted when
+// the attribute is null, so every attribute is resolved independently of the
+// others.
+func configValue(value types.String, envVar string) string {
+	if !value.IsNull() {
+		return strings.TrimSpace(value.ValueString())
+	}
+	return strings.TrimSpace(os.Getenv(envVar))
+}
+
+// configInt resolves a numeric provider attribute like configValue, falling
+// back to def when neither the attribute nor envVar is set. Environment values
+// below minimum are reported on the attribute.
+func configInt(value types.Int64, envVar, name string, def, minimum int64, diags *diag.Diagnostics) int64 {
+	if !value.IsNull() {
+		return value.ValueInt64()
+	}
+	raw := strings.TrimSpace(os.Getenv(envVar))
+	if raw == "" {
+		return def
+	}
+	n, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil || n < minimum {
+		diags.AddAttributeError(path.Root(name), "Invalid Looker API "+name,
+			fmt.Sprintf("%s must be a whole number of at least %d, got %q.", envVar, minimum, raw))
+		return def
+	}
+	return n
+}
+
+// httpHeaderName matches the token characters allowed in HTTP header names.
+var httpHeaderName = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
+// reservedHeaders are the headers the provider manages itself.
+var reservedHeaders = map[string]bool{
+	"Authorization":  true,
+	"User-Agent":     true,
+	"X-Looker-Appid": true,
+}
+
+// configBool resolves a boolean provider attribute like configValue, falling
+// back to def when neither the attribute nor envVar is set.
+func configBool(value types.Bool, envVar, name string, def bool, diags *diag.Diagnostics) bool {
+	if !value.IsNull() {
+		return value.ValueBool()
+	}
+	raw := strings.TrimSpace(os.Getenv(envVar))
+	if raw == "" {
+		return def
+	}
+	b, err := strconv.ParseBool(raw)
+	if err != nil {
+		diags.AddAttributeError(path.Root(name), "Invalid Looker API "+name,
+			fmt.Sprintf("%s must be true or false, got %q.", envVar, raw))
+		return def
+	}
+	return b
+}
+
+// unknownConfig returns the provider attributes whose value is not known yet,
+// typically because it depends on another resource that has not been applied.
+func unknownConfig(cfg providerModel) []string {
+	var unknown []string
+	attributes := []struct {
+		name  string
+		value types.String
+	}{
+		{"base_url", cfg.BaseURL},
+		{"client_id", cfg.ClientID},
+		{"client_secret", cfg.ClientSecret},
+		{"client_secret_command", cfg.ClientSecretCommand},
+		{"access_token", cfg.AccessToken},
+		{"agent_tag", cfg.AgentTag},
+		{"web_base_url", cfg.WebBaseURL},
+		{"operation_timeout", cfg.OperationTimeout},
+		{"ca_cert_pem", cfg.CACertPEM},
+		{"config_file", cfg.ConfigFile},
+		{"config_section", cfg.ConfigSection},
+		{"sudo_user_id", cfg.SudoUserID},
+	}
+	for _, attribute := range attributes {
+		if attribute.value.IsUnknown() {
+			unknown = append(unknown, attribute.name)
+		}
+	}
+	numbers := []struct {
+		name  string
+		value types.Int64
+	}{
+		{"timeout", cfg.Timeout},
+		{"max_retries", cfg.MaxRetries},
+		{"retry_wait_max", cfg.RetryWaitMax},
+		{"max_concurrent_requests", cfg.MaxConcurrent},
+		{"max_requests_per_second", cfg.MaxPerSecond},
+	}
+	for _, number := range numbers {
+		if number.value.IsUnknown() {
+			unknown = append(unknown, number.name)
+		}
+	}
+	headersUnknown := cfg.Headers.IsUnknown()
+	for _, value := range cfg.Headers.Elements() {
+		headersUnknown = headersUnknown || value.IsUnknown()
+	}
+	if headersUnknown {
+		unknown = append(unknown, "headers")
+	}
+	if cfg.SSLVerify.IsUnknown() {
+		unknown = append(unknown, "ssl_verify")
+	}
+	if cfg.SkipCredentialsValidation.IsUnknown() {
+		unknown = append(unknown, "skip_credentials_validation")
+	}
+	if cfg.PrefetchUsers.IsUnknown() {
+		unknown = append(unknown, "prefetch_users")
+	}
+	if cfg.LogHTTPBodies.IsUnknown() {
+		unknown = append(unknown, "log_http_bodies")
+	}
+	return unknown
+}
+
+// resolveConfig merges the provider configuration with the environment and
+// validates the result. All problems are reported at once, each scoped to the
+// attribute it concerns.
+func resolveConfig(ctx context.Context, cfg providerModel) (resolvedConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for _, name := range unknownConfig(cfg) {
+		hint := "Set it statically or use the environment."
+		if name == "headers" {
+			hint = "Set it statically."
+		}
+		diags.AddAttributeError(path.Root(name), "Unknown Looker API "+name,
+			fmt.Sprintf("The provider cannot create the Looker API client because %s depends on a value that is not known until apply. %s", name, hint))
+	}
+	if diags.HasError() {
+		return resolvedConfig{}, diags
+	}
+
+	// Settings of a looker.ini file apply last, below the environment.
+	ini := map[string]string{}
+	if file := configValue(cfg.ConfigFile, envConfigFile); file != "" {
+		section := configValue(cfg.ConfigSection, envConfigSection)
+		if section == "" {
+			section = defaultConfigSection
+		}
+		var err error
+		if ini, err = readLookerIni(file, section); err != nil {
+			diags.AddAttributeError(path.Root("config_file"), "Invalid Looker configuration file", err.Error())
+			return resolvedConfig{}, diags
+		}
+	}
+	orIni := func(value, key string) string {
+		if value == "" {
+			return ini[key]
+		}
+		return value
+	}
+	verifySSL := true
+	if raw, ok := ini["verify_ssl"]; ok {
+		if b, err := strconv.ParseBool(raw); err != nil {
+			diags.AddAttributeError(path.Root("config_file"), "Invalid Looker configuration file",
+				fmt.Sprintf("verify_ssl must be true or false, got %q.", raw))
+		} else {
+			verifySSL = b
+		}
+	}
+
+	resolved := resolvedConfig{
+		BaseURL:      orIni(configValue(cfg.BaseURL, envBaseURL), "base_url"),
+		ClientID:     orIni(configValue(cfg.ClientID, envClientID), "client_id"),
+		ClientSecret: orIni(configValue(cfg.ClientSecret, envClientSecret), "client_secret"),
+		AccessToken:  configValue(cfg.AccessToken, envAccessToken),
+		SudoUserID:   configValue(cfg.SudoUserID, envSudoUserID),
+		AgentTag:     configValue(cfg.AgentTag, envAgentTag),
+		WebBaseURL:   configValue(cfg.WebBaseURL, envWebBaseURL),
+		Timeout:      int32(configInt(cfg.Timeout, envTimeout, "timeout", defaultTimeout, 1, &diags)),
+		Retry: retryPolicy{
+			maxRetries: int(configInt(cfg.MaxRetries, envMaxRetries, "max_retries", defaultMaxRetries, 0, &diags)),
+			waitMax:    time.Duration(configInt(cfg.RetryWaitMax, envRetryWaitMax, "retry_wait_max", int64(defaultRetryWaitMax/time.Second), 1, &diags)) * time.Second,
+		},
+		VerifySSL:     configBool(cfg.SSLVerify, envVerifySSL, "ssl_verify", verifySSL, &diags),
+		CACertPEM:     configValue(cfg.CACertPEM, envCACertPEM),
+		MaxConcurrent: int(configInt(cfg.MaxConcurrent, envMaxConcurrent, "max_concurrent_requests", 0, 1, &diags)),
+		MaxPerSecond:  int(configInt(cfg.MaxPerSecond, envMaxPerSecond, "max_requests_per_second", 0, 1, &diags)),
+
+		SkipCredentialsValidation: configBool(cfg.SkipCredentialsValidation, envSkipValidation, "skip_credentials_validation", false, &diags),
+		PrefetchUsers:             configBool(cfg.PrefetchUsers, envPrefetchUsers, "prefetch_users", false, &diags),
+		LogHTTPBodies:             configBool(cfg.LogHTTPBodies, envLogHTTPBodies, "log_http_bodies", false, &diags),
+	}
+
+	if raw := configValue(cfg.OperationTimeout, envOperationTimeout); raw != "" {
+		timeout, err := time.ParseDuration(raw)
+		if err != nil || !durationPattern.MatchString(raw) || timeout <= 0 {
+			diags.AddAttributeError(path.Root("operation_timeout"), "Invalid Looker operation timeout",
+				fmt.Sprintf("The operation timeout must be a positive duration such as 30s, 10m or 1h30m, got %q.", raw))
+		} else {
+			resolved.OperationTimeout = timeout
+		}
+	}
+
+	if resolved.CACertPEM != "" && !x509.NewCertPool().AppendCertsFromPEM([]byte(resolved.CACertPEM)) {
+		diags.AddAttributeError(path.Root("ca_cert_pem"), "Invalid Looker CA certificate",
+			"ca_cert_pem must contain at least one PEM encoded certificate (-----BEGIN CERTIFICATE-----).")
+	} else if resolved.CACertPEM != "" && !resolved.VerifySSL {
+		diags.AddAttributeWarning(path.Root("ca_cert_pem"), "Looker CA certificate is ignored",
+			"ca_cert_pem has no effect while ssl_verify is false.")
+	}
+
+	if !cfg.Headers.IsNull() {
+		diags.Append(cfg.Headers.ElementsAs(ctx, &resolved.Headers, false)...)
+	}
+	for name := range resolved.Headers {
+		if !httpHeaderName.MatchString(name) {
+			diags.AddAttributeError(path.Root("headers"), "Invalid HTTP header",
+				fmt.Sprintf("%q is not a valid HTTP header name.", name))
+		} else if reservedHeaders[http.CanonicalHeaderKey(name)] {
+			diags.AddAttributeError(path.Root("headers"), "Reserved HTTP header",
+				fmt.Sprintf("The %s header is set by the provider and cannot be overridden; use agent_tag to extend the User-Agent.", http.CanonicalHeaderKey(name)))
+		}
+	}
+
+	// An access token replaces the API credentials. Credentials set in the
+	// configuration alongside it are a mistake, while credentials left in the
+	// environment are simply not used.
+	if resolved.AccessToken != "" {
+		for name, value := range map[string]types.String{
+			"client_id":             cfg.ClientID,
+			"client_secret":         cfg.ClientSecret,
+			"client_secret_command": cfg.ClientSecretCommand,
+		} {
+			if !value.IsNull() {
+				diags.AddAttributeError(path.Root(name), "Conflicting Looker authentication",
+					fmt.Sprintf("Set either access_token or client_id and client_secret, not both. %s is set together with an access token.", name))
+			}
+		}
+		resolved.ClientID = ""
+		resolved.ClientSecret = ""
+	}
+
+	// A command set in the configuration takes precedence over the
+	// LOOKER_CLIENT_SECRET environment variable, like any configured value.
+	// The LOOKER_CLIENT_SECRET_COMMAND variable is the last resort.
+	secretReported := resolved.AccessToken != ""
+	command := strings.TrimSpace(cfg.ClientSecretCommand.ValueString())
+	if resolved.AccessToken != "" {
+		command = ""
+	} else if !cfg.ClientSecret.IsNull() && command != "" {
+		diags.AddAttributeError(path.Root("client_secret_command"), "Conflicting client secret configuration",
+			"Set only one of client_secret or client_secret_command.")
+		command = ""
+		secretReported = true
+	} else if command == "" && resolved.ClientSecret == "" {
+		command = strings.TrimSpace(os.Getenv(envClientSecretCommand))
+	}
+	if command != "" {
+		secret, err := runSecretCommand(ctx, command)
+		if err != nil {
+			diags.AddAttributeError(path.Root("client_secret_command"), "Client secret command failed", err.Error())
+			secretReported = true
+		}
+		resolved.ClientSecret = secret
+	}
+
+	if resolved.BaseURL == "" {
+		diags.AddAttributeError(path.Root("base_url"), "Missing Looker base URL",
+			fmt.Sprintf("Set base_url in the provider configuration or the %s environment variable.", envBaseURL))
+	} else if baseURL, err := normalizeBaseURL(resolved.BaseURL); err != nil {
+		diags.AddAttributeError(path.Root("base_url"), "Invalid Looker base URL",
+			fmt.Sprintf("%q is not a valid Looker base URL: %v", resolved.BaseURL, err))
+	} else {
+		resolved.BaseURL = baseURL
+		if resolved.WebBaseURL == "" {
+			resolved.WebBaseURL = webBaseURL(baseURL)
+		}
+	}
+	if resolved.WebBaseURL != "" && resolved.WebBaseURL != resolved.BaseURL {
+		if webURL, err := normalizeBaseURL(resolved.WebBaseURL); err != nil {
+			diags.AddAttributeError(path.Root("web_base_url"), "Invalid Looker web base URL",
+				fmt.Sprintf("%q is not a valid Looker web base URL: %v", resolved.WebBaseURL, err))
+		} else {
+			resolved.WebBaseURL = webURL
+		}
+	}
+	if resolved.ClientID == "" && resolved.AccessToken == "" {
+		diags.AddAttributeError(path.Root("client_id"), "Missing Looker client ID",
+			fmt.Sprintf("Set client_id in the provider configuration or the %s environment variable, or authenticate with access_token.", envClientID))
+	}
+	if resolved.ClientSecret == "" && !secretReported {
+		diags.AddAttributeError(path.Root("client_secret"), "Missing Looker client secret",
+			fmt.Sprintf("Set client_secret or client_secret_command in the provider configuration, or the %s or %s environment variable.", envClientSecret, envClientSecretCommand))
+	}
+
+	return resolved, diags
+}
+
+// readLookerIni returns the settings of section in the looker.ini file at
+// name, with lower-case keys. The format is the one of the Looker SDKs:
+//
+//	[Looker]
+//	base_url=https://myinstance.looker.com:19999
+//	client_id=...
+//	client_secret=...
+//	verify_ssl=True
+func readLookerIni(name, section string) (map[string]string, error) {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := map[string]string{}
+	found := false
+	current := ""
+	for i, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			current = strings.TrimSpace(line[1 : len(line)-1])
+			found = found || current == section
+			continue
+		}
+		if current != section {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			key, value, ok = strings.Cut(line, ":")
+		}
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected key=value, got %q", name, i+1, line)
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		settings[strings.ToLower(strings.TrimSpace(key))] = value
+	}
+	if !found {
+		return nil, fmt.Errorf("%s has no [%s] section", name, section)
+	}
+	return settings, nil
+}
+
+// runSecretCommand runs command through the system shell and returns its
+// standard output without surrounding whitespace. Standard error is only used
+// to explain failures.
+func runSecretCommand(ctx context.Context, command string) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("running %q: %v: %s", command, err, strings.TrimSpace(stderr.String()))
+	}
+	secret := strings.TrimSpace(stdout.String())
+	if secret == "" {
+		return "", fmt.Errorf("%q printed nothing on standard output", command)
+	}
+	return secret, nil
+}
+
+// normalizeBaseURL checks that raw is an absolute http(s) URL pointing at the
+// host root, and returns it without a trailing slash.
+func normalizeBaseURL(raw string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return "", fmt.Errorf("scheme must be https or http, e.g. https://myinstance.looker.com")
+	}
+	if u.Hostname() == "" {
+		return "", fmt.Errorf("host is missing")
+	}
+	if port := u.Port(); port != "" {
+		n, err := strconv.Atoi(port)
+		if err != nil || n < 1 || n > 65535 {
+			return "", fmt.Errorf("port %q is not between 1 and 65535", port)
+		}
+	} else if strings.HasSuffix(u.Host, ":") {
+		return "", fmt.Errorf("port is empty")
+	}
+	if u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("must not contain credentials, a query or a fragment")
+	}
+	trimmed := strings.TrimRight(u.Path, "/")
+	if trimmed == "/api" || strings.HasPrefix(trimmed, "/api/") {
+		return "", fmt.Errorf("must not include the /api path, the provider appends /api/4.0 itself")
+	}
+	u.Path = trimmed
+	return u.String(), nil
+}
+
+// webBaseURL derives the root of the web UI from the normalized API base URL
+// by dropping the legacy API port.
+func webBaseURL(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Port() != legacyAPIPort {
+		return baseURL
+	}
+	host := u.Hostname()
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	u.Host = host
+	return u.String()
+}
+
+// agentTag returns the User-Agent sent with every API call. It always names
+// the provider and its version so that Looker admins can tell Terraform
+// traffic apart in the API usage logs; extra is appended when set.
+func agentTag(version, extra string) string {
+	tag := "terraform-provider-looker/" + version
+	if extra != "" {
+		tag += " " + extra
+	}
+	return tag
+}
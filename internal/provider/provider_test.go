@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"terraform-provider-looker/internal/lookertest"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccProtoV6ProviderFactories runs the provider in the test process, as
+// Terraform would run the plugin binary.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"looker": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccServer starts a lookertest.Server for one acceptance test and points
+// the provider at it through the environment. Tests using it must not run in
+// parallel, since the environment is shared.
+func testAccServer(t *testing.T) *lookertest.Server {
+	t.Helper()
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env %q is set", resource.EnvTfAcc)
+	}
+
+	srv := lookertest.NewServer()
+	t.Cleanup(srv.Close)
+	t.Setenv(envBaseURL, srv.URL)
+	t.Setenv(envClientID, lookertest.ClientID)
+	t.Setenv(envClientSecret, lookertest.ClientSecret)
+	// Settings pointing elsewhere would take precedence or fail the login.
+	for _, env := range []string{envClientSecretCommand, envAccessToken, envConfigFile, envSudoUserID} {
+		t.Setenv(env, "")
+	}
+	return srv
+}
+
+// testAccSeededServer is testAccServer with the fixtures of
+// lookertest.Server.Seed stored, for tests reading existing objects.
+func testAccSeededServer(t *testing.T) (*lookertest.Server, lookertest.Fixtures) {
+	t.Helper()
+	srv := testAccServer(t)
+	return srv, srv.Seed()
+}
+
+// testAccCheckDestroyed checks that no resource of resourceType in the state
+// is left in collection of srv.
+func testAccCheckDestroyed(srv *lookertest.Server, resourceType, collection string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			if _, ok := srv.Get(collection, rs.Primary.ID); ok {
+				return fmt.Errorf("%s %s still exists", resourceType, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+// testAccCheckStored checks that the object of resourceName in collection of
+// srv has value for key.
+func testAccCheckStored(srv *lookertest.Server, resourceName, collection, key string, value any) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%s not found in state", resourceName)
+		}
+		object, ok := srv.Get(collection, rs.Primary.ID)
+		if !ok {
+			return fmt.Errorf("%s %s not found on the server", resourceName, rs.Primary.ID)
+		}
+		if fmt.Sprint(object[key]) != fmt.Sprint(value) {
+			return fmt.Errorf("%s %s has %s %v, want %v", resourceName, rs.Primary.ID, key, object[key], value)
+		}
+		return nil
+	}
+}
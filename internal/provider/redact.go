@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+)
+
+// transcriptBodyLimit is the number of bytes of a body kept in the transcript.
+const transcriptBodyLimit = 64 << 10
+
+// redacted replaces sensitive values in the transcript.
+const redacted = "[REDACTED]"
+
+// sensitiveKey matches the JSON and form keys whose values are never logged,
+// e.g. client_secret, password, access_token and embed_secret.
+var sensitiveKey = regexp.MustCompile(`(?i)(secret|password|token|private_key)`)
+
+// redactBody returns body as it may be logged: JSON and form bodies with the
+// values of sensitive keys masked, and only a summary of anything else, since
+// it cannot be redacted reliably.
+func redactBody(body []byte, contentType string) string {
+	if len(body) == 0 {
+		return ""
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			break
+		}
+		for key := range values {
+			if sensitiveKey.MatchString(key) {
+				values[key] = []string{redacted}
+			}
+		}
+		return values.Encode()
+	case "application/json", "":
+		var value interface{}
+		if err := json.Unmarshal(body, &value); err != nil {
+			break
+		}
+		masked, err := json.Marshal(redactJSON(value))
+		if err != nil {
+			break
+		}
+		if len(masked) > transcriptBodyLimit {
+			return fmt.Sprintf("%s... (%d bytes truncated)", masked[:transcriptBodyLimit], len(masked)-transcriptBodyLimit)
+		}
+		return string(masked)
+	}
+	return fmt.Sprintf("(%d bytes of %s not logged)", len(body), contentType)
+}
+
+// redactJSON masks the values of sensitive keys in a decoded JSON value.
+func redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if sensitiveKey.MatchString(key) && item != nil {
+				v[key] = redacted
+			} else {
+				v[key] = redactJSON(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactJSON(item)
+		}
+	}
+	return value
+}
+
+// readRequestBody returns the body of req without consuming it.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, err
+}
+
+// readResponseBody returns the body of resp, replacing it with a copy so that
+// the caller can still read it. On error the body is left to the caller to
+// close.
+func readResponseBody(resp *http.Response) ([]byte, error) {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
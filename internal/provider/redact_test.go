@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := map[string]struct {
+		body        string
+		contentType string
+		want        string
+	}{
+		"empty": {
+			body: "", contentType: "application/json", want: "",
+		},
+		"login form": {
+			body:        "client_id=abc&client_secret=s3cret",
+			contentType: "application/x-www-form-urlencoded",
+			want:        "client_id=abc&client_secret=%5BREDACTED%5D",
+		},
+		"token response": {
+			// Keys are matched loosely, so token_type is masked too.
+			body:        `{"access_token":"tok","token_type":"Bearer","expires_in":3600}`,
+			contentType: "application/json; charset=utf-8",
+			want:        `{"access_token":"[REDACTED]","expires_in":3600,"token_type":"[REDACTED]"}`,
+		},
+		"nested secrets": {
+			body:        `[{"name":"app","embed_secret":{"value":"x"},"credentials":{"Password":"p","email":"a@b.c"}}]`,
+			contentType: "application/json",
+			want:        `[{"credentials":{"Password":"[REDACTED]","email":"a@b.c"},"embed_secret":"[REDACTED]","name":"app"}]`,
+		},
+		"null secret kept": {
+			body:        `{"client_secret":null}`,
+			contentType: "application/json",
+			want:        `{"client_secret":null}`,
+		},
+		"no content type": {
+			body:        `{"private_key":"k"}`,
+			contentType: "",
+			want:        `{"private_key":"[REDACTED]"}`,
+		},
+		"invalid json": {
+			body:        `{"password":`,
+			contentType: "application/json",
+			want:        "(12 bytes of application/json not logged)",
+		},
+		"binary": {
+			body:        "PNG....",
+			contentType: "image/png",
+			want:        "(7 bytes of image/png not logged)",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := redactBody([]byte(test.body), test.contentType); got != test.want {
+				t.Errorf("redactBody(%q) = %q, want %q", test.body, got, test.want)
+			}
+		})
+	}
+
+	t.Run("truncated", func(t *testing.T) {
+		body := `{"description":"` + strings.Repeat("a", transcriptBodyLimit) + `"}`
+		got := redactBody([]byte(body), "application/json")
+		if !strings.HasSuffix(got, "... (18 bytes truncated)") {
+			t.Errorf("redactBody of a large body ends with %q", got[len(got)-40:])
+		}
+	})
+}
+
+func TestReadBodies(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodPost, "https://example.looker.com/api/4.0/groups", strings.NewReader(`{"name":"g"}`))
+	body, err := readRequestBody(req)
+	if err != nil || string(body) != `{"name":"g"}` {
+		t.Fatalf("readRequestBody = %q, %v", body, err)
+	}
+	if sent, _ := io.ReadAll(req.Body); string(sent) != `{"name":"g"}` {
+		t.Errorf("request body consumed, %q left", sent)
+	}
+
+	resp := &http.Response{Body: io.NopCloser(bytes.NewReader([]byte(`[]`)))}
+	body, err = readResponseBody(resp)
+	if err != nil || string(body) != `[]` {
+		t.Fatalf("readResponseBody = %q, %v", body, err)
+	}
+	if rest, _ := io.ReadAll(resp.Body); string(rest) != `[]` {
+		t.Errorf("response body consumed, %q left", rest)
+	}
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var (
+	_ resource.Resource                 = &connectionOauthApplicationResource{}
+	_ resource.ResourceWithConfigure    = &connectionOauthApplicationResource{}
+	_ resource.ResourceWithImportState  = &connectionOauthApplicationResource{}
+	_ resource.ResourceWithUpgradeState = &connectionOauthApplicationResource{}
+)
+
+// connectionOauthApplicationResource is the resource implementation.
+type connectionOauthApplicationResource struct {
+	baseResource
+}
+
+// connectionOauthApplicationModel maps the resource schema data.
+type connectionOauthApplicationModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	ConnectionName     types.String   `tfsdk:"connection_name"`
+	OauthApplicationID types.String   `tfsdk:"oauth_application_id"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewConnectionOauthApplicationResource is a helper function to simplify the provider implementation.
+func NewConnectionOauthApplicationResource() resource.Resource {
+	return &connectionOauthApplicationResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *connectionOauthApplicationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connection_oauth_application"
+}
+
+// Schema defines the schema for the resource.
+func (r *connectionOauthApplicationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Links an OAuth application to a database connection, so that users authenticate to the database with their own OAuth credentials. Destroying the resource unlinks the application.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "The name of the connection.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"connection_name": schema.StringAttribute{
+				Description: "The name of the database connection.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"oauth_application_id": schema.StringAttribute{
+				Description: "The ID of the OAuth application, e.g. from `looker_external_oauth_application`.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// setConnectionOauthApplication is a helper function for Create, Update and Delete.
+func (r *connectionOauthApplicationResource) setConnectionOauthApplication(ctx context.Context, connectionName, applicationID string) error {
+	_, err := r.client.SDK(ctx).UpdateConnection(connectionName, v4.WriteDBConnection{OauthApplicationId: &applicationID}, nil)
+	return err
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *connectionOauthApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan connectionOauthApplicationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setConnectionOauthApplication(ctx, plan.ConnectionName.ValueString(), plan.OauthApplicationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to link OAuth application to connection %s: %v", plan.ConnectionName.ValueString(), err))
+		return
+	}
+
+	plan.ID = plan.ConnectionName
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *connectionOauthApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state connectionOauthApplicationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connectionName := state.ConnectionName.ValueString()
+
+	connection, err := r.client.SDK(ctx).Connection(connectionName, "name,oauth_application_id", nil)
+	if isNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Connection %s not found, removing from state", connectionName))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read connection %s: %v", connectionName, err))
+		return
+	}
+	if connection.OauthApplicationId == nil || *connection.OauthApplicationId == "" {
+		tflog.Warn(ctx, fmt.Sprintf("Connection %s has no OAuth application, removing from state", connectionName))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = state.ConnectionName
+	state.OauthApplicationID = types.StringValue(*connection.OauthApplicationId)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *connectionOauthApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
+	var plan connectionOauthApplicationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setConnectionOauthApplication(ctx, plan.ConnectionName.ValueString(), plan.OauthApplicationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to link OAuth application to connection %s: %v", plan.ConnectionName.ValueString(), err))
+		return
+	}
+
+	plan.ID = plan.ConnectionName
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete unlinks the OAuth application from the connection.
+func (r *connectionOauthApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
+	var state connectionOauthApplicationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setConnectionOauthApplication(ctx, state.ConnectionName.ValueString(), "")
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to unlink OAuth application from connection %s: %v", state.ConnectionName.ValueString(), err))
+		return
+	}
+}
+
+// ImportState imports the link using the connection name.
+func (r *connectionOauthApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("connection_name"), req, resp)
+}
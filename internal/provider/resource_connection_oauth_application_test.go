@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestConnectionOauthApplicationResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &connectionOauthApplicationResource{baseResource{client: client}})
+
+	api.EXPECT().UpdateConnection("warehouse", v4.WriteDBConnection{OauthApplicationId: ptr("3")}, nil).Return(v4.DBConnection{}, nil)
+	state, diags := tr.create(map[string]any{"connection_name": "warehouse", "oauth_application_id": "3"})
+	requireNoErrors(t, "Create", diags)
+	var id string
+	tr.get(state, "id", &id)
+	if id != "warehouse" {
+		t.Errorf("id = %q, want %q", id, "warehouse")
+	}
+
+	api.EXPECT().Connection("warehouse", "name,oauth_application_id", nil).
+		Return(v4.DBConnection{Name: ptr("warehouse"), OauthApplicationId: ptr("4")}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var applicationID string
+	tr.get(state, "oauth_application_id", &applicationID)
+	if applicationID != "4" {
+		t.Errorf("oauth_application_id = %q, want %q", applicationID, "4")
+	}
+
+	api.EXPECT().UpdateConnection("warehouse", v4.WriteDBConnection{OauthApplicationId: ptr("3")}, nil).Return(v4.DBConnection{}, nil)
+	state, diags = tr.update(state, map[string]any{"id": "warehouse", "connection_name": "warehouse", "oauth_application_id": "3"})
+	requireNoErrors(t, "Update", diags)
+
+	api.EXPECT().UpdateConnection("warehouse", v4.WriteDBConnection{OauthApplicationId: ptr("")}, nil).Return(v4.DBConnection{}, errNotFound)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestConnectionOauthApplicationResourceReadNotFound(t *testing.T) {
+	tests := map[string]struct {
+		connection v4.DBConnection
+		err        error
+	}{
+		"connection deleted":  {err: errNotFound},
+		"application removed": {connection: v4.DBConnection{Name: ptr("warehouse")}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, api := newMockClient(t)
+			tr := newTestResource(t, &connectionOauthApplicationResource{baseResource{client: client}})
+
+			api.EXPECT().Connection("warehouse", "name,oauth_application_id", nil).Return(test.connection, test.err)
+			state, diags := tr.read(tr.state(map[string]any{"id": "warehouse", "connection_name": "warehouse", "oauth_application_id": "3"}))
+			requireNoErrors(t, "Read", diags)
+			if !state.Raw.IsNull() {
+				t.Errorf("state = %v, want removed", state.Raw)
+			}
+		})
+	}
+}
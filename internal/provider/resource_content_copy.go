@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var (
+	_ resource.Resource                 = &contentCopyResource{}
+	_ resource.ResourceWithConfigure    = &contentCopyResource{}
+	_ resource.ResourceWithUpgradeState = &contentCopyResource{}
+)
+
+// contentCopyResource is the resource implementation.
+type contentCopyResource struct {
+	baseResource
+}
+
+// contentCopyResourceModel maps the resource schema data.
+type contentCopyResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Content     types.String   `tfsdk:"content"`
+	FolderID    types.String   `tfsdk:"folder_id"`
+	ContentType types.String   `tfsdk:"content_type"`
+	WebURL      types.String   `tfsdk:"web_url"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewContentCopyResource is a helper function to simplify the provider implementation.
+func NewContentCopyResource() resource.Resource {
+	return &contentCopyResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *contentCopyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_copy"
+}
+
+// Schema defines the schema for the resource.
+func (r *contentCopyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a copy of a dashboard or look exported with `looker_content_export`, typically from another Looker instance configured as a separate provider alias. Any change to the exported content replaces the copy.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "The ID of the created dashboard or look.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "The `content` of a `looker_content_export` data source.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"folder_id": schema.StringAttribute{
+				Description: "The ID of the folder the copy is created in.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				Description: "The type of the copy, `dashboard` or `look`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"web_url": schema.StringAttribute{
+				Description: "Link to the copy in the Looker web UI.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *contentCopyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan contentCopyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var export contentExport
+	if err := json.Unmarshal([]byte(plan.Content.ValueString()), &export); err != nil {
+		resp.Diagnostics.AddError("Invalid content", fmt.Sprintf("content is not a looker_content_export document: %v", err))
+		return
+	}
+	folderID := plan.FolderID.ValueString()
+
+	switch export.Type {
+	case contentTypeDashboard:
+		dashboard, err := r.client.SDK(ctx).ImportDashboardFromLookml(v4.WriteDashboardLookml{
+			FolderId: &folderID,
+			Lookml:   &export.LookML,
+		}, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to import dashboard %q into folder %s: %v", export.Title, folderID, err))
+			return
+		}
+		plan.ID = types.StringPointerValue(dashboard.Id)
+		plan.WebURL = r.client.webURL("dashboards", dashboard.Id)
+	case contentTypeLook:
+		if export.Query == nil {
+			resp.Diagnostics.AddError("Invalid content", "The exported look has no query")
+			return
+		}
+		look, err := r.client.SDK(ctx).CreateLook(v4.WriteLookWithQuery{
+			Title:       &export.Title,
+			Description: &export.Description,
+			FolderId:    &folderID,
+			Query:       export.Query,
+		}, "id", nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to create look %q in folder %s: %v", export.Title, folderID, err))
+			return
+		}
+		plan.ID = types.StringPointerValue(look.Id)
+		plan.WebURL = r.client.webURL("looks", look.Id)
+	default:
+		resp.Diagnostics.AddError("Invalid content", fmt.Sprintf("Unsupported content type %q", export.Type))
+		return
+	}
+
+	plan.ContentType = types.StringValue(export.Type)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *contentCopyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state contentCopyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.ID.ValueString()
+
+	var err error
+	var deleted *bool
+	if state.ContentType.ValueString() == contentTypeLook {
+		var look v4.LookWithQuery
+		look, err = r.client.SDK(ctx).Look(id, "id,deleted", nil)
+		deleted = look.Deleted
+	} else {
+		var dashboard v4.Dashboard
+		dashboard, err = r.client.SDK(ctx).Dashboard(id, "id,deleted", nil)
+		deleted = dashboard.Deleted
+	}
+	if isNotFound(err) || (err == nil && deleted != nil && *deleted) {
+		tflog.Warn(ctx, fmt.Sprintf("Copied %s %s not found, removing from state", state.ContentType.ValueString(), id))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read %s %s: %v", state.ContentType.ValueString(), id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called: every attribute requires replacement.
+func (r *contentCopyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan contentCopyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the copied dashboard or look.
+func (r *contentCopyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
+	var state contentCopyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.ID.ValueString()
+
+	var err error
+	if state.ContentType.ValueString() == contentTypeLook {
+		_, err = r.client.SDK(ctx).DeleteLook(id, nil)
+	} else {
+		_, err = r.client.SDK(ctx).DeleteDashboard(id, nil)
+	}
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to delete %s %s: %v", state.ContentType.ValueString(), id, err))
+		return
+	}
+}
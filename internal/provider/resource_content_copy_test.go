@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-looker/internal/lookerapi/lookerapimock"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestContentCopyResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	client.webBaseURL = "https://example.looker.com"
+	tr := newTestResource(t, &contentCopyResource{baseResource{client: client}})
+	content := `{"type":"dashboard","title":"Sales","lookml":"- dashboard: sales"}`
+
+	api.EXPECT().ImportDashboardFromLookml(v4.WriteDashboardLookml{
+		FolderId: ptr("12"),
+		Lookml:   ptr("- dashboard: sales"),
+	}, nil).Return(v4.Dashboard{Id: ptr("30")}, nil)
+	state, diags := tr.create(map[string]any{"content": content, "folder_id": "12"})
+	requireNoErrors(t, "Create", diags)
+	var contentType, webURL string
+	tr.get(state, "content_type", &contentType)
+	tr.get(state, "web_url", &webURL)
+	if contentType != "dashboard" || webURL != "https://example.looker.com/dashboards/30" {
+		t.Errorf("content_type, web_url = %q, %q, want dashboard, https://example.looker.com/dashboards/30", contentType, webURL)
+	}
+
+	api.EXPECT().Dashboard("30", "id,deleted", nil).Return(v4.Dashboard{Id: ptr("30"), Deleted: ptr(false)}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	if state.Raw.IsNull() {
+		t.Fatal("Read removed the copy")
+	}
+
+	api.EXPECT().DeleteDashboard("30", nil).Return("", nil)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestContentCopyResourceLook(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &contentCopyResource{baseResource{client: client}})
+	content := `{"type":"look","title":"Orders","description":"All orders","query":{"model":"shop","view":"orders"}}`
+
+	api.EXPECT().CreateLook(v4.WriteLookWithQuery{
+		Title:       ptr("Orders"),
+		Description: ptr("All orders"),
+		FolderId:    ptr("12"),
+		Query:       &v4.WriteQuery{Model: "shop", View: "orders"},
+	}, "id", nil).Return(v4.LookWithQuery{Id: ptr("40")}, nil)
+	state, diags := tr.create(map[string]any{"content": content, "folder_id": "12"})
+	requireNoErrors(t, "Create", diags)
+	var id string
+	tr.get(state, "id", &id)
+	if id != "40" {
+		t.Errorf("id = %q, want %q", id, "40")
+	}
+
+	api.EXPECT().Look("40", "id,deleted", nil).Return(v4.LookWithQuery{Id: ptr("40")}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+
+	api.EXPECT().DeleteLook("40", nil).Return("", errNotFound)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestContentCopyResourceReadNotFound(t *testing.T) {
+	tests := map[string]struct {
+		contentType string
+		expect      func(api *lookerapimock.MockClient)
+	}{
+		"dashboard deleted": {
+			contentType: "dashboard",
+			expect: func(api *lookerapimock.MockClient) {
+				api.EXPECT().Dashboard("30", "id,deleted", nil).Return(v4.Dashboard{}, errNotFound)
+			},
+		},
+		"dashboard in trash": {
+			contentType: "dashboard",
+			expect: func(api *lookerapimock.MockClient) {
+				api.EXPECT().Dashboard("30", "id,deleted", nil).Return(v4.Dashboard{Id: ptr("30"), Deleted: ptr(true)}, nil)
+			},
+		},
+		"look deleted": {
+			contentType: "look",
+			expect: func(api *lookerapimock.MockClient) {
+				api.EXPECT().Look("30", "id,deleted", nil).Return(v4.LookWithQuery{}, errNotFound)
+			},
+		},
+		"look in trash": {
+			contentType: "look",
+			expect: func(api *lookerapimock.MockClient) {
+				api.EXPECT().Look("30", "id,deleted", nil).Return(v4.LookWithQuery{Id: ptr("30"), Deleted: ptr(true)}, nil)
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, api := newMockClient(t)
+			tr := newTestResource(t, &contentCopyResource{baseResource{client: client}})
+
+			test.expect(api)
+			state, diags := tr.read(tr.state(map[string]any{"id": "30", "content": "{}", "folder_id": "12", "content_type": test.contentType}))
+			requireNoErrors(t, "Read", diags)
+			if !state.Raw.IsNull() {
+				t.Errorf("state = %v, want removed", state.Raw)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var (
+	_ resource.Resource                = &contentMetadataResource{}
+	_ resource.ResourceWithConfigure   = &contentMetadataResource{}
+	_ resource.ResourceWithImportState = &contentMetadataResource{}
+)
+
+// contentMetadataResource manages the access inheritance of existing content.
+// The content itself is neither created nor deleted.
+type contentMetadataResource struct {
+	baseResource
+}
+
+// contentMetadataResourceModel maps the resource schema data.
+type contentMetadataResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	ContentMetadataID types.String   `tfsdk:"content_metadata_id"`
+	Inherits          types.Bool     `tfsdk:"inherits"`
+	Name              types.String   `tfsdk:"name"`
+	ContentType       types.String   `tfsdk:"content_type"`
+	ParentID          types.String   `tfsdk:"parent_id"`
+	InheritingID      types.String   `tfsdk:"inheriting_id"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewContentMetadataResource is a helper function to simplify the provider implementation.
+func NewContentMetadataResource() resource.Resource {
+	return &contentMetadataResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *contentMetadataResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_metadata"
+}
+
+// Schema defines the schema for the resource.
+func (r *contentMetadataResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages whether existing content, such as a folder, board or dashboard, inherits its access from its parent. The content is not created or deleted, and destroying the resource leaves its inheritance as it is.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "The content_metadata_id of the content.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content_metadata_id": schema.StringAttribute{
+				Description: "The content_metadata_id of the content, e.g. from the `looker_folder` data source.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"inherits": schema.BoolAttribute{
+				Description: "Whether the content inherits its access from its parent. Must be false for direct grants, such as `looker_folder_access`, to take effect.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the content.",
+				Computed:    true,
+			},
+			"content_type": schema.StringAttribute{
+				Description: "The type of the content, e.g. `space` for folders, `board` or `dashboard`.",
+				Computed:    true,
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "The content_metadata_id of the parent of the content.",
+				Computed:    true,
+			},
+			"inheriting_id": schema.StringAttribute{
+				Description: "The content_metadata_id of the content whose access this content inherits, if any.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// setInherits updates the inheritance of the content and stores the result in
+// model.
+func (r *contentMetadataResource) setInherits(ctx context.Context, model *contentMetadataResourceModel) error {
+	id := model.ContentMetadataID.ValueString()
+	meta, err := r.client.SDK(ctx).UpdateContentMetadata(id, v4.WriteContentMeta{Inherits: model.Inherits.ValueBoolPointer()}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to update content metadata %s: %w", id, err)
+	}
+	model.ID = types.StringValue(id)
+	setContentMetadata(model, meta)
+	return nil
+}
+
+// setContentMetadata copies the read-only attributes of meta into model.
+func setContentMetadata(model *contentMetadataResourceModel, meta v4.ContentMeta) {
+	model.Name = types.StringPointerValue(meta.Name)
+	model.ContentType = types.StringPointerValue(meta.ContentType)
+	model.ParentID = types.StringPointerValue(meta.ParentId)
+	model.InheritingID = types.StringPointerValue(meta.InheritingId)
+}
+
+// Create takes over the inheritance of the content.
+func (r *contentMetadataResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan contentMetadataResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setInherits(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *contentMetadataResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state contentMetadataResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	meta, err := r.client.SDK(ctx).ContentMetadata(id, "", nil)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read content metadata %s: %v", id, err))
+		return
+	}
+	state.ContentMetadataID = types.StringValue(id)
+	state.Inherits = types.BoolValue(meta.Inherits != nil && *meta.Inherits)
+	setContentMetadata(&state, meta)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *contentMetadataResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
+	var plan contentMetadataResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setInherits(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete only removes the resource from state: the inheritance of the content
+// is left as it is.
+func (r *contentMetadataResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState imports the resource by content_metadata_id.
+func (r *contentMetadataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
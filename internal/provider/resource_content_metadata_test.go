@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"testing"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestContentMetadataResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &contentMetadataResource{baseResource{client: client}})
+
+	api.EXPECT().UpdateContentMetadata("90", v4.WriteContentMeta{Inherits: ptr(false)}, nil).Return(v4.ContentMeta{
+		Id:           ptr("90"),
+		Name:         ptr("Finance"),
+		ContentType:  ptr("space"),
+		ParentId:     ptr("1"),
+		InheritingId: ptr("90"),
+		Inherits:     ptr(false),
+	}, nil)
+	state, diags := tr.create(map[string]any{"content_metadata_id": "90", "inherits": false})
+	requireNoErrors(t, "Create", diags)
+	var id, inheritingID string
+	tr.get(state, "id", &id)
+	tr.get(state, "inheriting_id", &inheritingID)
+	if id != "90" || inheritingID != "90" {
+		t.Errorf("id, inheriting_id = %q, %q, want 90, 90", id, inheritingID)
+	}
+
+	api.EXPECT().ContentMetadata("90", "", nil).Return(v4.ContentMeta{
+		Id:           ptr("90"),
+		Name:         ptr("Finance"),
+		ContentType:  ptr("space"),
+		ParentId:     ptr("1"),
+		InheritingId: ptr("1"),
+		Inherits:     ptr(true),
+	}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var inherits bool
+	tr.get(state, "inherits", &inherits)
+	if !inherits {
+		t.Error("inherits = false, want the inheritance changed outside Terraform")
+	}
+
+	api.EXPECT().UpdateContentMetadata("90", v4.WriteContentMeta{Inherits: ptr(false)}, nil).Return(v4.ContentMeta{
+		Id:           ptr("90"),
+		InheritingId: ptr("90"),
+		Inherits:     ptr(false),
+	}, nil)
+	state, diags = tr.update(state, map[string]any{"id": "90", "content_metadata_id": "90", "inherits": false})
+	requireNoErrors(t, "Update", diags)
+
+	// Delete leaves the inheritance as it is, so the mock expects no call.
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestContentMetadataResourceReadNotFound(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &contentMetadataResource{baseResource{client: client}})
+
+	api.EXPECT().ContentMetadata("90", "", nil).Return(v4.ContentMeta{}, errNotFound)
+	state, diags := tr.read(tr.state(map[string]any{"id": "90", "content_metadata_id": "90", "inherits": false}))
+	requireNoErrors(t, "Read", diags)
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want removed", state.Raw)
+	}
+}
@@ -0,0 +1,466 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var (
+	_ resource.Resource                   = &dashboardResource{}
+	_ resource.ResourceWithConfigure      = &dashboardResource{}
+	_ resource.ResourceWithValidateConfig = &dashboardResource{}
+	_ resource.ResourceWithImportState    = &dashboardResource{}
+	_ resource.ResourceWithUpgradeState   = &dashboardResource{}
+)
+
+// dashboardResource is the resource implementation.
+type dashboardResource struct {
+	baseResource
+}
+
+// dashboardResourceModel maps the resource schema data.
+type dashboardResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Title       types.String   `tfsdk:"title"`
+	FolderID    types.String   `tfsdk:"folder_id"`
+	Description types.String   `tfsdk:"description"`
+	Filters     types.String   `tfsdk:"filters"`
+	Elements    types.String   `tfsdk:"elements"`
+	ElementIDs  types.List     `tfsdk:"element_ids"`
+	WebURL      types.String   `tfsdk:"web_url"`
+	SudoUserID  types.String   `tfsdk:"sudo_user_id"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewDashboardResource is a helper function to simplify the provider implementation.
+func NewDashboardResource() resource.Resource {
+	return &dashboardResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *dashboardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard"
+}
+
+// Schema defines the schema for the resource.
+func (r *dashboardResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a user-defined Looker dashboard from a definition of its filters and elements. " +
+			"Filters and elements are given as JSON arrays in the format of the Looker API (`WriteDashboardFilter` and `WriteDashboardElement`); " +
+			"only the keys present in the configuration are compared on refresh, so ids and other values generated by Looker never show up as a diff.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "The ID of the dashboard.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"web_url": schema.StringAttribute{
+				Description: "Link to the dashboard in the Looker web UI.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sudo_user_id": schema.StringAttribute{
+				Description: "ID of the user the dashboard is created as, who becomes its owner. Overrides the provider's sudo_user_id. Only used when the dashboard is created; changing it does not modify an existing dashboard.",
+				Optional:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the dashboard.",
+				Required:    true,
+			},
+			"folder_id": schema.StringAttribute{
+				Description: "The ID of the folder the dashboard is saved in.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the dashboard.",
+				Optional:    true,
+			},
+			"filters": schema.StringAttribute{
+				Description: "JSON array of dashboard filters, e.g. `[{\"name\": \"date\", \"title\": \"Date\", \"type\": \"date\"}]`. Changing it replaces all filters of the dashboard.",
+				Optional:    true,
+			},
+			"elements": schema.StringAttribute{
+				Description: "JSON array of dashboard elements (tiles), e.g. `[{\"type\": \"text\", \"title_text\": \"Hello\"}]`. Changing it replaces all elements of the dashboard.",
+				Optional:    true,
+			},
+			"element_ids": schema.ListAttribute{
+				Description: "The IDs Looker assigned to the elements, in the order of `elements`.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that filters and elements are JSON arrays.
+func (r *dashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config dashboardResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Filters.IsNull() && !config.Filters.IsUnknown() {
+		var filters []v4.WriteCreateDashboardFilter
+		if err := json.Unmarshal([]byte(config.Filters.ValueString()), &filters); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("filters"), "Invalid dashboard filters", fmt.Sprintf("filters must be a JSON array of dashboard filters: %v", err))
+		}
+	}
+	if !config.Elements.IsNull() && !config.Elements.IsUnknown() {
+		var elements []v4.WriteDashboardElement
+		if err := json.Unmarshal([]byte(config.Elements.ValueString()), &elements); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("elements"), "Invalid dashboard elements", fmt.Sprintf("elements must be a JSON array of dashboard elements: %v", err))
+		}
+	}
+}
+
+// writeDashboard returns the dashboard attributes of the model.
+func (m *dashboardResourceModel) writeDashboard() v4.WriteDashboard {
+	return v4.WriteDashboard{
+		Title:       m.Title.ValueStringPointer(),
+		FolderId:    m.FolderID.ValueStringPointer(),
+		Description: m.Description.ValueStringPointer(),
+	}
+}
+
+// replaceFilters deletes the current filters of the dashboard and creates the
+// ones of the definition.
+func (r *dashboardResource) replaceFilters(ctx context.Context, dashboardID string, definition types.String) error {
+	sdk := r.client.SDK(ctx)
+	current, err := sdk.DashboardDashboardFilters(dashboardID, "id", nil)
+	if err != nil {
+		return fmt.Errorf("failed to list filters of dashboard %s: %w", dashboardID, err)
+	}
+	for _, filter := range current {
+		if _, err := sdk.DeleteDashboardFilter(*filter.Id, nil); err != nil && !isNotFound(err) {
+			return fmt.Errorf("failed to delete filter %s of dashboard %s: %w", *filter.Id, dashboardID, err)
+		}
+	}
+
+	if definition.IsNull() {
+		return nil
+	}
+	var filters []v4.WriteCreateDashboardFilter
+	if err := json.Unmarshal([]byte(definition.ValueString()), &filters); err != nil {
+		return fmt.Errorf("invalid filters: %w", err)
+	}
+	for _, filter := range filters {
+		filter.DashboardId = dashboardID
+		if _, err := sdk.CreateDashboardFilter(filter, "", nil); err != nil {
+			return fmt.Errorf("failed to create filter %q on dashboard %s: %w", filter.Name, dashboardID, err)
+		}
+	}
+	return nil
+}
+
+// replaceElements deletes the current elements of the dashboard and creates
+// the ones of the definition, returning their new IDs in order.
+func (r *dashboardResource) replaceElements(ctx context.Context, dashboardID string, definition types.String) ([]string, error) {
+	sdk := r.client.SDK(ctx)
+	current, err := sdk.DashboardDashboardElements(dashboardID, "id", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list elements of dashboard %s: %w", dashboardID, err)
+	}
+	for _, element := range current {
+		if _, err := sdk.DeleteDashboardElement(*element.Id, nil); err != nil && !isNotFound(err) {
+			return nil, fmt.Errorf("failed to delete element %s of dashboard %s: %w", *element.Id, dashboardID, err)
+		}
+	}
+
+	ids := []string{}
+	if definition.IsNull() {
+		return ids, nil
+	}
+	var elements []v4.WriteDashboardElement
+	if err := json.Unmarshal([]byte(definition.ValueString()), &elements); err != nil {
+		return nil, fmt.Errorf("invalid elements: %w", err)
+	}
+	for i, element := range elements {
+		element.DashboardId = &dashboardID
+		created, err := sdk.CreateDashboardElement(v4.RequestCreateDashboardElement{Body: element}, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create element %d on dashboard %s: %w", i, dashboardID, err)
+		}
+		ids = append(ids, *created.Id)
+	}
+	return ids, nil
+}
+
+// projectJSON keeps only the parts of actual that are present in configured,
+// so that values generated by Looker (ids, defaults) are ignored when
+// comparing a definition with what the API returns.
+func projectJSON(configured, actual any) any {
+	switch c := configured.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			return actual
+		}
+		projected := make(map[string]any, len(c))
+		for key, value := range c {
+			projected[key] = projectJSON(value, a[key])
+		}
+		return projected
+	case []any:
+		a, ok := actual.([]any)
+		if !ok {
+			return actual
+		}
+		projected := make([]any, len(a))
+		for i := range a {
+			if i < len(c) {
+				projected[i] = projectJSON(c[i], a[i])
+			} else {
+				projected[i] = a[i]
+			}
+		}
+		return projected
+	default:
+		return actual
+	}
+}
+
+// refreshDefinition returns the definition to store for a list of objects read
+// from the API. The configured definition is kept when the API objects match
+// it; otherwise the API objects are rendered, restricted to the configured keys
+// when there is a configuration to compare with.
+func refreshDefinition(configured types.String, actual any, ignore ...string) (types.String, error) {
+	raw, err := json.Marshal(actual)
+	if err != nil {
+		return configured, err
+	}
+	var actualValue []any
+	if err := json.Unmarshal(raw, &actualValue); err != nil {
+		return configured, err
+	}
+	for _, item := range actualValue {
+		if object, ok := item.(map[string]any); ok {
+			for _, key := range ignore {
+				delete(object, key)
+			}
+		}
+	}
+
+	if configured.IsNull() || configured.IsUnknown() {
+		if len(actualValue) == 0 {
+			return types.StringNull(), nil
+		}
+		rendered, err := json.Marshal(actualValue)
+		return types.StringValue(string(rendered)), err
+	}
+
+	var configuredValue any
+	if err := json.Unmarshal([]byte(configured.ValueString()), &configuredValue); err != nil {
+		return configured, err
+	}
+	projected := projectJSON(configuredValue, any(actualValue))
+	if reflect.DeepEqual(projected, configuredValue) {
+		return configured, nil
+	}
+	rendered, err := json.Marshal(projected)
+	return types.StringValue(string(rendered)), err
+}
+
+// Create creates the dashboard with its filters and elements.
+func (r *dashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan dashboardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboard, err := r.client.asUser(plan.SudoUserID.ValueString()).SDK(ctx).CreateDashboard(plan.writeDashboard(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to create dashboard: %v", err))
+		return
+	}
+	plan.ID = types.StringPointerValue(dashboard.Id)
+	plan.WebURL = r.client.webURL("dashboards", dashboard.Id)
+	// Save the dashboard right away so that a failure below does not orphan it.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+
+	// Filters go first: elements may listen to them.
+	if err := r.replaceFilters(ctx, *dashboard.Id, plan.Filters); err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+	elementIDs, err := r.replaceElements(ctx, *dashboard.Id, plan.Elements)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+
+	ids, diags := types.ListValueFrom(ctx, types.StringType, elementIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ElementIDs = ids
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state dashboardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dashboard, err := r.client.SDK(ctx).Dashboard(state.ID.ValueString(), "", nil)
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Dashboard %s not found, removing from state", state.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read dashboard %s: %v", state.ID.ValueString(), err))
+		return
+	}
+	if dashboard.Deleted != nil && *dashboard.Deleted {
+		tflog.Warn(ctx, fmt.Sprintf("Dashboard %s is in the trash, removing from state", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Title = types.StringPointerValue(dashboard.Title)
+	state.FolderID = types.StringPointerValue(dashboard.FolderId)
+	state.WebURL = r.client.webURL("dashboards", dashboard.Id)
+	if dashboard.Description != nil && (*dashboard.Description != "" || !state.Description.IsNull()) {
+		state.Description = types.StringPointerValue(dashboard.Description)
+	}
+
+	filters := []v4.DashboardFilter{}
+	if dashboard.DashboardFilters != nil {
+		filters = *dashboard.DashboardFilters
+	}
+	state.Filters, err = refreshDefinition(state.Filters, filters, "id", "dashboard_id", "can")
+	if err != nil {
+		resp.Diagnostics.AddError("Read error", fmt.Sprintf("Failed to compare filters of dashboard %s: %v", state.ID.ValueString(), err))
+		return
+	}
+
+	elements := []v4.DashboardElement{}
+	if dashboard.DashboardElements != nil {
+		elements = *dashboard.DashboardElements
+	}
+	state.Elements, err = refreshDefinition(state.Elements, elements, "id", "dashboard_id", "can", "edit_uri", "query_id", "result_maker_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Read error", fmt.Sprintf("Failed to compare elements of dashboard %s: %v", state.ID.ValueString(), err))
+		return
+	}
+	elementIDs := make([]attr.Value, 0, len(elements))
+	for _, element := range elements {
+		elementIDs = append(elementIDs, types.StringPointerValue(element.Id))
+	}
+	state.ElementIDs = types.ListValueMust(types.StringType, elementIDs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the dashboard, replacing filters and elements when their
+// definition changed.
+func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
+	var plan, state dashboardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	dashboardID := state.ID.ValueString()
+
+	if _, err := r.client.SDK(ctx).UpdateDashboard(dashboardID, plan.writeDashboard(), nil); err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to update dashboard %s: %v", dashboardID, err))
+		return
+	}
+
+	if !plan.Filters.Equal(state.Filters) {
+		if err := r.replaceFilters(ctx, dashboardID, plan.Filters); err != nil {
+			resp.Diagnostics.AddError("API error", err.Error())
+			return
+		}
+	}
+	plan.ElementIDs = state.ElementIDs
+	if !plan.Elements.Equal(state.Elements) {
+		elementIDs, err := r.replaceElements(ctx, dashboardID, plan.Elements)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", err.Error())
+			return
+		}
+		ids, diags := types.ListValueFrom(ctx, types.StringType, elementIDs)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.ElementIDs = ids
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the dashboard.
+func (r *dashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
+	var state dashboardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.SDK(ctx).DeleteDashboard(state.ID.ValueString(), nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to delete dashboard %s: %v", state.ID.ValueString(), err))
+		return
+	}
+}
+
+// ImportState imports an existing dashboard by ID. Filters and elements are
+// read back in full.
+func (r *dashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
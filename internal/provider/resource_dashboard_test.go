@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"testing"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDashboardResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &dashboardResource{baseResource{client: client}})
+	filters := `[{"name":"date","title":"Date","type":"date_filter"}]`
+	elements := `[{"title":"Notes","type":"text"}]`
+
+	gomock.InOrder(
+		api.EXPECT().CreateDashboard(v4.WriteDashboard{Title: ptr("Sales"), FolderId: ptr("12")}, nil).Return(v4.Dashboard{Id: ptr("30")}, nil),
+		api.EXPECT().DashboardDashboardFilters("30", "id", nil).Return([]v4.DashboardFilter{}, nil),
+		api.EXPECT().CreateDashboardFilter(v4.WriteCreateDashboardFilter{DashboardId: "30", Name: "date", Title: "Date", Type: "date_filter"}, "", nil).
+			Return(v4.DashboardFilter{Id: ptr("1")}, nil),
+		api.EXPECT().DashboardDashboardElements("30", "id", nil).Return([]v4.DashboardElement{{Id: ptr("2")}}, nil),
+		api.EXPECT().DeleteDashboardElement("2", nil).Return("", nil),
+		api.EXPECT().CreateDashboardElement(v4.RequestCreateDashboardElement{Body: v4.WriteDashboardElement{
+			DashboardId: ptr("30"),
+			Title:       ptr("Notes"),
+			Type:        ptr("text"),
+		}}, nil).Return(v4.DashboardElement{Id: ptr("3")}, nil),
+	)
+	state, diags := tr.create(map[string]any{"title": "Sales", "folder_id": "12", "filters": filters, "elements": elements})
+	requireNoErrors(t, "Create", diags)
+	var elementIDs []string
+	tr.get(state, "element_ids", &elementIDs)
+	if len(elementIDs) != 1 || elementIDs[0] != "3" {
+		t.Errorf("element_ids = %v, want [3]", elementIDs)
+	}
+
+	api.EXPECT().Dashboard("30", "", nil).Return(v4.Dashboard{
+		Id:               ptr("30"),
+		Title:            ptr("Sales"),
+		FolderId:         ptr("12"),
+		Description:      ptr(""),
+		DashboardFilters: &[]v4.DashboardFilter{{Id: ptr("1"), DashboardId: ptr("30"), Name: ptr("date"), Title: ptr("Date"), Type: ptr("date_filter")}},
+		DashboardElements: &[]v4.DashboardElement{{
+			Id:          ptr("3"),
+			DashboardId: ptr("30"),
+			Title:       ptr("Notes"),
+			Type:        ptr("text"),
+		}},
+	}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var gotFilters, gotElements string
+	tr.get(state, "filters", &gotFilters)
+	tr.get(state, "elements", &gotElements)
+	if gotFilters != filters || gotElements != elements {
+		t.Errorf("filters, elements = %s, %s, want them unchanged", gotFilters, gotElements)
+	}
+
+	// Only the title changed: filters and elements are left alone.
+	api.EXPECT().UpdateDashboard("30", v4.WriteDashboard{Title: ptr("Revenue"), FolderId: ptr("12")}, nil).Return(v4.Dashboard{Id: ptr("30")}, nil)
+	state, diags = tr.update(state, map[string]any{"id": "30", "title": "Revenue", "folder_id": "12", "filters": filters, "elements": elements})
+	requireNoErrors(t, "Update", diags)
+	tr.get(state, "element_ids", &elementIDs)
+	if len(elementIDs) != 1 || elementIDs[0] != "3" {
+		t.Errorf("element_ids = %v, want [3] kept", elementIDs)
+	}
+
+	api.EXPECT().DeleteDashboard("30", nil).Return("", nil)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestDashboardResourceReadNotFound(t *testing.T) {
+	tests := map[string]struct {
+		dashboard v4.Dashboard
+		err       error
+	}{
+		"deleted":  {err: errNotFound},
+		"in trash": {dashboard: v4.Dashboard{Id: ptr("30"), Deleted: ptr(true)}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, api := newMockClient(t)
+			tr := newTestResource(t, &dashboardResource{baseResource{client: client}})
+
+			api.EXPECT().Dashboard("30", "", nil).Return(test.dashboard, test.err)
+			state, diags := tr.read(tr.state(map[string]any{"id": "30", "title": "Sales", "folder_id": "12"}))
+			requireNoErrors(t, "Read", diags)
+			if !state.Raw.IsNull() {
+				t.Errorf("state = %v, want removed", state.Raw)
+			}
+		})
+	}
+}
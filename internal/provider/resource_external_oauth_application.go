@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var (
+	_ resource.Resource                 = &externalOauthApplicationResource{}
+	_ resource.ResourceWithConfigure    = &externalOauthApplicationResource{}
+	_ resource.ResourceWithImportState  = &externalOauthApplicationResource{}
+	_ resource.ResourceWithUpgradeState = &externalOauthApplicationResource{}
+)
+
+// externalOauthApplicationResource is the resource implementation.
+type externalOauthApplicationResource struct {
+	baseResource
+}
+
+// externalOauthApplicationModel maps the resource schema data.
+type externalOauthApplicationModel struct {
+	ID           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	ClientID     types.String   `tfsdk:"client_id"`
+	ClientSecret types.String   `tfsdk:"client_secret"`
+	TenantID     types.String   `tfsdk:"tenant_id"`
+	DialectName  types.String   `tfsdk:"dialect_name"`
+	CreatedAt    types.String   `tfsdk:"created_at"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewExternalOauthApplicationResource is a helper function to simplify the provider implementation.
+func NewExternalOauthApplicationResource() resource.Resource {
+	return &externalOauthApplicationResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *externalOauthApplicationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_oauth_application"
+}
+
+// Schema defines the schema for the resource.
+func (r *externalOauthApplicationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Registers an OAuth application Looker uses to authenticate users to a database, e.g. Snowflake or BigQuery OAuth. Only the client secret can be changed in place. Looker cannot delete OAuth applications, so destroying the resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "The ID of the OAuth application.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the application. For Snowflake connections, this should be the name of the host database.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				Description: "The OAuth client ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				Description: "The OAuth client secret. Looker never returns it, so changes made outside Terraform are not detected.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"tenant_id": schema.StringAttribute{
+				Description: "The OAuth tenant ID, if the identity provider needs one.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dialect_name": schema.StringAttribute{
+				Description: "The database dialect of the application, e.g. `snowflake` or `bigquery_standard_sql`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation time of the application, in RFC 3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// findExternalOauthApplication returns the OAuth application with the given
+// ID, or nil if there is none. Looker has no endpoint to get a single
+// application, so the list is searched, narrowed by client ID when known.
+func findExternalOauthApplication(sdk lookerapi.Client, id, clientID string) (*v4.ExternalOauthApplication, error) {
+	request := v4.RequestAllExternalOauthApplications{}
+	if clientID != "" {
+		request.ClientId = &clientID
+	}
+	applications, err := sdk.AllExternalOauthApplications(request, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, application := range applications {
+		if application.Id != nil && *application.Id == id {
+			return &application, nil
+		}
+	}
+	return nil, nil
+}
+
+// setFromApplication copies the values Looker returns into the model.
+func (m *externalOauthApplicationModel) setFromApplication(application v4.ExternalOauthApplication) {
+	m.ID = types.StringPointerValue(application.Id)
+	m.Name = types.StringPointerValue(application.Name)
+	m.ClientID = types.StringPointerValue(application.ClientId)
+	m.DialectName = types.StringPointerValue(application.DialectName)
+	if application.TenantId != nil && *application.TenantId != "" {
+		m.TenantID = types.StringValue(*application.TenantId)
+	} else {
+		m.TenantID = types.StringNull()
+	}
+	if application.CreatedAt != nil {
+		m.CreatedAt = types.StringValue(application.CreatedAt.Format(time.RFC3339))
+	} else if m.CreatedAt.IsUnknown() {
+		m.CreatedAt = types.StringNull()
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *externalOauthApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan externalOauthApplicationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	application, err := r.client.SDK(ctx).CreateExternalOauthApplication(v4.WriteExternalOauthApplication{
+		Name:         plan.Name.ValueStringPointer(),
+		ClientId:     plan.ClientID.ValueStringPointer(),
+		ClientSecret: plan.ClientSecret.ValueStringPointer(),
+		TenantId:     plan.TenantID.ValueStringPointer(),
+		DialectName:  plan.DialectName.ValueStringPointer(),
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to create OAuth application %s: %v", plan.Name.ValueString(), err))
+		return
+	}
+
+	plan.setFromApplication(application)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *externalOauthApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state externalOauthApplicationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.ID.ValueString()
+
+	application, err := findExternalOauthApplication(r.client.SDK(ctx), id, state.ClientID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read OAuth application %s: %v", id, err))
+		return
+	}
+	if application == nil {
+		tflog.Warn(ctx, fmt.Sprintf("OAuth application %s not found, removing from state", id))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.setFromApplication(*application)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update changes the client secret, the only attribute that can be updated in place.
+func (r *externalOauthApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
+	var plan, state externalOauthApplicationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ClientSecret.Equal(state.ClientSecret) {
+		application, err := r.client.SDK(ctx).UpdateExternalOauthApplication(state.ClientID.ValueString(), v4.WriteExternalOauthApplication{
+			ClientSecret: plan.ClientSecret.ValueStringPointer(),
+		}, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to update OAuth application %s: %v", state.ID.ValueString(), err))
+			return
+		}
+		plan.setFromApplication(application)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the resource from state; Looker has no API to delete an OAuth application.
+func (r *externalOauthApplicationResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Warn(ctx, "Deleting a 'looker_external_oauth_application' does not remove the application from Looker. Revoke the client secret with the identity provider if needed.")
+}
+
+// ImportState imports an OAuth application using its ID. The client secret
+// cannot be read back and is planned as a change on the next apply.
+func (r *externalOauthApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
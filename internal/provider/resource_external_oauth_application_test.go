@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestExternalOauthApplicationResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &externalOauthApplicationResource{baseResource{client: client}})
+	application := v4.ExternalOauthApplication{
+		Id:          ptr("3"),
+		Name:        ptr("snowflake"),
+		ClientId:    ptr("client"),
+		DialectName: ptr("snowflake"),
+	}
+
+	api.EXPECT().CreateExternalOauthApplication(v4.WriteExternalOauthApplication{
+		Name:         ptr("snowflake"),
+		ClientId:     ptr("client"),
+		ClientSecret: ptr("secret"),
+		DialectName:  ptr("snowflake"),
+	}, nil).Return(application, nil)
+	state, diags := tr.create(map[string]any{"name": "snowflake", "client_id": "client", "client_secret": "secret", "dialect_name": "snowflake"})
+	requireNoErrors(t, "Create", diags)
+	var id string
+	tr.get(state, "id", &id)
+	if id != "3" {
+		t.Errorf("id = %q, want %q", id, "3")
+	}
+
+	api.EXPECT().AllExternalOauthApplications(v4.RequestAllExternalOauthApplications{ClientId: ptr("client")}, nil).
+		Return([]v4.ExternalOauthApplication{application}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var secret string
+	tr.get(state, "client_secret", &secret)
+	if secret != "secret" {
+		t.Errorf("client_secret = %q, want it kept from state", secret)
+	}
+
+	api.EXPECT().UpdateExternalOauthApplication("client", v4.WriteExternalOauthApplication{ClientSecret: ptr("rotated")}, nil).
+		Return(application, nil)
+	state, diags = tr.update(state, map[string]any{"id": "3", "name": "snowflake", "client_id": "client", "client_secret": "rotated", "dialect_name": "snowflake"})
+	requireNoErrors(t, "Update", diags)
+
+	// Looker cannot delete OAuth applications, so the mock expects no call.
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestExternalOauthApplicationResourceReadNotFound(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &externalOauthApplicationResource{baseResource{client: client}})
+
+	api.EXPECT().AllExternalOauthApplications(v4.RequestAllExternalOauthApplications{ClientId: ptr("client")}, nil).
+		Return([]v4.ExternalOauthApplication{{Id: ptr("4"), ClientId: ptr("client")}}, nil)
+	state, diags := tr.read(tr.state(map[string]any{"id": "3", "name": "snowflake", "client_id": "client", "client_secret": "secret", "dialect_name": "snowflake"}))
+	requireNoErrors(t, "Read", diags)
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want removed", state.Raw)
+	}
+}
@@ -2,13 +2,20 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -18,22 +25,36 @@ import (
 )
 
 var (
-	_ resource.Resource                = &folderAccessResource{}
-	_ resource.ResourceWithConfigure   = &folderAccessResource{}
-	_ resource.ResourceWithImportState = &folderAccessResource{}
+	_ resource.Resource                   = &folderAccessResource{}
+	_ resource.ResourceWithConfigure      = &folderAccessResource{}
+	_ resource.ResourceWithImportState    = &folderAccessResource{}
+	_ resource.ResourceWithValidateConfig = &folderAccessResource{}
+	_ resource.ResourceWithModifyPlan     = &folderAccessResource{}
+	_ resource.ResourceWithUpgradeState   = &folderAccessResource{}
 )
 
 // folderAccessResource is the resource implementation.
 type folderAccessResource struct {
-	sdk *v4.LookerSDK
+	baseResource
 }
 
 // folderAccessResourceModel maps the resource schema data.
 type folderAccessResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	FolderID    types.String `tfsdk:"folder_id"`
-	GroupID     types.String `tfsdk:"group_id"`
-	AccessLevel types.String `tfsdk:"access_level"`
+	ID             types.String `tfsdk:"id"`
+	FolderID       types.String `tfsdk:"folder_id"`
+	GroupID        types.String `tfsdk:"group_id"`
+	AccessLevel    types.String `tfsdk:"access_level"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	RemoveOnExpiry types.Bool   `tfsdk:"remove_on_expiry"`
+	Expired        types.Bool   `tfsdk:"expired"`
+	Notify         types.Bool   `tfsdk:"notify"`
+
+	LegacyFolderID    types.Bool   `tfsdk:"folder_id_is_content_metadata_id"`
+	ContentMetadataID types.String `tfsdk:"content_metadata_id"`
+
+	RemoveDuplicateGrants types.Bool     `tfsdk:"remove_duplicate_grants"`
+	DuplicateGrantIDs     types.Set      `tfsdk:"duplicate_grant_ids"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewFolderAccessResource is a helper function to simplify the provider implementation.
@@ -47,10 +68,12 @@ func (r *folderAccessResource) Metadata(_ context.Context, req resource.Metadata
 }
 
 // Schema defines the schema for the resource.
-func (r *folderAccessResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *folderAccessResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages content access grants for a Looker folder (space). This resource links a group to a folder with a specific access level.",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
 			"id": schema.StringAttribute{
 				Description: "The unique ID of this access grant.",
 				Computed:    true,
@@ -59,35 +82,275 @@ func (r *folderAccessResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"folder_id": schema.StringAttribute{
-				Description: "The ID of the folder (content_metadata_id) to grant access to.",
+				Description: "The ID of the folder to grant access to. With `folder_id_is_content_metadata_id`, the folder's content_metadata_id instead.",
 				Required:    true,
 			},
+			"folder_id_is_content_metadata_id": schema.BoolAttribute{
+				Description: "If true, `folder_id` is the content_metadata_id of the folder, as in earlier versions of the provider. Grants created by those versions have it set in state. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"content_metadata_id": schema.StringAttribute{
+				Description: "The content_metadata_id of the folder, which the grant is attached to.",
+				Computed:    true,
+			},
 			"group_id": schema.StringAttribute{
 				Description: "The ID of the group to grant access to.",
 				Required:    true,
 			},
 			"access_level": schema.StringAttribute{
-				Description: "The access level to grant. Valid values are: `view` (View), `edit` (Manage Access, Edit).",
+				Description: "The access level to grant. " + accessLevelDescription,
 				Required:    true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("view", "edit"),
+					stringvalidator.OneOf(accessLevelValues...),
 				},
 			},
+			"expires_at": schema.StringAttribute{
+				Description: "RFC 3339 timestamp after which the grant is considered expired, e.g. `2025-06-30T00:00:00Z`. Once expired, plans show `expired` changing to `true` together with a warning.",
+				Optional:    true,
+			},
+			"remove_on_expiry": schema.BoolAttribute{
+				Description: "If true, the first apply after `expires_at` removes the grant from the folder while keeping the resource in state. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"expired": schema.BoolAttribute{
+				Description: "Whether `expires_at` has passed.",
+				Computed:    true,
+			},
+			"notify": schema.BoolAttribute{
+				Description: "If true, Looker emails the members of the group when the grant is created. Changing it later sends nothing. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"remove_duplicate_grants": schema.BoolAttribute{
+				Description: "If true, redundant direct grants of the group on the folder listed in `duplicate_grant_ids` are deleted on the next apply. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"duplicate_grant_ids": schema.SetAttribute{
+				Description: "IDs of redundant direct grants of the group on the folder, besides the one managed by this resource.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 		},
 	}
 }
 
-// Configure adds the provider configured client to the resource.
-func (r *folderAccessResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if cb, ok := req.ProviderData.(*clientBundle); ok && cb.SDK != nil {
-		r.sdk = cb.SDK
-	} else if req.ProviderData != nil {
-		resp.Diagnostics.AddError("Unexpected provider data", "Missing Looker SDK client")
+// UpgradeState upgrades grants created when folder_id was always the
+// content_metadata_id of the folder.
+func (r *folderAccessResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: rawStateUpgrader(func(state map[string]json.RawMessage) {
+			state["content_metadata_id"] = state["folder_id"]
+			state["folder_id_is_content_metadata_id"] = json.RawMessage("true")
+		}),
+	}
+}
+
+// folderContentMetadataID returns the content_metadata_id of a folder.
+func folderContentMetadataID(sdk lookerapi.Client, folderID string) (string, error) {
+	folder, err := sdk.Folder(folderID, "id,content_metadata_id", nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to read folder %s: %w", folderID, err)
+	}
+	if folder.ContentMetadataId == nil {
+		return "", fmt.Errorf("folder %s has no content_metadata_id", folderID)
+	}
+	return *folder.ContentMetadataId, nil
+}
+
+// Bounds for retrying calls on a folder created moments ago in the same apply,
+// whose content metadata may not be visible yet.
+const (
+	contentMetadataRetryTimeout = 30 * time.Second
+	contentMetadataRetryMaxWait = 8 * time.Second
+)
+
+// retryNotFound runs call, retrying briefly while it fails with a 404. what
+// names the missing object in logs.
+func retryNotFound(ctx context.Context, what string, call func() error) error {
+	deadline := time.Now().Add(contentMetadataRetryTimeout)
+	wait := time.Second
+	for {
+		err := call()
+		if !isNotFound(err) || time.Now().Add(wait).After(deadline) {
+			return err
+		}
+		tflog.Warn(ctx, fmt.Sprintf("%s not found, retrying in %s", what, wait))
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+		wait = min(2*wait, contentMetadataRetryMaxWait)
+	}
+}
+
+// folderInheritsDetail explains why a grant on a folder inheriting its access
+// has no effect, and how to fix it.
+const folderInheritsDetail = "Folder %s inherits its access from its parent folder, so Looker ignores grants set on it. Set inherits_permissions = false on its looker_folder resource, or manage it with a looker_content_metadata resource if the folder is not managed by Terraform."
+
+// contentInherits reports whether the content with the given content metadata
+// ID inherits its access from its parent.
+func contentInherits(sdk lookerapi.Client, contentMetadataID string) (bool, error) {
+	meta, err := sdk.ContentMetadata(contentMetadataID, "inherits", nil)
+	if err != nil {
+		return false, err
+	}
+	return meta.Inherits != nil && *meta.Inherits, nil
+}
+
+// planContentMetadataID plans content_metadata_id from folder_id. state is nil
+// on create, legacyConfig the configured folder_id_is_content_metadata_id. A
+// grant moving to another folder is replaced. The folder is only looked up
+// when folder_id changes; otherwise its content_metadata_id is in state.
+func (r *folderAccessResource) planContentMetadataID(ctx context.Context, legacyConfig types.Bool, plan, state *folderAccessResourceModel, resp *resource.ModifyPlanResponse) {
+	if state != nil && state.LegacyFolderID.ValueBool() && legacyConfig.IsNull() && plan.FolderID.Equal(state.FolderID) {
+		// Grants upgraded from earlier versions keep the earlier meaning of
+		// folder_id until the configuration is migrated.
+		plan.LegacyFolderID = types.BoolValue(true)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("folder_id_is_content_metadata_id"), plan.LegacyFolderID)...)
+		resp.Diagnostics.AddAttributeWarning(path.Root("folder_id"), "folder_id is a content_metadata_id",
+			fmt.Sprintf("This grant was created with folder_id %s as the content_metadata_id of the folder. Set folder_id to the ID of the folder, e.g. looker_folder.<name>.id; the grant is kept as long as it resolves to the same content_metadata_id. To keep the earlier meaning instead, set folder_id_is_content_metadata_id = true.", plan.FolderID.ValueString()))
+	}
+
+	switch {
+	case plan.FolderID.IsUnknown() || plan.LegacyFolderID.IsUnknown():
+		// The folder is created in the same apply; Create resolves it.
+		plan.ContentMetadataID = types.StringUnknown()
+	case plan.LegacyFolderID.ValueBool():
+		plan.ContentMetadataID = plan.FolderID
+	case state != nil && plan.FolderID.Equal(state.FolderID) && !state.LegacyFolderID.ValueBool():
+		plan.ContentMetadataID = state.ContentMetadataID
+	case r.client == nil:
+		plan.ContentMetadataID = types.StringUnknown()
+	default:
+		id, err := folderContentMetadataID(r.client.SDK(ctx), plan.FolderID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("folder_id"), "Folder not found", err.Error())
+			return
+		}
+		plan.ContentMetadataID = types.StringValue(id)
+	}
+	if state != nil && !plan.ContentMetadataID.Equal(state.ContentMetadataID) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_metadata_id"))
 	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_metadata_id"), plan.ContentMetadataID)...)
+}
+
+// ValidateConfig checks that expires_at is a valid timestamp.
+func (r *folderAccessResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg folderAccessResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if _, err := grantExpired(cfg.ExpiresAt, time.Now()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("expires_at"), "Invalid expires_at",
+			fmt.Sprintf("expires_at must be an RFC 3339 timestamp such as 2025-06-30T00:00:00Z: %v", err))
+	}
+}
+
+// ModifyPlan resolves content_metadata_id, computes `expired` so that a grant
+// passing its expiry date shows up as a change in the plan, plans the removal
+// of duplicate grants and rejects grants also managed by another folder access
+// resource.
+func (r *folderAccessResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan folderAccessResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state *folderAccessResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &folderAccessResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var legacyConfig types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("folder_id_is_content_metadata_id"), &legacyConfig)...)
+	r.planContentMetadataID(ctx, legacyConfig, &plan, state, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// A grant moving to another folder is replaced, and claims when planned
+	// again as a new resource.
+	replaced := state != nil && !plan.ContentMetadataID.Equal(state.ContentMetadataID)
+	if !plan.ContentMetadataID.IsUnknown() && !plan.GroupID.IsUnknown() && !replaced {
+		claimant := folderAccessClaimant{resourceType: "looker_folder_access", folderID: plan.FolderID.ValueString()}
+		if state != nil {
+			claimant.id = state.ID.ValueString()
+		}
+		claimFolderAccess(r.client, claimant, plan.ContentMetadataID.ValueString(), plan.GroupID.ValueString(), &resp.Diagnostics)
+	}
+	if state == nil && r.client != nil && !plan.ContentMetadataID.IsUnknown() {
+		// Only a warning: the same apply may still turn off inheritance on
+		// the folder. Create fails if it has not.
+		if inherits, err := contentInherits(r.client.SDK(ctx), plan.ContentMetadataID.ValueString()); err == nil && inherits {
+			resp.Diagnostics.AddAttributeWarning(path.Root("folder_id"), "Folder inherits access",
+				fmt.Sprintf(folderInheritsDetail, plan.FolderID.ValueString())+" Unless this apply does so before creating the grant, it fails.")
+		}
+	}
+
+	duplicates := types.SetValueMust(types.StringType, []attr.Value{})
+	if state != nil {
+		if len(state.DuplicateGrantIDs.Elements()) > 0 {
+			if plan.RemoveDuplicateGrants.ValueBool() {
+				resp.Diagnostics.AddWarning("Duplicate folder access grants",
+					fmt.Sprintf("Redundant grants %s of group %s on folder %s will be removed.", state.DuplicateGrantIDs.String(), plan.GroupID.ValueString(), plan.FolderID.ValueString()))
+			} else {
+				duplicates = state.DuplicateGrantIDs
+			}
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("duplicate_grant_ids"), duplicates)...)
+
+	if plan.ExpiresAt.IsUnknown() {
+		return
+	}
+
+	expired, err := grantExpired(plan.ExpiresAt, time.Now())
+	if err != nil {
+		return // reported by ValidateConfig
+	}
+	if expired {
+		switch {
+		case req.State.Raw.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("expires_at"), "Grant already expired",
+				fmt.Sprintf("Cannot create an access grant that expired at %s.", plan.ExpiresAt.ValueString()))
+			return
+		case plan.RemoveOnExpiry.ValueBool():
+			resp.Diagnostics.AddWarning("Folder access grant expired",
+				fmt.Sprintf("The grant of group %s on folder %s expired at %s and will be removed.", plan.GroupID.ValueString(), plan.FolderID.ValueString(), plan.ExpiresAt.ValueString()))
+		default:
+			resp.Diagnostics.AddWarning("Folder access grant expired",
+				fmt.Sprintf("The grant of group %s on folder %s expired at %s. Remove it from the configuration or set remove_on_expiry.", plan.GroupID.ValueString(), plan.FolderID.ValueString(), plan.ExpiresAt.ValueString()))
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expired"), expired)...)
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *folderAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
 	var plan folderAccessResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -95,44 +358,132 @@ func (r *folderAccessResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	accessLevelString := plan.AccessLevel.ValueString()
-	permissionType := v4.PermissionType(accessLevelString)
+	// The folder may have been created earlier in this apply, so its content
+	// metadata may not be visible yet.
+	folderID := plan.FolderID.ValueString()
+	if plan.ContentMetadataID.IsUnknown() {
+		var id string
+		err := retryNotFound(ctx, "Folder "+folderID, func() (err error) {
+			id, err = folderContentMetadataID(r.client.SDK(ctx), folderID)
+			return err
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("API error", err.Error())
+			return
+		}
+		plan.ContentMetadataID = types.StringValue(id)
+	}
 
-	accessGrant, err := r.sdk.CreateContentMetadataAccess(
-		v4.ContentMetaGroupUser{
-			ContentMetadataId: plan.FolderID.ValueStringPointer(),
-			GroupId:           plan.GroupID.ValueStringPointer(),
-			PermissionType:    &permissionType,
-		},
-		false, // sendBoardsNotificationEmail
-		nil,
-	)
+	var inherits bool
+	err := retryNotFound(ctx, "Content metadata of folder "+folderID, func() (err error) {
+		inherits, err = contentInherits(r.client.SDK(ctx), plan.ContentMetadataID.ValueString())
+		return err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read content metadata of folder %s: %v", folderID, err))
+		return
+	}
+	if inherits {
+		resp.Diagnostics.AddAttributeError(path.Root("folder_id"), "Folder inherits access", fmt.Sprintf(folderInheritsDetail, folderID))
+		return
+	}
+
+	permissionType := permissionTypeFor(plan.AccessLevel.ValueString())
+
+	var accessGrant v4.ContentMetaGroupUser
+	err = retryNotFound(ctx, "Content metadata of folder "+folderID, func() (err error) {
+		accessGrant, err = r.client.SDK(ctx).CreateContentMetadataAccess(
+			v4.ContentMetaGroupUser{
+				ContentMetadataId: plan.ContentMetadataID.ValueStringPointer(),
+				GroupId:           plan.GroupID.ValueStringPointer(),
+				PermissionType:    &permissionType,
+			},
+			plan.Notify.ValueBool(), // sendBoardsNotificationEmail
+			nil,
+		)
+		return err
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to create folder access grant: %v", err))
 		return
 	}
 
 	plan.ID = types.StringPointerValue(accessGrant.Id)
+	plan.Expired = types.BoolValue(false)
+	plan.DuplicateGrantIDs = types.SetValueMust(types.StringType, []attr.Value{})
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-// findAccessGrant is a helper to locate a specific grant for a folder and group.
-// CORRECTED: The unused 'ctx' parameter is renamed to '_' to satisfy the compiler.
-func (r *folderAccessResource) findAccessGrant(_ context.Context, folderID, groupID string) (*v4.ContentMetaGroupUser, error) {
-	results, err := r.sdk.AllContentMetadataAccesses(folderID, "", nil)
+// findAccessGrant locates the grant of a group on a folder. Looker may list
+// several grants for the same group, e.g. an inherited one next to a direct
+// one. Direct grants (on the folder itself) win over inherited ones, and among
+// direct grants the one with ID preferredID wins. The other direct grants are
+// returned as redundant; inherited duplicates belong to an ancestor folder and
+// are only logged.
+func (r *folderAccessResource) findAccessGrant(ctx context.Context, folderID, groupID, preferredID string) (*v4.ContentMetaGroupUser, []v4.ContentMetaGroupUser, error) {
+	var direct, inherited []v4.ContentMetaGroupUser
+	err := forEachContentAccess(ctx, r.client, folderID, func(grant v4.ContentMetaGroupUser) error {
+		if grant.GroupId == nil || *grant.GroupId != groupID || grant.Id == nil {
+			return nil
+		}
+		if grant.ContentMetadataId == nil || *grant.ContentMetadataId == folderID {
+			direct = append(direct, grant)
+		} else {
+			inherited = append(inherited, grant)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("API error searching for access grants on folder %s: %w", folderID, err)
+		return nil, nil, fmt.Errorf("API error searching for access grants on folder %s: %w", folderID, err)
+	}
+
+	if len(direct) == 0 {
+		if len(inherited) == 0 {
+			return nil, nil, nil // Not found
+		}
+		if len(inherited) > 1 {
+			tflog.Warn(ctx, fmt.Sprintf("Group %s has %d inherited grants on folder %s, using %s", groupID, len(inherited), folderID, *inherited[0].Id))
+		}
+		return &inherited[0], nil, nil
+	}
+
+	preferred := 0
+	for i, grant := range direct {
+		if *grant.Id == preferredID {
+			preferred = i
+			break
+		}
 	}
-	for _, grant := range results {
-		if grant.GroupId != nil && *grant.GroupId == groupID {
-			return &grant, nil
+	redundant := make([]v4.ContentMetaGroupUser, 0, len(direct)-1)
+	for i, grant := range direct {
+		if i != preferred {
+			redundant = append(redundant, grant)
 		}
 	}
-	return nil, nil // Not found
+	if len(redundant) > 0 || len(inherited) > 0 {
+		tflog.Warn(ctx, fmt.Sprintf("Group %s has duplicate grants on folder %s, using direct grant %s", groupID, folderID, *direct[preferred].Id))
+	}
+	return &direct[preferred], redundant, nil
+}
+
+// grantIDs returns the IDs of grants as a set value.
+func grantIDs(grants []v4.ContentMetaGroupUser) types.Set {
+	ids := make([]attr.Value, 0, len(grants))
+	for _, grant := range grants {
+		ids = append(ids, types.StringPointerValue(grant.Id))
+	}
+	return types.SetValueMust(types.StringType, ids)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (r *folderAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
 	var state folderAccessResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -140,11 +491,46 @@ func (r *folderAccessResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	grant, err := r.findAccessGrant(ctx, state.FolderID.ValueString(), state.GroupID.ValueString())
+	if state.LegacyFolderID.IsNull() {
+		state.LegacyFolderID = types.BoolValue(false)
+	}
+	if state.ContentMetadataID.IsNull() {
+		// Imported: only folder_id is known.
+		id := state.FolderID.ValueString()
+		if !state.LegacyFolderID.ValueBool() {
+			var err error
+			if id, err = folderContentMetadataID(r.client.SDK(ctx), id); err != nil {
+				resp.Diagnostics.AddError("Read error", err.Error())
+				return
+			}
+		}
+		state.ContentMetadataID = types.StringValue(id)
+	}
+
+	grant, redundant, err := r.findAccessGrant(ctx, state.ContentMetadataID.ValueString(), state.GroupID.ValueString(), state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Read error", err.Error())
 		return
 	}
+	state.DuplicateGrantIDs = grantIDs(redundant)
+	if state.RemoveDuplicateGrants.IsNull() {
+		state.RemoveDuplicateGrants = types.BoolValue(false)
+	}
+	if state.Expired.IsNull() {
+		state.Expired = types.BoolValue(false)
+	}
+	if state.RemoveOnExpiry.IsNull() {
+		state.RemoveOnExpiry = types.BoolValue(false)
+	}
+	if state.Notify.IsNull() {
+		state.Notify = types.BoolValue(false)
+	}
+	if grant == nil && state.Expired.ValueBool() && state.RemoveOnExpiry.ValueBool() {
+		// The grant was removed on expiry; keep tracking it until it is
+		// dropped from the configuration or its expiry is extended.
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
 	if grant == nil {
 		tflog.Warn(ctx, fmt.Sprintf("Folder access grant for group %s on folder %s not found, removing from state.", state.GroupID.ValueString(), state.FolderID.ValueString()))
 		resp.State.RemoveResource(ctx)
@@ -153,7 +539,7 @@ func (r *folderAccessResource) Read(ctx context.Context, req resource.ReadReques
 
 	state.ID = types.StringPointerValue(grant.Id)
 	if grant.PermissionType != nil {
-		state.AccessLevel = types.StringValue(string(*grant.PermissionType))
+		state.AccessLevel = types.StringValue(accessLevelFor(*grant.PermissionType, state.AccessLevel.ValueString()))
 	} else {
 		state.AccessLevel = types.StringNull()
 	}
@@ -163,6 +549,13 @@ func (r *folderAccessResource) Read(ctx context.Context, req resource.ReadReques
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *folderAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
 	var plan, state folderAccessResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -170,25 +563,85 @@ func (r *folderAccessResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	accessLevelString := plan.AccessLevel.ValueString()
-	permissionType := v4.PermissionType(accessLevelString)
-
-	_, err := r.sdk.UpdateContentMetadataAccess(
-		state.ID.ValueString(),
-		v4.ContentMetaGroupUser{
-			PermissionType: &permissionType,
-		},
-		nil,
-	)
+	grant, redundant, err := r.findAccessGrant(ctx, state.ContentMetadataID.ValueString(), state.GroupID.ValueString(), state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to update folder access grant %s: %v", state.ID.ValueString(), err))
+		resp.Diagnostics.AddError("Read error", err.Error())
 		return
 	}
+	plan.ContentMetadataID = state.ContentMetadataID
+	if plan.RemoveDuplicateGrants.ValueBool() {
+		for _, duplicate := range redundant {
+			if _, err := r.client.SDK(ctx).DeleteContentMetadataAccess(*duplicate.Id, nil); err != nil && !isNotFound(err) {
+				resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to remove duplicate folder access grant %s: %v", *duplicate.Id, err))
+				return
+			}
+			tflog.Info(ctx, fmt.Sprintf("Removed duplicate folder access grant %s", *duplicate.Id))
+		}
+		redundant = nil
+	}
+	plan.DuplicateGrantIDs = grantIDs(redundant)
+
+	permissionType := permissionTypeFor(plan.AccessLevel.ValueString())
+	switch {
+	case plan.Expired.ValueBool() && plan.RemoveOnExpiry.ValueBool():
+		if grant != nil {
+			if _, err := r.client.SDK(ctx).DeleteContentMetadataAccess(*grant.Id, nil); err != nil && !isNotFound(err) {
+				resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to remove expired folder access grant %s: %v", *grant.Id, err))
+				return
+			}
+			tflog.Info(ctx, fmt.Sprintf("Removed expired folder access grant %s", *grant.Id))
+		}
+	case grant == nil:
+		// The grant was removed on expiry and its expiry has since been extended.
+		inherits, err := contentInherits(r.client.SDK(ctx), plan.ContentMetadataID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read content metadata of folder %s: %v", plan.FolderID.ValueString(), err))
+			return
+		}
+		if inherits {
+			resp.Diagnostics.AddAttributeError(path.Root("folder_id"), "Folder inherits access", fmt.Sprintf(folderInheritsDetail, plan.FolderID.ValueString()))
+			return
+		}
+		accessGrant, err := r.client.SDK(ctx).CreateContentMetadataAccess(
+			v4.ContentMetaGroupUser{
+				ContentMetadataId: plan.ContentMetadataID.ValueStringPointer(),
+				GroupId:           plan.GroupID.ValueStringPointer(),
+				PermissionType:    &permissionType,
+			},
+			plan.Notify.ValueBool(), // sendBoardsNotificationEmail
+			nil,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to create folder access grant: %v", err))
+			return
+		}
+		plan.ID = types.StringPointerValue(accessGrant.Id)
+	default:
+		_, err := r.client.SDK(ctx).UpdateContentMetadataAccess(
+			*grant.Id,
+			v4.ContentMetaGroupUser{
+				PermissionType: &permissionType,
+			},
+			nil,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to update folder access grant %s: %v", *grant.Id, err))
+			return
+		}
+		plan.ID = types.StringPointerValue(grant.Id)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *folderAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
 	var state folderAccessResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -196,8 +649,8 @@ func (r *folderAccessResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	_, err := r.sdk.DeleteContentMetadataAccess(state.ID.ValueString(), nil)
-	if err != nil {
+	_, err := r.client.SDK(ctx).DeleteContentMetadataAccess(state.ID.ValueString(), nil)
+	if err != nil && !isNotFound(err) {
 		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to delete folder access grant %s: %v", state.ID.ValueString(), err))
 		return
 	}
@@ -209,7 +662,7 @@ func (r *folderAccessResource) ImportState(ctx context.Context, req resource.Imp
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: <folder_id>/<group_id>. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: <folder_id>/<group_id>, where folder_id is the ID of the folder. Got: %q", req.ID),
 		)
 		return
 	}
@@ -0,0 +1,316 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var (
+	_ resource.Resource                 = &folderAccessPolicyResource{}
+	_ resource.ResourceWithConfigure    = &folderAccessPolicyResource{}
+	_ resource.ResourceWithImportState  = &folderAccessPolicyResource{}
+	_ resource.ResourceWithModifyPlan   = &folderAccessPolicyResource{}
+	_ resource.ResourceWithUpgradeState = &folderAccessPolicyResource{}
+)
+
+// folderAccessPolicyResource is the resource implementation.
+type folderAccessPolicyResource struct {
+	baseResource
+}
+
+// folderAccessPolicyResourceModel maps the resource schema data.
+type folderAccessPolicyResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	FolderID types.String   `tfsdk:"folder_id"`
+	Grants   types.Map      `tfsdk:"grants"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewFolderAccessPolicyResource is a helper function to simplify the provider implementation.
+func NewFolderAccessPolicyResource() resource.Resource {
+	return &folderAccessPolicyResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *folderAccessPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder_access_policy"
+}
+
+// Schema defines the schema for the resource.
+func (r *folderAccessPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manages the group access grants of a Looker folder (space). Any group grant on the folder that is not declared in `grants` is removed. Grants inherited from parent folders are left alone. Can be imported with the folder's `content_metadata_id`.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "The content_metadata_id of the folder.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"folder_id": schema.StringAttribute{
+				Description: "The ID of the folder (content_metadata_id) whose grants are managed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grants": schema.MapAttribute{
+				Description: "Map of group ID to access level. " + accessLevelDescription,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf(accessLevelValues...)),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan rejects a policy on a folder whose grants are also managed by
+// another folder access resource, since the policy would remove them.
+func (r *folderAccessPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan folderAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.FolderID.IsUnknown() {
+		return
+	}
+	claimant := folderAccessClaimant{resourceType: "looker_folder_access_policy", folderID: plan.FolderID.ValueString()}
+	if !req.State.Raw.IsNull() {
+		var state folderAccessPolicyResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || !state.FolderID.Equal(plan.FolderID) {
+			return // replaced, and claimed when planned again as a new resource
+		}
+		claimant.id = state.ID.ValueString()
+	}
+	claimFolderAccess(r.client, claimant, plan.FolderID.ValueString(), wholeFolder, &resp.Diagnostics)
+}
+
+// folderGroupGrants returns the folder's own group access grants keyed by
+// group ID. The grants it inherits from its ancestors are listed too, but
+// belong to them and are left out.
+func folderGroupGrants(ctx context.Context, client *clientBundle, folderID string) (map[string]v4.ContentMetaGroupUser, error) {
+	grants := make(map[string]v4.ContentMetaGroupUser)
+	err := forEachContentAccess(ctx, client, folderID, func(grant v4.ContentMetaGroupUser) error {
+		if grant.ContentMetadataId != nil && *grant.ContentMetadataId != folderID {
+			return nil
+		}
+		if grant.GroupId != nil {
+			grants[*grant.GroupId] = grant
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("API error listing access grants on folder %s: %w", folderID, err)
+	}
+	return grants, nil
+}
+
+// reconcileFolderGroupGrants makes the group grants on the folder match the
+// desired map of group ID to access level exactly.
+func reconcileFolderGroupGrants(ctx context.Context, client *clientBundle, folderID string, desired map[string]string) error {
+	current, err := folderGroupGrants(ctx, client, folderID)
+	if err != nil {
+		return err
+	}
+
+	sdk := client.SDK(ctx)
+	for groupID, accessLevel := range desired {
+		permissionType := permissionTypeFor(accessLevel)
+		grant, ok := current[groupID]
+		if !ok {
+			_, err := sdk.CreateContentMetadataAccess(
+				v4.ContentMetaGroupUser{
+					ContentMetadataId: &folderID,
+					GroupId:           &groupID,
+					PermissionType:    &permissionType,
+				},
+				false, // sendBoardsNotificationEmail
+				nil,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to grant %s access to group %s on folder %s: %w", accessLevel, groupID, folderID, err)
+			}
+			continue
+		}
+		if grant.PermissionType == nil || *grant.PermissionType != permissionType {
+			_, err := sdk.UpdateContentMetadataAccess(*grant.Id, v4.ContentMetaGroupUser{PermissionType: &permissionType}, nil)
+			if err != nil {
+				return fmt.Errorf("failed to update access grant %s on folder %s: %w", *grant.Id, folderID, err)
+			}
+		}
+	}
+
+	for groupID, grant := range current {
+		if _, ok := desired[groupID]; ok {
+			continue
+		}
+		if _, err := sdk.DeleteContentMetadataAccess(*grant.Id, nil); err != nil {
+			return fmt.Errorf("failed to remove access grant %s for group %s on folder %s: %w", *grant.Id, groupID, folderID, err)
+		}
+	}
+	return nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *folderAccessPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan folderAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var grants map[string]string
+	resp.Diagnostics.Append(plan.Grants.ElementsAs(ctx, &grants, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := reconcileFolderGroupGrants(ctx, r.client, plan.FolderID.ValueString(), grants); err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+
+	plan.ID = plan.FolderID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *folderAccessPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state folderAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	folderID := state.FolderID.ValueString()
+
+	current, err := folderGroupGrants(ctx, r.client, folderID)
+	if err != nil {
+		// Only a deleted folder drops the policy: after any other failure
+		// the next apply would recreate it and revoke every other grant.
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Folder %s not found, removing from state", folderID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+
+	var configured map[string]string
+	if !state.Grants.IsNull() && !state.Grants.IsUnknown() {
+		resp.Diagnostics.Append(state.Grants.ElementsAs(ctx, &configured, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	grants := make(map[string]string, len(current))
+	for groupID, grant := range current {
+		if grant.PermissionType != nil {
+			grants[groupID] = accessLevelFor(*grant.PermissionType, configured[groupID])
+		}
+	}
+	grantsMap, diags := types.MapValueFrom(ctx, types.StringType, grants)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Grants = grantsMap
+	state.ID = state.FolderID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *folderAccessPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
+	var plan folderAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var grants map[string]string
+	resp.Diagnostics.Append(plan.Grants.ElementsAs(ctx, &grants, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := reconcileFolderGroupGrants(ctx, r.client, plan.FolderID.ValueString(), grants); err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+
+	plan.ID = plan.FolderID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes every group grant managed by this policy from the folder.
+func (r *folderAccessPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
+	var state folderAccessPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := reconcileFolderGroupGrants(ctx, r.client, state.FolderID.ValueString(), map[string]string{}); err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+}
+
+// ImportState imports the full grant list of a folder using its content_metadata_id.
+func (r *folderAccessPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("folder_id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+// folderGrant returns a grant of groupID on the folder with content metadata
+// ID contentMetadataID.
+func folderGrant(id, contentMetadataID, groupID string, permissionType v4.PermissionType) v4.ContentMetaGroupUser {
+	return v4.ContentMetaGroupUser{
+		Id:                &id,
+		ContentMetadataId: &contentMetadataID,
+		GroupId:           &groupID,
+		PermissionType:    &permissionType,
+	}
+}
+
+func TestFolderAccessPolicyResourceInheritedGrants(t *testing.T) {
+	client, api := newMockClient(t)
+	streamer := mockStreamer(t, client)
+	tr := newTestResource(t, &folderAccessPolicyResource{baseResource{client: client}})
+
+	// Group 5 has view access inherited from the parent folder 10 and is
+	// granted edit on the folder itself; group 6 only inherits access.
+	listing := func() []v4.ContentMetaGroupUser {
+		return []v4.ContentMetaGroupUser{
+			folderGrant("50", "10", "5", v4.PermissionType_View),
+			folderGrant("60", "10", "6", v4.PermissionType_View),
+		}
+	}
+	gomock.InOrder(
+		streamer.EXPECT().Stream(gomock.Any(), "/content_metadata_access", gomock.Any()).Return(jsonBody(t, listing()), nil),
+		api.EXPECT().CreateContentMetadataAccess(v4.ContentMetaGroupUser{
+			ContentMetadataId: ptr("20"),
+			GroupId:           ptr("5"),
+			PermissionType:    ptr(v4.PermissionType_Edit),
+		}, false, nil).Return(v4.ContentMetaGroupUser{}, nil),
+		streamer.EXPECT().Stream(gomock.Any(), "/content_metadata_access", gomock.Any()).
+			Return(jsonBody(t, append(listing(), folderGrant("51", "20", "5", v4.PermissionType_Edit))), nil),
+		// Delete only removes the folder's own grant.
+		streamer.EXPECT().Stream(gomock.Any(), "/content_metadata_access", gomock.Any()).
+			Return(jsonBody(t, append(listing(), folderGrant("51", "20", "5", v4.PermissionType_Edit))), nil),
+		api.EXPECT().DeleteContentMetadataAccess("51", nil).Return("", nil),
+	)
+
+	state, diags := tr.create(map[string]any{"folder_id": "20", "grants": map[string]string{"5": "edit"}})
+	requireNoErrors(t, "Create", diags)
+
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var grants map[string]string
+	tr.get(state, "grants", &grants)
+	if want := map[string]string{"5": "edit"}; !reflect.DeepEqual(grants, want) {
+		t.Errorf("grants = %v, want %v without the inherited grants", grants, want)
+	}
+
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestFolderAccessPolicyResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	streamer := mockStreamer(t, client)
+	tr := newTestResource(t, &folderAccessPolicyResource{baseResource{client: client}})
+
+	// Group 7 was granted access outside Terraform and is revoked.
+	gomock.InOrder(
+		streamer.EXPECT().Stream(gomock.Any(), "/content_metadata_access", gomock.Any()).
+			Return(jsonBody(t, []v4.ContentMetaGroupUser{folderGrant("70", "20", "7", v4.PermissionType_View)}), nil),
+		api.EXPECT().CreateContentMetadataAccess(v4.ContentMetaGroupUser{
+			ContentMetadataId: ptr("20"),
+			GroupId:           ptr("5"),
+			PermissionType:    ptr(v4.PermissionType_View),
+		}, false, nil).Return(v4.ContentMetaGroupUser{}, nil),
+		api.EXPECT().DeleteContentMetadataAccess("70", nil).Return("", nil),
+	)
+	state, diags := tr.create(map[string]any{"folder_id": "20", "grants": map[string]string{"5": "view"}})
+	requireNoErrors(t, "Create", diags)
+	var id string
+	tr.get(state, "id", &id)
+	if id != "20" {
+		t.Errorf("id = %q, want %q", id, "20")
+	}
+
+	streamer.EXPECT().Stream(gomock.Any(), "/content_metadata_access", gomock.Any()).
+		Return(jsonBody(t, []v4.ContentMetaGroupUser{folderGrant("51", "20", "5", v4.PermissionType_View)}), nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+
+	gomock.InOrder(
+		streamer.EXPECT().Stream(gomock.Any(), "/content_metadata_access", gomock.Any()).
+			Return(jsonBody(t, []v4.ContentMetaGroupUser{folderGrant("51", "20", "5", v4.PermissionType_View)}), nil),
+		api.EXPECT().UpdateContentMetadataAccess("51", v4.ContentMetaGroupUser{PermissionType: ptr(v4.PermissionType_Edit)}, nil).
+			Return(v4.ContentMetaGroupUser{}, nil),
+	)
+	state, diags = tr.update(state, map[string]any{"id": "20", "folder_id": "20", "grants": map[string]string{"5": "edit"}})
+	requireNoErrors(t, "Update", diags)
+
+	gomock.InOrder(
+		streamer.EXPECT().Stream(gomock.Any(), "/content_metadata_access", gomock.Any()).
+			Return(jsonBody(t, []v4.ContentMetaGroupUser{folderGrant("51", "20", "5", v4.PermissionType_Edit)}), nil),
+		api.EXPECT().DeleteContentMetadataAccess("51", nil).Return("", nil),
+	)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestFolderAccessPolicyResourceReadNotFound(t *testing.T) {
+	client, _ := newMockClient(t)
+	tr := newTestResource(t, &folderAccessPolicyResource{baseResource{client: client}})
+
+	mockStreamer(t, client).EXPECT().Stream(gomock.Any(), "/content_metadata_access", gomock.Any()).Return(nil, errNotFound)
+	state, diags := tr.read(tr.state(map[string]any{"id": "20", "folder_id": "20", "grants": map[string]string{"5": "view"}}))
+	requireNoErrors(t, "Read", diags)
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want removed", state.Raw)
+	}
+}
@@ -0,0 +1,336 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                 = &folderAccessTemplateResource{}
+	_ resource.ResourceWithConfigure    = &folderAccessTemplateResource{}
+	_ resource.ResourceWithModifyPlan   = &folderAccessTemplateResource{}
+	_ resource.ResourceWithImportState  = &folderAccessTemplateResource{}
+	_ resource.ResourceWithUpgradeState = &folderAccessTemplateResource{}
+)
+
+// folderAccessTemplateResource is the resource implementation.
+type folderAccessTemplateResource struct {
+	baseResource
+}
+
+// folderAccessTemplateResourceModel maps the resource schema data.
+type folderAccessTemplateResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	SourceFolderID     types.String   `tfsdk:"source_folder_id"`
+	TargetFolderIDs    types.Set      `tfsdk:"target_folder_ids"`
+	Enforce            types.Bool     `tfsdk:"enforce"`
+	Grants             types.Map      `tfsdk:"grants"`
+	OutOfSyncFolderIDs types.Set      `tfsdk:"out_of_sync_folder_ids"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewFolderAccessTemplateResource is a helper function to simplify the provider implementation.
+func NewFolderAccessTemplateResource() resource.Resource {
+	return &folderAccessTemplateResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *folderAccessTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder_access_template"
+}
+
+// Schema defines the schema for the resource.
+func (r *folderAccessTemplateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Copies the group access grants of a template folder to one or more target folders. Each target ends up with exactly the group grants of the template. " +
+			"By default the copy happens when the resource is created or its arguments change; with `enforce` the targets are also checked on every refresh and brought back in line with the template. " +
+			"Destroying the resource leaves the grants of the target folders as they are. Can be imported with `<source_folder_id>`.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "The content_metadata_id of the template folder.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_folder_id": schema.StringAttribute{
+				Description: "The ID of the template folder (content_metadata_id) whose group grants are copied.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_folder_ids": schema.SetAttribute{
+				Description: "The IDs of the folders (content_metadata_id) that receive the template's group grants.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"enforce": schema.BoolAttribute{
+				Description: "If true, target folders whose group grants drift from the template are reported in `out_of_sync_folder_ids` and fixed on the next apply. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"grants": schema.MapAttribute{
+				Description: "The group grants copied from the template, as a map of group ID to permission type (`view` or `edit`).",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"out_of_sync_folder_ids": schema.SetAttribute{
+				Description: "Target folders whose group grants differ from the template. Only populated when `enforce` is true.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// templateGrants reads the group grants of the template folder as a map of
+// group ID to permission type.
+func templateGrants(ctx context.Context, client *clientBundle, folderID string) (map[string]string, error) {
+	current, err := folderGroupGrants(ctx, client, folderID)
+	if err != nil {
+		return nil, err
+	}
+	grants := make(map[string]string, len(current))
+	for groupID, grant := range current {
+		if grant.PermissionType != nil {
+			grants[groupID] = string(*grant.PermissionType)
+		}
+	}
+	return grants, nil
+}
+
+// outOfSync returns the sorted IDs of the target folders whose group grants
+// differ from the template. Changes to the template itself show up here too.
+func outOfSync(ctx context.Context, client *clientBundle, sourceID string, template map[string]string, targets []string) ([]string, error) {
+	var drifted []string
+	for _, folderID := range targets {
+		if folderID == sourceID {
+			continue
+		}
+		current, err := templateGrants(ctx, client, folderID)
+		if err != nil {
+			return nil, err
+		}
+		if !sameGrants(template, current) {
+			drifted = append(drifted, folderID)
+		}
+	}
+	sort.Strings(drifted)
+	return drifted, nil
+}
+
+// sameGrants reports whether two grant maps are identical.
+func sameGrants(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for groupID, permissionType := range a {
+		if b[groupID] != permissionType {
+			return false
+		}
+	}
+	return true
+}
+
+// ModifyPlan plans the out-of-sync targets found on refresh as fixed, so that
+// drift under `enforce` shows up as a change.
+func (r *folderAccessTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var state folderAccessTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(state.OutOfSyncFolderIDs.Elements()) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddWarning("Folder access drift",
+		fmt.Sprintf("Folders %s no longer match the grants of template folder %s and will be updated.", state.OutOfSyncFolderIDs.String(), state.SourceFolderID.ValueString()))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("out_of_sync_folder_ids"), types.SetValueMust(types.StringType, []attr.Value{}))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("grants"), types.MapUnknown(types.StringType))...)
+}
+
+// apply copies the template grants to every target folder and records them in
+// the model.
+func (r *folderAccessTemplateResource) apply(ctx context.Context, plan *folderAccessTemplateResourceModel) error {
+	sourceID := plan.SourceFolderID.ValueString()
+
+	grants, err := templateGrants(ctx, r.client, sourceID)
+	if err != nil {
+		return err
+	}
+
+	var targets []string
+	if diags := plan.TargetFolderIDs.ElementsAs(ctx, &targets, false); diags.HasError() {
+		return fmt.Errorf("invalid target_folder_ids")
+	}
+	for _, folderID := range targets {
+		if folderID == sourceID {
+			continue
+		}
+		if err := reconcileFolderGroupGrants(ctx, r.client, folderID, grants); err != nil {
+			return err
+		}
+		tflog.Info(ctx, fmt.Sprintf("Copied access grants of folder %s to folder %s", sourceID, folderID))
+	}
+
+	grantsMap, diags := types.MapValueFrom(ctx, types.StringType, grants)
+	if diags.HasError() {
+		return fmt.Errorf("invalid grants of folder %s", sourceID)
+	}
+	plan.ID = plan.SourceFolderID
+	plan.Grants = grantsMap
+	plan.OutOfSyncFolderIDs = types.SetValueMust(types.StringType, []attr.Value{})
+	return nil
+}
+
+// Create copies the template grants and sets the initial Terraform state.
+func (r *folderAccessTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan folderAccessTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to copy folder access: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *folderAccessTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state folderAccessTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sourceID := state.SourceFolderID.ValueString()
+
+	grants, err := templateGrants(ctx, r.client, sourceID)
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Template folder %s not found, removing from state", sourceID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read error", err.Error())
+		return
+	}
+
+	drifted := []string{}
+	if state.Enforce.ValueBool() {
+		var targets []string
+		resp.Diagnostics.Append(state.TargetFolderIDs.ElementsAs(ctx, &targets, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		found, err := outOfSync(ctx, r.client, sourceID, grants, targets)
+		if err != nil {
+			resp.Diagnostics.AddError("Read error", err.Error())
+			return
+		}
+		drifted = append(drifted, found...)
+	}
+	if state.Grants.IsNull() {
+		grantsMap, diags := types.MapValueFrom(ctx, types.StringType, grants)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Grants = grantsMap
+	}
+
+	outOfSyncSet, diags := types.SetValueFrom(ctx, types.StringType, drifted)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.OutOfSyncFolderIDs = outOfSyncSet
+	state.ID = state.SourceFolderID
+	if state.Enforce.IsNull() {
+		state.Enforce = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update copies the template grants again, e.g. to newly added targets.
+func (r *folderAccessTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
+	var plan folderAccessTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to copy folder access: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete only removes the resource from state; the copied grants stay on the
+// target folders.
+func (r *folderAccessTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state folderAccessTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Leaving access grants copied from folder %s in place", state.SourceFolderID.ValueString()))
+}
+
+// ImportState imports the template using the source folder's content_metadata_id.
+func (r *folderAccessTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("source_folder_id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
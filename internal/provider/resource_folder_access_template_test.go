@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFolderAccessTemplateResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	streamer := mockStreamer(t, client)
+	tr := newTestResource(t, &folderAccessTemplateResource{baseResource{client: client}})
+	template := []v4.ContentMetaGroupUser{
+		folderGrant("11", "10", "5", v4.PermissionType_View),
+		folderGrant("12", "10", "6", v4.PermissionType_Edit),
+	}
+
+	gomock.InOrder(
+		expectContentAccess(t, streamer, "10", template...),
+		expectContentAccess(t, streamer, "20", folderGrant("21", "20", "5", v4.PermissionType_Edit)),
+	)
+	api.EXPECT().UpdateContentMetadataAccess("21", v4.ContentMetaGroupUser{PermissionType: ptr(v4.PermissionType_View)}, nil).
+		Return(v4.ContentMetaGroupUser{}, nil)
+	api.EXPECT().CreateContentMetadataAccess(v4.ContentMetaGroupUser{
+		ContentMetadataId: ptr("20"),
+		GroupId:           ptr("6"),
+		PermissionType:    ptr(v4.PermissionType_Edit),
+	}, false, nil).Return(v4.ContentMetaGroupUser{}, nil)
+	state, diags := tr.create(map[string]any{"source_folder_id": "10", "target_folder_ids": []string{"10", "20"}, "enforce": true})
+	requireNoErrors(t, "Create", diags)
+	var grants map[string]string
+	tr.get(state, "grants", &grants)
+	if want := map[string]string{"5": "view", "6": "edit"}; !reflect.DeepEqual(grants, want) {
+		t.Errorf("grants = %v, want %v", grants, want)
+	}
+
+	// Folder 20 lost the grant of group 6 since.
+	gomock.InOrder(
+		expectContentAccess(t, streamer, "10", template...),
+		expectContentAccess(t, streamer, "20", folderGrant("21", "20", "5", v4.PermissionType_View)),
+	)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var outOfSync []string
+	tr.get(state, "out_of_sync_folder_ids", &outOfSync)
+	if want := []string{"20"}; !reflect.DeepEqual(outOfSync, want) {
+		t.Errorf("out_of_sync_folder_ids = %v, want %v", outOfSync, want)
+	}
+
+	gomock.InOrder(
+		expectContentAccess(t, streamer, "10", template...),
+		expectContentAccess(t, streamer, "20", folderGrant("21", "20", "5", v4.PermissionType_View)),
+		api.EXPECT().CreateContentMetadataAccess(v4.ContentMetaGroupUser{
+			ContentMetadataId: ptr("20"),
+			GroupId:           ptr("6"),
+			PermissionType:    ptr(v4.PermissionType_Edit),
+		}, false, nil).Return(v4.ContentMetaGroupUser{}, nil),
+	)
+	state, diags = tr.update(state, map[string]any{"id": "10", "source_folder_id": "10", "target_folder_ids": []string{"20"}, "enforce": true})
+	requireNoErrors(t, "Update", diags)
+	tr.get(state, "out_of_sync_folder_ids", &outOfSync)
+	if len(outOfSync) != 0 {
+		t.Errorf("out_of_sync_folder_ids = %v, want none after the update", outOfSync)
+	}
+
+	// The copied grants stay, so the mock expects no call.
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestFolderAccessTemplateResourceReadNotFound(t *testing.T) {
+	client, _ := newMockClient(t)
+	tr := newTestResource(t, &folderAccessTemplateResource{baseResource{client: client}})
+
+	mockStreamer(t, client).EXPECT().Stream(gomock.Any(), "/content_metadata_access", gomock.Any()).Return(nil, errNotFound)
+	state, diags := tr.read(tr.state(map[string]any{"id": "10", "source_folder_id": "10", "target_folder_ids": []string{"20"}, "enforce": false}))
+	requireNoErrors(t, "Read", diags)
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want removed", state.Raw)
+	}
+}
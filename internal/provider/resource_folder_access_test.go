@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-looker/internal/lookertest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAccFolderAccessResource(t *testing.T) {
+	srv := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(srv, "looker_folder_access", lookertest.ContentMetadataAccess),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderAccessConfig("view"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("looker_folder_access.test", "id"),
+					resource.TestCheckResourceAttrPair("looker_folder_access.test", "folder_id", "looker_folder.test", "id"),
+					resource.TestCheckResourceAttrPair("looker_folder_access.test", "content_metadata_id", "looker_folder.test", "content_metadata_id"),
+					resource.TestCheckResourceAttr("looker_folder_access.test", "access_level", "view"),
+					resource.TestCheckResourceAttr("looker_folder_access.test", "duplicate_grant_ids.#", "0"),
+					testAccCheckStored(srv, "looker_folder_access.test", lookertest.ContentMetadataAccess, "permission_type", "view"),
+				),
+			},
+			{
+				ResourceName:      "looker_folder_access.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccFolderAccessImportID("looker_folder_access.test"),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFolderAccessConfig("edit"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_folder_access.test", "access_level", "edit"),
+					testAccCheckStored(srv, "looker_folder_access.test", lookertest.ContentMetadataAccess, "permission_type", "edit"),
+				),
+			},
+		},
+	})
+}
+
+// testAccFolderAccessImportID returns the <folder_id>/<group_id> import ID of
+// resourceName.
+func testAccFolderAccessImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("%s not found in state", resourceName)
+		}
+		return rs.Primary.Attributes["folder_id"] + "/" + rs.Primary.Attributes["group_id"], nil
+	}
+}
+
+func testAccFolderAccessConfig(accessLevel string) string {
+	return fmt.Sprintf(`
+resource "looker_folder" "test" {
+  name                 = "tf-acc-test-folder"
+  parent_id            = %q
+  inherits_permissions = false
+}
+
+resource "looker_group" "test" {
+  name = "tf-acc-test-group"
+}
+
+resource "looker_folder_access" "test" {
+  folder_id    = looker_folder.test.id
+  group_id     = looker_group.test.id
+  access_level = %q
+}
+`, lookertest.SharedFolderID, accessLevel)
+}
+
+func TestFolderAccessResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	streamer := mockStreamer(t, client)
+	tr := newTestResource(t, &folderAccessResource{baseResource{client: client}})
+	values := map[string]any{
+		"folder_id":                        "20",
+		"group_id":                         "5",
+		"access_level":                     "view",
+		"folder_id_is_content_metadata_id": false,
+		"remove_on_expiry":                 false,
+		"notify":                           false,
+		"remove_duplicate_grants":          false,
+	}
+
+	gomock.InOrder(
+		api.EXPECT().Folder("20", "id,content_metadata_id", nil).Return(v4.Folder{Id: ptr("20"), ContentMetadataId: ptr("90")}, nil),
+		api.EXPECT().ContentMetadata("90", "inherits", nil).Return(v4.ContentMeta{Inherits: ptr(false)}, nil),
+		api.EXPECT().CreateContentMetadataAccess(v4.ContentMetaGroupUser{
+			ContentMetadataId: ptr("90"),
+			GroupId:           ptr("5"),
+			PermissionType:    ptr(v4.PermissionType_View),
+		}, false, nil).Return(v4.ContentMetaGroupUser{Id: ptr("51")}, nil),
+	)
+	state, diags := tr.create(values)
+	requireNoErrors(t, "Create", diags)
+	var id, contentMetadataID string
+	tr.get(state, "id", &id)
+	tr.get(state, "content_metadata_id", &contentMetadataID)
+	if id != "51" || contentMetadataID != "90" {
+		t.Errorf("id, content_metadata_id = %q, %q, want 51, 90", id, contentMetadataID)
+	}
+
+	// Group 5 also has a grant inherited from the parent folder 10.
+	listing := func(permissionType v4.PermissionType) []v4.ContentMetaGroupUser {
+		return []v4.ContentMetaGroupUser{
+			folderGrant("41", "10", "5", v4.PermissionType_View),
+			folderGrant("51", "90", "5", permissionType),
+			folderGrant("52", "90", "6", v4.PermissionType_Edit),
+		}
+	}
+	streamer.EXPECT().Stream(gomock.Any(), "/content_metadata_access", gomock.Any()).Return(jsonBody(t, listing(v4.PermissionType_Edit)), nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var accessLevel string
+	tr.get(state, "access_level", &accessLevel)
+	if accessLevel != "edit" {
+		t.Errorf("access_level = %q, want the grant changed outside Terraform", accessLevel)
+	}
+
+	values["id"] = "51"
+	gomock.InOrder(
+		streamer.EXPECT().Stream(gomock.Any(), "/content_metadata_access", gomock.Any()).Return(jsonBody(t, listing(v4.PermissionType_Edit)), nil),
+		api.EXPECT().UpdateContentMetadataAccess("51", v4.ContentMetaGroupUser{PermissionType: ptr(v4.PermissionType_View)}, nil).
+			Return(v4.ContentMetaGroupUser{}, nil),
+	)
+	state, diags = tr.update(state, values)
+	requireNoErrors(t, "Update", diags)
+
+	api.EXPECT().DeleteContentMetadataAccess("51", nil).Return("", nil)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestFolderAccessResourceReadNotFound(t *testing.T) {
+	client, _ := newMockClient(t)
+	tr := newTestResource(t, &folderAccessResource{baseResource{client: client}})
+
+	mockStreamer(t, client).EXPECT().Stream(gomock.Any(), "/content_metadata_access", gomock.Any()).
+		Return(jsonBody(t, []v4.ContentMetaGroupUser{folderGrant("52", "90", "6", v4.PermissionType_Edit)}), nil)
+	state, diags := tr.read(tr.state(map[string]any{
+		"id":                               "51",
+		"folder_id":                        "20",
+		"content_metadata_id":              "90",
+		"group_id":                         "5",
+		"access_level":                     "view",
+		"folder_id_is_content_metadata_id": false,
+	}))
+	requireNoErrors(t, "Read", diags)
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want removed", state.Raw)
+	}
+}
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,19 +18,22 @@ import (
 )
 
 var (
-	_ resource.Resource                = &folderPermissionOverrideResource{}
-	_ resource.ResourceWithConfigure   = &folderPermissionOverrideResource{}
-	_ resource.ResourceWithImportState = &folderPermissionOverrideResource{}
+	_ resource.Resource                 = &folderPermissionOverrideResource{}
+	_ resource.ResourceWithConfigure    = &folderPermissionOverrideResource{}
+	_ resource.ResourceWithImportState  = &folderPermissionOverrideResource{}
+	_ resource.ResourceWithModifyPlan   = &folderPermissionOverrideResource{}
+	_ resource.ResourceWithUpgradeState = &folderPermissionOverrideResource{}
 )
 
 type folderPermissionOverrideResource struct {
-	sdk *v4.LookerSDK
+	baseResource
 }
 type folderPermissionOverrideResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	FolderID    types.String `tfsdk:"folder_id"`
-	GroupID     types.String `tfsdk:"group_id"`
-	AccessLevel types.String `tfsdk:"access_level"`
+	ID          types.String   `tfsdk:"id"`
+	FolderID    types.String   `tfsdk:"folder_id"`
+	GroupID     types.String   `tfsdk:"group_id"`
+	AccessLevel types.String   `tfsdk:"access_level"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewFolderPermissionOverrideResource() resource.Resource {
@@ -39,76 +44,167 @@ func (r *folderPermissionOverrideResource) Metadata(_ context.Context, req resou
 	resp.TypeName = req.ProviderTypeName + "_folder_permission_override"
 }
 
-func (r *folderPermissionOverrideResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *folderPermissionOverrideResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a folder permission override. This resource finds an existing, inherited access grant for a group on a folder and updates it to a new, direct access level (e.g., from inherited 'view' to direct 'edit').",
+		MarkdownDescription: "Manages a folder permission override. This resource finds an existing, inherited access grant for a group on a folder and updates it to a new, direct access level (e.g., from inherited 'view' to direct 'edit'). Destroying the resource restores the access level the grant had before.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts":  timeoutsAttribute(ctx),
 			"id":        schema.StringAttribute{Description: "The unique ID of the access grant that was updated.", Computed: true, PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()}},
 			"folder_id": schema.StringAttribute{Description: "The ID of the folder (content_metadata_id) whose permissions will be overridden.", Required: true},
 			"group_id":  schema.StringAttribute{Description: "The ID of the group whose inherited permission will be overridden.", Required: true},
 			"access_level": schema.StringAttribute{
-				Description: "The new, direct access level to set. Valid values are: `view` or `edit`.",
+				Description: "The new, direct access level to set. " + accessLevelDescription,
 				Required:    true,
-				Validators:  []validator.String{stringvalidator.OneOf("view", "edit")},
+				Validators:  []validator.String{stringvalidator.OneOf(accessLevelValues...)},
 			},
 		},
 	}
 }
 
-func (r *folderPermissionOverrideResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if cb, ok := req.ProviderData.(*clientBundle); ok && cb.SDK != nil {
-		r.sdk = cb.SDK
+// ModifyPlan rejects overrides of grants also managed by another folder access
+// resource.
+func (r *folderPermissionOverrideResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
 	}
-}
 
-func (r *folderPermissionOverrideResource) findAccessGrant(_ context.Context, folderID, groupID string) (*v4.ContentMetaGroupUser, error) {
-	results, err := r.sdk.AllContentMetadataAccesses(folderID, "", nil)
-	if err != nil {
-		return nil, fmt.Errorf("API error searching for access grants on folder %s: %w", folderID, err)
+	var plan folderPermissionOverrideResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	for _, grant := range results {
-		if grant.GroupId != nil && *grant.GroupId == groupID {
-			return &grant, nil
+	if plan.FolderID.IsUnknown() || plan.GroupID.IsUnknown() {
+		return
+	}
+	claimant := folderAccessClaimant{resourceType: "looker_folder_permission_override", folderID: plan.FolderID.ValueString()}
+	if !req.State.Raw.IsNull() {
+		var state folderPermissionOverrideResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
+		claimant.id = state.ID.ValueString()
 	}
-	return nil, nil // Not found
+	claimFolderAccess(r.client, claimant, plan.FolderID.ValueString(), plan.GroupID.ValueString(), &resp.Diagnostics)
 }
 
-func (r *folderPermissionOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan folderPermissionOverrideResourceModel
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+func (r *folderPermissionOverrideResource) findAccessGrant(ctx context.Context, folderID, groupID string) (*v4.ContentMetaGroupUser, error) {
+	var found *v4.ContentMetaGroupUser
+	err := forEachContentAccess(ctx, r.client, folderID, func(grant v4.ContentMetaGroupUser) error {
+		if found == nil && grant.GroupId != nil && *grant.GroupId == groupID {
+			found = &grant
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("API error searching for access grants on folder %s: %w", folderID, err)
 	}
+	return found, nil // nil when not found
+}
+
+// priorGrantKey is the private state key holding the grant as it was before
+// the override, so that Delete can restore it.
+const priorGrantKey = "prior_grant"
 
+// priorGrant is the grant captured before the override.
+type priorGrant struct {
+	GrantID        string `json:"grant_id"`
+	PermissionType string `json:"permission_type"`
+}
+
+// override sets the grant of the group on the folder to the planned access
+// level and returns the grant as it was before.
+func (r *folderPermissionOverrideResource) override(ctx context.Context, plan *folderPermissionOverrideResourceModel) (*priorGrant, error) {
 	folderID := plan.FolderID.ValueString()
 	groupID := plan.GroupID.ValueString()
 
 	grant, err := r.findAccessGrant(ctx, folderID, groupID)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error on Find", err.Error())
-		return
+		return nil, err
 	}
 	if grant == nil {
-		resp.Diagnostics.AddError("Cannot Override Permission", fmt.Sprintf("No inherited permission found for group %s on folder %s to override. The group must have parent access first.", groupID, folderID))
+		return nil, fmt.Errorf("no inherited permission found for group %s on folder %s to override. The group must have parent access first", groupID, folderID)
+	}
+	prior := &priorGrant{GrantID: *grant.Id}
+	if grant.PermissionType != nil {
+		prior.PermissionType = string(*grant.PermissionType)
+	}
+
+	permissionType := permissionTypeFor(plan.AccessLevel.ValueString())
+	updatedGrant, err := r.client.SDK(ctx).UpdateContentMetadataAccess(*grant.Id, v4.ContentMetaGroupUser{PermissionType: &permissionType}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update folder access grant %s: %w", *grant.Id, err)
+	}
+
+	plan.ID = types.StringPointerValue(updatedGrant.Id)
+	return prior, nil
+}
+
+// revert restores the grant captured before the override. Without a captured
+// grant, e.g. for overrides created by older provider versions, the folder is
+// left as it is.
+func (r *folderPermissionOverrideResource) revert(ctx context.Context, private []byte) error {
+	if len(private) == 0 {
+		tflog.Warn(ctx, "The permission in place before this looker_folder_permission_override was not recorded, leaving the folder unchanged.")
+		return nil
+	}
+	var prior priorGrant
+	if err := json.Unmarshal(private, &prior); err != nil {
+		return fmt.Errorf("invalid private state: %w", err)
+	}
+	if prior.PermissionType == "" {
+		return nil
+	}
+
+	permissionType := v4.PermissionType(prior.PermissionType)
+	_, err := r.client.SDK(ctx).UpdateContentMetadataAccess(prior.GrantID, v4.ContentMetaGroupUser{PermissionType: &permissionType}, nil)
+	if isNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to restore folder access grant %s to %s: %w", prior.GrantID, prior.PermissionType, err)
+	}
+	return nil
+}
+
+func (r *folderPermissionOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
-	accessLevelString := plan.AccessLevel.ValueString()
-	permissionType := v4.PermissionType(accessLevelString)
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
 
-	updatedGrant, err := r.sdk.UpdateContentMetadataAccess(*grant.Id, v4.ContentMetaGroupUser{PermissionType: &permissionType}, nil)
+	var plan folderPermissionOverrideResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prior, err := r.override(ctx, &plan)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error on Update", fmt.Sprintf("Failed to update folder access grant %s: %v", *grant.Id, err))
+		resp.Diagnostics.AddError("Cannot Override Permission", err.Error())
 		return
 	}
 
-	plan.ID = types.StringPointerValue(updatedGrant.Id)
+	data, err := json.Marshal(prior)
+	if err != nil {
+		resp.Diagnostics.AddError("Internal error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, priorGrantKey, data)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (r *folderPermissionOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
 	var state folderPermissionOverrideResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -121,7 +217,7 @@ func (r *folderPermissionOverrideResource) Read(ctx context.Context, req resourc
 		resp.Diagnostics.AddError("Read error", err.Error())
 		return
 	}
-	if grant == nil || grant.PermissionType == nil || string(*grant.PermissionType) != state.AccessLevel.ValueString() {
+	if grant == nil || grant.PermissionType == nil || *grant.PermissionType != permissionTypeFor(state.AccessLevel.ValueString()) {
 		tflog.Warn(ctx, "Permission override no longer exists or has been changed externally. Will re-apply on next run.")
 		resp.State.RemoveResource(ctx)
 		return
@@ -132,6 +228,13 @@ func (r *folderPermissionOverrideResource) Read(ctx context.Context, req resourc
 }
 
 func (r *folderPermissionOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
 	var plan, state folderPermissionOverrideResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -139,12 +242,56 @@ func (r *folderPermissionOverrideResource) Update(ctx context.Context, req resou
 		return
 	}
 
-	// Re-use the Create logic as "Update" means finding and updating the inherited grant.
-	r.Create(ctx, resource.CreateRequest{Plan: req.Plan}, &resource.CreateResponse{State: resp.State, Diagnostics: resp.Diagnostics})
+	// Moving the override to another folder or group restores the previous
+	// grant first. Otherwise the grant captured at create stays the one to
+	// restore on delete.
+	moved := !plan.FolderID.Equal(state.FolderID) || !plan.GroupID.Equal(state.GroupID)
+	if moved {
+		private, diags := req.Private.GetKey(ctx, priorGrantKey)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.revert(ctx, private); err != nil {
+			resp.Diagnostics.AddError("Cannot Revert Permission", err.Error())
+			return
+		}
+	}
+
+	prior, err := r.override(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Cannot Override Permission", err.Error())
+		return
+	}
+
+	if moved {
+		data, err := json.Marshal(prior)
+		if err != nil {
+			resp.Diagnostics.AddError("Internal error", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, priorGrantKey, data)...)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
+// Delete restores the grant to the access level it had before the override.
 func (r *folderPermissionOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	tflog.Warn(ctx, "Deleting a 'looker_folder_permission_override' does not automatically revert the folder to inherited permissions. Please manage permissions in the Looker UI if reversion is needed.")
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
+	private, diags := req.Private.GetKey(ctx, priorGrantKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.revert(ctx, private); err != nil {
+		resp.Diagnostics.AddError("Cannot Revert Permission", err.Error())
+	}
 }
 
 func (r *folderPermissionOverrideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+// Create and Delete keep the prior grant in private state, which only the
+// framework can set up, so they are tested through override and revert.
+func TestFolderPermissionOverrideResourceCRUD(t *testing.T) {
+	ctx := context.Background()
+	client, api := newMockClient(t)
+	streamer := mockStreamer(t, client)
+	r := &folderPermissionOverrideResource{baseResource{client: client}}
+	tr := newTestResource(t, r)
+
+	gomock.InOrder(
+		expectContentAccess(t, streamer, "20", folderGrant("51", "10", "5", v4.PermissionType_View)),
+		api.EXPECT().UpdateContentMetadataAccess("51", v4.ContentMetaGroupUser{PermissionType: ptr(v4.PermissionType_Edit)}, nil).
+			Return(v4.ContentMetaGroupUser{Id: ptr("51")}, nil),
+	)
+	plan := folderPermissionOverrideResourceModel{FolderID: types.StringValue("20"), GroupID: types.StringValue("5"), AccessLevel: types.StringValue("edit")}
+	prior, err := r.override(ctx, &plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (priorGrant{GrantID: "51", PermissionType: "view"}); *prior != want || plan.ID.ValueString() != "51" {
+		t.Errorf("prior grant, id = %+v, %s, want %+v, 51", *prior, plan.ID, want)
+	}
+
+	state := tr.state(map[string]any{"id": "51", "folder_id": "20", "group_id": "5", "access_level": "edit"})
+	expectContentAccess(t, streamer, "20", folderGrant("51", "10", "5", v4.PermissionType_Edit))
+	state, diags := tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	if state.Raw.IsNull() {
+		t.Fatal("Read removed the override")
+	}
+
+	// Changing the access level in place keeps the prior grant to restore.
+	gomock.InOrder(
+		expectContentAccess(t, streamer, "20", folderGrant("51", "10", "5", v4.PermissionType_Edit)),
+		api.EXPECT().UpdateContentMetadataAccess("51", v4.ContentMetaGroupUser{PermissionType: ptr(v4.PermissionType_View)}, nil).
+			Return(v4.ContentMetaGroupUser{Id: ptr("51")}, nil),
+	)
+	state, diags = tr.update(state, map[string]any{"id": "51", "folder_id": "20", "group_id": "5", "access_level": "view"})
+	requireNoErrors(t, "Update", diags)
+
+	api.EXPECT().UpdateContentMetadataAccess("51", v4.ContentMetaGroupUser{PermissionType: ptr(v4.PermissionType_View)}, nil).
+		Return(v4.ContentMetaGroupUser{}, nil)
+	private, err := json.Marshal(prior)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.revert(ctx, private); err != nil {
+		t.Fatal(err)
+	}
+
+	// Without a recorded prior grant the folder is left as it is.
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestFolderPermissionOverrideResourceReadNotFound(t *testing.T) {
+	tests := map[string][]v4.ContentMetaGroupUser{
+		"grant deleted":             {folderGrant("61", "10", "6", v4.PermissionType_View)},
+		"changed outside Terraform": {folderGrant("51", "10", "5", v4.PermissionType_View)},
+	}
+	for name, grants := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, _ := newMockClient(t)
+			tr := newTestResource(t, &folderPermissionOverrideResource{baseResource{client: client}})
+
+			expectContentAccess(t, mockStreamer(t, client), "20", grants...)
+			state, diags := tr.read(tr.state(map[string]any{"id": "51", "folder_id": "20", "group_id": "5", "access_level": "edit"}))
+			requireNoErrors(t, "Read", diags)
+			if !state.Raw.IsNull() {
+				t.Errorf("state = %v, want removed", state.Raw)
+			}
+		})
+	}
+}
@@ -0,0 +1,401 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var (
+	_ resource.Resource                 = &folderPermissionsResource{}
+	_ resource.ResourceWithConfigure    = &folderPermissionsResource{}
+	_ resource.ResourceWithImportState  = &folderPermissionsResource{}
+	_ resource.ResourceWithModifyPlan   = &folderPermissionsResource{}
+	_ resource.ResourceWithUpgradeState = &folderPermissionsResource{}
+)
+
+// folderPermissionObjectType is the object type of an entry in `grants`.
+var folderPermissionObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"group_id":     types.StringType,
+	"user_id":      types.StringType,
+	"access_level": types.StringType,
+}}
+
+// folderPermissionsResource is the resource implementation.
+type folderPermissionsResource struct {
+	baseResource
+}
+
+// folderPermissionsResourceModel maps the resource schema data.
+type folderPermissionsResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	FolderID types.String   `tfsdk:"folder_id"`
+	Grants   types.Set      `tfsdk:"grants"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// folderPermissionModel maps an entry of `grants`.
+type folderPermissionModel struct {
+	GroupID     types.String `tfsdk:"group_id"`
+	UserID      types.String `tfsdk:"user_id"`
+	AccessLevel types.String `tfsdk:"access_level"`
+}
+
+// principal identifies the group or user of a grant, e.g. "group:12".
+func (g folderPermissionModel) principal() string {
+	if !g.GroupID.IsNull() {
+		return "group:" + g.GroupID.ValueString()
+	}
+	return "user:" + g.UserID.ValueString()
+}
+
+// grantPrincipal identifies the group or user of a grant read from the API,
+// or returns "" for grants of neither.
+func grantPrincipal(grant v4.ContentMetaGroupUser) string {
+	switch {
+	case grant.GroupId != nil && *grant.GroupId != "":
+		return "group:" + *grant.GroupId
+	case grant.UserId != nil && *grant.UserId != "":
+		return "user:" + *grant.UserId
+	}
+	return ""
+}
+
+// NewFolderPermissionsResource is a helper function to simplify the provider implementation.
+func NewFolderPermissionsResource() resource.Resource {
+	return &folderPermissionsResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *folderPermissionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder_permissions"
+}
+
+// Schema defines the schema for the resource.
+func (r *folderPermissionsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exclusively manages every access grant, of groups and of users, set directly on a Looker folder (space). Grants added outside Terraform, e.g. in the sharing dialog, show up as a diff and are removed on apply. The folder must not inherit permissions from its parent. Can be imported with the folder's `content_metadata_id`.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "The content_metadata_id of the folder.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"folder_id": schema.StringAttribute{
+				Description: "The ID of the folder (content_metadata_id) whose grants are managed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grants": schema.SetNestedAttribute{
+				Description: "The complete list of grants on the folder. An empty list removes every grant.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_id": schema.StringAttribute{
+							Description: "The group granted access. Exactly one of group_id or user_id must be set.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("user_id")),
+							},
+						},
+						"user_id": schema.StringAttribute{
+							Description: "The user granted access.",
+							Optional:    true,
+						},
+						"access_level": schema.StringAttribute{
+							Description: "The access level granted. " + accessLevelDescription,
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(accessLevelValues...),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan rejects a folder whose grants are also managed by another folder
+// access resource.
+func (r *folderPermissionsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan folderPermissionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.FolderID.IsUnknown() {
+		return
+	}
+	claimant := folderAccessClaimant{resourceType: "looker_folder_permissions", folderID: plan.FolderID.ValueString()}
+	if !req.State.Raw.IsNull() {
+		var state folderPermissionsResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || !state.FolderID.Equal(plan.FolderID) {
+			return // replaced, and claimed when planned again as a new resource
+		}
+		claimant.id = state.ID.ValueString()
+	}
+	claimFolderAccess(r.client, claimant, plan.FolderID.ValueString(), wholeFolder, &resp.Diagnostics)
+}
+
+// desiredGrants returns the planned grants keyed by principal.
+func desiredGrants(ctx context.Context, grants types.Set) (map[string]folderPermissionModel, diag.Diagnostics) {
+	var list []folderPermissionModel
+	diags := grants.ElementsAs(ctx, &list, false)
+	desired := make(map[string]folderPermissionModel, len(list))
+	for _, grant := range list {
+		if _, ok := desired[grant.principal()]; ok {
+			diags.AddAttributeError(path.Root("grants"), "Duplicate folder grant",
+				fmt.Sprintf("%s is granted access more than once.", grant.principal()))
+			continue
+		}
+		desired[grant.principal()] = grant
+	}
+	return desired, diags
+}
+
+// directGrants returns the grants set on the folder itself, keyed by
+// principal. Grants inherited from an ancestor are left out.
+func directGrants(ctx context.Context, client *clientBundle, folderID string) (map[string]v4.ContentMetaGroupUser, error) {
+	grants := make(map[string]v4.ContentMetaGroupUser)
+	err := forEachContentAccess(ctx, client, folderID, func(grant v4.ContentMetaGroupUser) error {
+		if grant.ContentMetadataId != nil && *grant.ContentMetadataId != folderID {
+			return nil
+		}
+		if principal := grantPrincipal(grant); principal != "" && grant.Id != nil {
+			grants[principal] = grant
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("API error listing access grants on folder %s: %w", folderID, err)
+	}
+	return grants, nil
+}
+
+// reconcile makes the direct grants on the folder match desired exactly.
+func (r *folderPermissionsResource) reconcile(ctx context.Context, folderID string, desired map[string]folderPermissionModel) error {
+	current, err := directGrants(ctx, r.client, folderID)
+	if err != nil {
+		return err
+	}
+	sdk := r.client.SDK(ctx)
+
+	principals := make([]string, 0, len(desired))
+	for principal := range desired {
+		principals = append(principals, principal)
+	}
+	sort.Strings(principals)
+	for _, principal := range principals {
+		want := desired[principal]
+		permissionType := permissionTypeFor(want.AccessLevel.ValueString())
+		grant, ok := current[principal]
+		if !ok {
+			_, err := sdk.CreateContentMetadataAccess(
+				v4.ContentMetaGroupUser{
+					ContentMetadataId: &folderID,
+					GroupId:           want.GroupID.ValueStringPointer(),
+					UserId:            want.UserID.ValueStringPointer(),
+					PermissionType:    &permissionType,
+				},
+				false, // sendBoardsNotificationEmail
+				nil,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to grant %s access to %s on folder %s: %w", want.AccessLevel.ValueString(), principal, folderID, err)
+			}
+			continue
+		}
+		if grant.PermissionType == nil || *grant.PermissionType != permissionType {
+			_, err := sdk.UpdateContentMetadataAccess(*grant.Id, v4.ContentMetaGroupUser{PermissionType: &permissionType}, nil)
+			if err != nil {
+				return fmt.Errorf("failed to update access grant %s on folder %s: %w", *grant.Id, folderID, err)
+			}
+		}
+	}
+
+	for principal, grant := range current {
+		if _, ok := desired[principal]; ok {
+			continue
+		}
+		if _, err := sdk.DeleteContentMetadataAccess(*grant.Id, nil); err != nil && !isNotFound(err) {
+			return fmt.Errorf("failed to remove access grant %s for %s on folder %s: %w", *grant.Id, principal, folderID, err)
+		}
+		tflog.Info(ctx, fmt.Sprintf("Removed unmanaged access grant of %s on folder %s", principal, folderID))
+	}
+	return nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *folderPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan folderPermissionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := desiredGrants(ctx, plan.Grants)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.reconcile(ctx, plan.FolderID.ValueString(), desired); err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+
+	plan.ID = plan.FolderID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *folderPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state folderPermissionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	folderID := state.FolderID.ValueString()
+
+	current, err := directGrants(ctx, r.client, folderID)
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Folder %s not found, removing from state", folderID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+
+	configured := map[string]folderPermissionModel{}
+	if !state.Grants.IsNull() && !state.Grants.IsUnknown() {
+		var diags diag.Diagnostics
+		configured, diags = desiredGrants(ctx, state.Grants)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	grants := make([]folderPermissionModel, 0, len(current))
+	for principal, grant := range current {
+		if grant.PermissionType == nil {
+			continue
+		}
+		item := folderPermissionModel{
+			GroupID:     types.StringNull(),
+			UserID:      types.StringNull(),
+			AccessLevel: types.StringValue(accessLevelFor(*grant.PermissionType, configured[principal].AccessLevel.ValueString())),
+		}
+		if grant.GroupId != nil && *grant.GroupId != "" {
+			item.GroupID = types.StringPointerValue(grant.GroupId)
+		} else {
+			item.UserID = types.StringPointerValue(grant.UserId)
+		}
+		grants = append(grants, item)
+	}
+	grantsSet, diags := types.SetValueFrom(ctx, folderPermissionObjectType, grants)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Grants = grantsSet
+	state.ID = state.FolderID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *folderPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
+	var plan folderPermissionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := desiredGrants(ctx, plan.Grants)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.reconcile(ctx, plan.FolderID.ValueString(), desired); err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+
+	plan.ID = plan.FolderID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes every direct grant from the folder.
+func (r *folderPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
+	var state folderPermissionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.reconcile(ctx, state.FolderID.ValueString(), map[string]folderPermissionModel{})
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API error", err.Error())
+	}
+}
+
+// ImportState imports every direct grant of a folder using its content_metadata_id.
+func (r *folderPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("folder_id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+// groupPermission and userPermission return a grant of the grants attribute.
+func groupPermission(groupID, accessLevel string) folderPermissionModel {
+	return folderPermissionModel{GroupID: types.StringValue(groupID), UserID: types.StringNull(), AccessLevel: types.StringValue(accessLevel)}
+}
+
+func userPermission(userID, accessLevel string) folderPermissionModel {
+	return folderPermissionModel{GroupID: types.StringNull(), UserID: types.StringValue(userID), AccessLevel: types.StringValue(accessLevel)}
+}
+
+func TestFolderPermissionsResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	streamer := mockStreamer(t, client)
+	tr := newTestResource(t, &folderPermissionsResource{baseResource{client: client}})
+	userGrant := v4.ContentMetaGroupUser{Id: ptr("52"), ContentMetadataId: ptr("20"), UserId: ptr("8"), PermissionType: ptr(v4.PermissionType_View)}
+
+	// The grant inherited from folder 10 is left alone, the unmanaged
+	// direct grant of group 7 is removed.
+	gomock.InOrder(
+		expectContentAccess(t, streamer, "20",
+			folderGrant("41", "10", "6", v4.PermissionType_View),
+			folderGrant("70", "20", "7", v4.PermissionType_Edit),
+		),
+		api.EXPECT().CreateContentMetadataAccess(v4.ContentMetaGroupUser{
+			ContentMetadataId: ptr("20"),
+			GroupId:           ptr("5"),
+			PermissionType:    ptr(v4.PermissionType_Edit),
+		}, false, nil).Return(v4.ContentMetaGroupUser{}, nil),
+		api.EXPECT().CreateContentMetadataAccess(v4.ContentMetaGroupUser{
+			ContentMetadataId: ptr("20"),
+			UserId:            ptr("8"),
+			PermissionType:    ptr(v4.PermissionType_View),
+		}, false, nil).Return(v4.ContentMetaGroupUser{}, nil),
+		api.EXPECT().DeleteContentMetadataAccess("70", nil).Return("", nil),
+	)
+	grants := []folderPermissionModel{groupPermission("5", "edit_content"), userPermission("8", "view")}
+	state, diags := tr.create(map[string]any{"folder_id": "20", "grants": grants})
+	requireNoErrors(t, "Create", diags)
+
+	expectContentAccess(t, streamer, "20",
+		folderGrant("41", "10", "6", v4.PermissionType_View),
+		folderGrant("51", "20", "5", v4.PermissionType_Edit),
+		userGrant,
+	)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var read []folderPermissionModel
+	tr.get(state, "grants", &read)
+	if len(read) != 2 {
+		t.Fatalf("grants = %v, want the 2 direct grants", read)
+	}
+	for _, grant := range read {
+		if !grant.GroupID.IsNull() && grant.AccessLevel.ValueString() != "edit_content" {
+			t.Errorf("access_level of group 5 = %s, want the configured edit_content", grant.AccessLevel)
+		}
+	}
+
+	gomock.InOrder(
+		expectContentAccess(t, streamer, "20", folderGrant("51", "20", "5", v4.PermissionType_Edit), userGrant),
+		api.EXPECT().UpdateContentMetadataAccess("51", v4.ContentMetaGroupUser{PermissionType: ptr(v4.PermissionType_View)}, nil).
+			Return(v4.ContentMetaGroupUser{}, nil),
+		api.EXPECT().DeleteContentMetadataAccess("52", nil).Return("", nil),
+	)
+	state, diags = tr.update(state, map[string]any{"id": "20", "folder_id": "20", "grants": []folderPermissionModel{groupPermission("5", "view")}})
+	requireNoErrors(t, "Update", diags)
+
+	gomock.InOrder(
+		expectContentAccess(t, streamer, "20", folderGrant("51", "20", "5", v4.PermissionType_View)),
+		api.EXPECT().DeleteContentMetadataAccess("51", nil).Return("", nil),
+	)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestFolderPermissionsResourceReadNotFound(t *testing.T) {
+	client, _ := newMockClient(t)
+	tr := newTestResource(t, &folderPermissionsResource{baseResource{client: client}})
+
+	mockStreamer(t, client).EXPECT().Stream(gomock.Any(), "/content_metadata_access", gomock.Any()).Return(nil, errNotFound)
+	state, diags := tr.read(tr.state(map[string]any{"id": "20", "folder_id": "20", "grants": []folderPermissionModel{groupPermission("5", "view")}}))
+	requireNoErrors(t, "Read", diags)
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want removed", state.Raw)
+	}
+}
@@ -0,0 +1,414 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var (
+	_ resource.Resource                 = &folderTreeResource{}
+	_ resource.ResourceWithConfigure    = &folderTreeResource{}
+	_ resource.ResourceWithUpgradeState = &folderTreeResource{}
+)
+
+// folderTreePathPattern matches a slash-separated folder path without empty
+// segments, e.g. "Sales/EMEA".
+var folderTreePathPattern = regexp.MustCompile(`^[^/]+(/[^/]+)*$`)
+
+// folderTreeEntryType is the object type of an entry in `folders`.
+var folderTreeEntryType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":                  types.StringType,
+	"content_metadata_id": types.StringType,
+}}
+
+// folderTreeResource is the resource implementation.
+type folderTreeResource struct {
+	baseResource
+}
+
+// folderTreeResourceModel maps the resource schema data.
+type folderTreeResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	ParentID       types.String   `tfsdk:"parent_id"`
+	Paths          types.Set      `tfsdk:"paths"`
+	DeleteContents types.Bool     `tfsdk:"delete_contents"`
+	Folders        types.Map      `tfsdk:"folders"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// folderTreeEntry maps an entry of `folders`.
+type folderTreeEntry struct {
+	ID                types.String `tfsdk:"id"`
+	ContentMetadataID types.String `tfsdk:"content_metadata_id"`
+}
+
+// NewFolderTreeResource is a helper function to simplify the provider implementation.
+func NewFolderTreeResource() resource.Resource {
+	return &folderTreeResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *folderTreeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder_tree"
+}
+
+// Schema defines the schema for the resource.
+func (r *folderTreeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a tree of Looker folders under a parent folder, given as slash-separated paths such as `Sales/EMEA/UK`. Every folder along a path is created, parents before children, so a whole hierarchy needs neither one resource per folder nor `depends_on` chains.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "The ID of the parent folder.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "The ID of the folder the tree is created under.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"paths": schema.SetAttribute{
+				Description: "Slash-separated paths of the folders, relative to parent_id. Intermediate folders are implied: `Sales/EMEA` also manages `Sales`. Folder names cannot contain `/`.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(folderTreePathPattern, "must be a slash-separated path without empty segments")),
+				},
+			},
+			"delete_contents": schema.BoolAttribute{
+				Description: "If true, folders removed from the tree are deleted together with their dashboards and looks. If false, removing a folder that is not empty fails. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"folders": schema.MapNestedAttribute{
+				Description: "The folders of the tree keyed by path, including intermediate folders.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the folder.",
+							Computed:    true,
+						},
+						"content_metadata_id": schema.StringAttribute{
+							Description: "The content_metadata_id of the folder, used for access grants.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandFolderPaths returns every folder implied by paths, parents before
+// children.
+func expandFolderPaths(paths []string) []string {
+	seen := map[string]bool{}
+	var expanded []string
+	for _, p := range paths {
+		segments := strings.Split(p, "/")
+		for i := range segments {
+			prefix := strings.Join(segments[:i+1], "/")
+			if !seen[prefix] {
+				seen[prefix] = true
+				expanded = append(expanded, prefix)
+			}
+		}
+	}
+	sort.Slice(expanded, func(i, j int) bool {
+		di, dj := strings.Count(expanded[i], "/"), strings.Count(expanded[j], "/")
+		if di != dj {
+			return di < dj
+		}
+		return expanded[i] < expanded[j]
+	})
+	return expanded
+}
+
+// folderTreeName splits a path into the path of its parent, "" at the top, and
+// the folder name.
+func folderTreeName(p string) (string, string) {
+	if i := strings.LastIndex(p, "/"); i >= 0 {
+		return p[:i], p[i+1:]
+	}
+	return "", p
+}
+
+// folders reads `folders` of the model.
+func (m *folderTreeResourceModel) folders(ctx context.Context) (map[string]folderTreeEntry, diag.Diagnostics) {
+	folders := map[string]folderTreeEntry{}
+	if m.Folders.IsNull() || m.Folders.IsUnknown() {
+		return folders, nil
+	}
+	diags := m.Folders.ElementsAs(ctx, &folders, false)
+	return folders, diags
+}
+
+// sync creates the folders of plan missing from existing and deletes the
+// folders of existing no longer in plan, deepest first. The resulting folders
+// are stored in plan even on error, so that nothing created is lost.
+func (r *folderTreeResource) sync(ctx context.Context, plan *folderTreeResourceModel, existing map[string]folderTreeEntry) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var paths []string
+	diags.Append(plan.Paths.ElementsAs(ctx, &paths, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	wanted := expandFolderPaths(paths)
+
+	folders := make(map[string]folderTreeEntry, len(existing))
+	for p, entry := range existing {
+		folders[p] = entry
+	}
+	defer func() {
+		value, d := types.MapValueFrom(ctx, folderTreeEntryType, folders)
+		diags.Append(d...)
+		plan.Folders = value
+		plan.ID = plan.ParentID
+	}()
+
+	for _, p := range wanted {
+		if _, ok := folders[p]; ok {
+			continue
+		}
+		parentPath, name := folderTreeName(p)
+		parentID := plan.ParentID.ValueString()
+		if parentPath != "" {
+			parentID = folders[parentPath].ID.ValueString()
+		}
+		folder, err := createFolder(ctx, r.client, v4.CreateFolder{Name: name, ParentId: parentID})
+		if err != nil {
+			diags.AddError("API error on CreateFolder", fmt.Sprintf("Failed to create folder %q: %v", p, err))
+			return diags
+		}
+		folders[p] = folderTreeEntry{
+			ID:                types.StringPointerValue(folder.Id),
+			ContentMetadataID: types.StringPointerValue(folder.ContentMetadataId),
+		}
+	}
+
+	keep := map[string]bool{}
+	for _, p := range wanted {
+		keep[p] = true
+	}
+	var removed []string
+	for p := range folders {
+		if !keep[p] {
+			removed = append(removed, p)
+		}
+	}
+	diags.Append(r.deleteFolders(ctx, plan.DeleteContents.ValueBool(), removed, folders)...)
+	return diags
+}
+
+// deleteFolders deletes the folders at paths, deepest first, and removes them
+// from folders.
+func (r *folderTreeResource) deleteFolders(ctx context.Context, deleteContents bool, paths []string, folders map[string]folderTreeEntry) diag.Diagnostics {
+	var diags diag.Diagnostics
+	sort.Slice(paths, func(i, j int) bool {
+		di, dj := strings.Count(paths[i], "/"), strings.Count(paths[j], "/")
+		if di != dj {
+			return di > dj
+		}
+		return paths[i] > paths[j]
+	})
+
+	sdk := r.client.SDK(ctx)
+	for _, p := range paths {
+		folderID := folders[p].ID.ValueString()
+		if !deleteContents {
+			contents, err := folderContents(sdk, folderID)
+			if err != nil && !isNotFound(err) {
+				diags.AddError("API error on folder contents", err.Error())
+				return diags
+			}
+			if contents != "" {
+				diags.AddError("Folder is not empty",
+					fmt.Sprintf("Folder %q (%s) still contains %s. Move or delete them, or set delete_contents to true and apply.", p, folderID, contents))
+				return diags
+			}
+		}
+		if _, err := sdk.DeleteFolder(folderID, nil); err != nil && !isNotFound(err) {
+			diags.AddError("API error on DeleteFolder", fmt.Sprintf("Failed to delete folder %q (%s): %v", p, folderID, err))
+			return diags
+		}
+		delete(folders, p)
+	}
+	return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *folderTreeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan folderTreeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.sync(ctx, &plan, map[string]folderTreeEntry{})...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data. Folders deleted
+// outside Terraform are dropped together with the paths below them, so that
+// the next apply recreates them.
+func (r *folderTreeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state folderTreeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	folders, diags := state.folders(ctx)
+	resp.Diagnostics.Append(diags...)
+	var paths []string
+	resp.Diagnostics.Append(state.Paths.ElementsAs(ctx, &paths, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sdk := r.client.SDK(ctx)
+	missing := map[string]bool{}
+	for p, entry := range folders {
+		folder, err := sdk.Folder(entry.ID.ValueString(), "id,content_metadata_id", nil)
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Folder %q (%s) not found, it will be recreated", p, entry.ID.ValueString()))
+			missing[p] = true
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read folder %q (%s): %v", p, entry.ID.ValueString(), err))
+			return
+		}
+		entry.ContentMetadataID = types.StringPointerValue(folder.ContentMetadataId)
+		folders[p] = entry
+	}
+
+	// A folder is gone when it or any of its ancestors is.
+	gone := func(p string) bool {
+		for {
+			if missing[p] {
+				return true
+			}
+			parent, _ := folderTreeName(p)
+			if parent == "" {
+				return false
+			}
+			p = parent
+		}
+	}
+	for p := range folders {
+		if gone(p) {
+			delete(folders, p)
+		}
+	}
+	kept := make([]string, 0, len(paths))
+	for _, p := range paths {
+		if !gone(p) {
+			kept = append(kept, p)
+		}
+	}
+
+	state.Paths, diags = types.SetValueFrom(ctx, types.StringType, kept)
+	resp.Diagnostics.Append(diags...)
+	state.Folders, diags = types.MapValueFrom(ctx, folderTreeEntryType, folders)
+	resp.Diagnostics.Append(diags...)
+	if state.DeleteContents.IsNull() {
+		state.DeleteContents = types.BoolValue(false)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *folderTreeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
+	var plan, state folderTreeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	existing, diags := state.folders(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.sync(ctx, &plan, existing)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes every folder of the tree, deepest first.
+func (r *folderTreeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
+	var state folderTreeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	folders, diags := state.folders(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	paths := make([]string, 0, len(folders))
+	for p := range folders {
+		paths = append(paths, p)
+	}
+	resp.Diagnostics.Append(r.deleteFolders(ctx, state.DeleteContents.ValueBool(), paths, folders)...)
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFolderTreeResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &folderTreeResource{baseResource{client: client}})
+
+	gomock.InOrder(
+		api.EXPECT().CreateFolder(v4.CreateFolder{Name: "Sales", ParentId: "1"}, nil).
+			Return(v4.Folder{Id: ptr("100"), ContentMetadataId: ptr("200")}, nil),
+		api.EXPECT().CreateFolder(v4.CreateFolder{Name: "EMEA", ParentId: "100"}, nil).
+			Return(v4.Folder{Id: ptr("101"), ContentMetadataId: ptr("201")}, nil),
+	)
+	state, diags := tr.create(map[string]any{"parent_id": "1", "paths": []string{"Sales/EMEA"}, "delete_contents": false})
+	requireNoErrors(t, "Create", diags)
+	var folders map[string]folderTreeEntry
+	tr.get(state, "folders", &folders)
+	if len(folders) != 2 || folders["Sales/EMEA"].ID.ValueString() != "101" {
+		t.Errorf("folders = %v, want Sales and Sales/EMEA", folders)
+	}
+
+	api.EXPECT().Folder("100", "id,content_metadata_id", nil).Return(v4.Folder{Id: ptr("100"), ContentMetadataId: ptr("200")}, nil)
+	api.EXPECT().Folder("101", "id,content_metadata_id", nil).Return(v4.Folder{Id: ptr("101"), ContentMetadataId: ptr("201")}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+
+	// Removing a folder with delete_contents skips the check for contents.
+	api.EXPECT().DeleteFolder("101", nil).Return("", nil)
+	state, diags = tr.update(state, map[string]any{"id": "1", "parent_id": "1", "paths": []string{"Sales"}, "delete_contents": true})
+	requireNoErrors(t, "Update", diags)
+	tr.get(state, "folders", &folders)
+	if len(folders) != 1 {
+		t.Errorf("folders = %v, want only Sales", folders)
+	}
+
+	state = tr.state(map[string]any{"id": "1", "parent_id": "1", "paths": []string{"Sales"}, "delete_contents": false, "folders": folders})
+	gomock.InOrder(
+		api.EXPECT().FolderDashboards("100", "id", nil).Return([]v4.Dashboard{}, nil),
+		api.EXPECT().FolderLooks("100", "id", nil).Return([]v4.LookWithQuery{}, nil),
+		api.EXPECT().FolderChildren(v4.RequestFolderChildren{FolderId: "100", Fields: ptr("id")}, nil).Return([]v4.Folder{}, nil),
+		api.EXPECT().DeleteFolder("100", nil).Return("", nil),
+	)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestFolderTreeResourceReadNotFound(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &folderTreeResource{baseResource{client: client}})
+	folders := map[string]folderTreeEntry{}
+	for p, id := range map[string]string{"Sales": "100", "Sales/EMEA": "101", "Support": "102"} {
+		folders[p] = folderTreeEntry{ID: types.StringValue(id), ContentMetadataID: types.StringValue("2" + id[1:])}
+	}
+
+	// Sales was deleted outside Terraform, so Sales/EMEA is gone too.
+	api.EXPECT().Folder("100", "id,content_metadata_id", nil).Return(v4.Folder{}, errNotFound)
+	api.EXPECT().Folder("101", "id,content_metadata_id", nil).Return(v4.Folder{Id: ptr("101")}, nil)
+	api.EXPECT().Folder("102", "id,content_metadata_id", nil).Return(v4.Folder{Id: ptr("102"), ContentMetadataId: ptr("202")}, nil)
+	state, diags := tr.read(tr.state(map[string]any{"id": "1", "parent_id": "1", "paths": []string{"Sales/EMEA", "Support"}, "delete_contents": false, "folders": folders}))
+	requireNoErrors(t, "Read", diags)
+	var paths []string
+	tr.get(state, "paths", &paths)
+	if want := []string{"Support"}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("paths = %v, want %v", paths, want)
+	}
+	tr.get(state, "folders", &folders)
+	if len(folders) != 1 || folders["Support"].ID.ValueString() != "102" {
+		t.Errorf("folders = %v, want only Support", folders)
+	}
+}
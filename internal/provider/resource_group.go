@@ -4,25 +4,35 @@ import (
 	"context"
 	"fmt"
 
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
 )
 
 var (
-	_ resource.Resource                = &groupResource{}
-	_ resource.ResourceWithConfigure   = &groupResource{}
-	_ resource.ResourceWithImportState = &groupResource{}
+	_ resource.Resource                 = &groupResource{}
+	_ resource.ResourceWithConfigure    = &groupResource{}
+	_ resource.ResourceWithImportState  = &groupResource{}
+	_ resource.ResourceWithModifyPlan   = &groupResource{}
+	_ resource.ResourceWithUpgradeState = &groupResource{}
 )
 
 // groupResource is the resource implementation.
 type groupResource struct {
-	sdk *v4.LookerSDK
+	baseResource
 }
 
 // groupResourceModel maps the resource schema data.
@@ -31,6 +41,16 @@ type groupResourceModel struct {
 	Name       types.String `tfsdk:"name"`
 	UserIDs    types.Set    `tfsdk:"user_ids"`
 	UserEmails types.Set    `tfsdk:"user_emails"`
+	Snapshot   types.Object `tfsdk:"membership_snapshot"`
+
+	Description types.String `tfsdk:"description"`
+	Labels      types.Map    `tfsdk:"labels"`
+
+	Authoritative types.Bool `tfsdk:"authoritative"`
+
+	ExternallyManaged types.Bool     `tfsdk:"externally_managed"`
+	ExternalGroupID   types.String   `tfsdk:"external_group_id"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewGroupResource is a helper function to simplify the provider implementation.
@@ -44,10 +64,11 @@ func (r *groupResource) Metadata(_ context.Context, req resource.MetadataRequest
 }
 
 // Schema defines the schema for the resource.
-func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *groupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages Looker groups and their user memberships.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -58,55 +79,61 @@ func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required: true,
 			},
 			"user_ids": schema.SetAttribute{
-				Description: "IDs of users to be added to the group.",
+				Description: "IDs of users to be added to the group. Conflicts with `user_emails`.",
 				ElementType: types.StringType,
 				Optional:    true,
 			},
 			"user_emails": schema.SetAttribute{
-				Description: "Emails of users to be added to the group. The provider will resolve these to user IDs. Use this or `user_ids`, but not both.",
+				Description: "Emails of users to be added to the group. The provider will resolve these to user IDs. Conflicts with `user_ids`: a user listed in both would be added twice, so declare every member in one of them.",
 				ElementType: types.StringType,
 				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("user_ids")),
+				},
+			},
+			"membership_snapshot": membershipSnapshotAttribute,
+			"authoritative": schema.BoolAttribute{
+				Description: "If true, `user_ids` or `user_emails` is the complete member list and any other member is removed. If false, declared users are added but members added outside Terraform (e.g. by SAML or SCIM) are left alone, and only users dropped from `user_ids` are removed. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the group. Looker groups have no description field, so it is stored by the provider in the Looker artifact store.",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Free-form labels for the group, e.g. owner or cost center, stored alongside `description`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"externally_managed": schema.BoolAttribute{
+				Description: "Whether the group membership is controlled outside of Looker, e.g. by SAML or LDAP. The provider does not add or remove members of such groups; `user_ids` and `user_emails` are ignored.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"external_group_id": schema.StringAttribute{
+				Description: "ID of the group in the external system, if any.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
 }
 
-// Configure adds the provider configured client to the resource.
-func (r *groupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if cb, ok := req.ProviderData.(*clientBundle); ok && cb.SDK != nil {
-		r.sdk = cb.SDK
-	} else if req.ProviderData != nil {
-		resp.Diagnostics.AddError("Unexpected provider data", "Missing Looker SDK client")
-	}
-}
-
-// Helper function to resolve emails to IDs
-func (r *groupResource) resolveUserEmailsToIDs(ctx context.Context, emails []string) ([]string, error) {
-	var resolvedIDs []string
-	for _, email := range emails {
-		// Search for the user by email
-		results, err := r.sdk.SearchUsers(v4.RequestSearchUsers{Email: &email}, nil)
-		if err != nil {
-			return nil, fmt.Errorf("API error searching for user with email %s: %w", email, err)
-		}
-		if len(results) == 0 {
-			return nil, fmt.Errorf("no user found with email %s", email)
-		}
-		if len(results) > 1 {
-			return nil, fmt.Errorf("multiple users found with email %s", email)
-		}
-		resolvedIDs = append(resolvedIDs, *results[0].Id)
-	}
-	return resolvedIDs, nil
-}
-
 // Create creates the resource and sets the initial Terraform state.
 func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
 	var plan groupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -114,12 +141,14 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	group, err := r.sdk.CreateGroup(v4.WriteGroup{Name: plan.Name.ValueStringPointer()}, "", nil)
+	group, err := r.client.SDK(ctx).CreateGroup(v4.WriteGroup{Name: plan.Name.ValueStringPointer()}, "", nil)
 	if err != nil {
 		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to create group: %v", err))
 		return
 	}
 	plan.ID = types.StringPointerValue(group.Id)
+	plan.ExternallyManaged = types.BoolValue(group.ExternallyManaged != nil && *group.ExternallyManaged)
+	plan.ExternalGroupID = types.StringPointerValue(group.ExternalGroupId)
 	groupID := *group.Id
 
 	// MODIFIED: Combine user IDs and resolved user emails
@@ -132,7 +161,7 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 	if !plan.UserEmails.IsNull() {
 		var userEmails []string
 		resp.Diagnostics.Append(plan.UserEmails.ElementsAs(ctx, &userEmails, false)...)
-		resolvedIDs, err := r.resolveUserEmailsToIDs(ctx, userEmails)
+		resolvedIDs, err := resolveUserEmailsToIDs(ctx, r.client, userEmails)
 		if err != nil {
 			resp.Diagnostics.AddError("User resolution failed", err.Error())
 			return
@@ -140,24 +169,41 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 		finalUserIDs = append(finalUserIDs, resolvedIDs...)
 	}
 
-	for _, userID := range finalUserIDs {
-		_, err := r.sdk.AddGroupUser(groupID, v4.GroupIdForGroupUserInclusion{UserId: &userID}, nil)
-		if err != nil {
-			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to add user %s to group %s: %v", userID, groupID, err))
+	if err := changeGroupMembers(r.client.SDK(ctx), groupID, finalUserIDs, nil); err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+
+	metadata, diags := planGroupMetadata(ctx, plan.Description, plan.Labels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !metadata.empty() {
+		if err := writeGroupMetadata(r.client.SDK(ctx), groupID, metadata); err != nil {
+			resp.Diagnostics.AddError("API error", err.Error())
 			return
 		}
 	}
 
+	plan.Snapshot, diags = groupMembershipSnapshot(ctx, r.client.SDK(ctx), groupID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
 	var state groupResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -166,15 +212,17 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 	groupID := state.ID.ValueString()
 
-	group, err := r.sdk.Group(groupID, "id,name", nil)
+	group, err := r.client.SDK(ctx).Group(groupID, "id,name,externally_managed,external_group_id", nil)
 	if err != nil {
 		tflog.Warn(ctx, fmt.Sprintf("Group %s not found, removing from state", groupID))
 		resp.State.RemoveResource(ctx)
 		return
 	}
 	state.Name = types.StringPointerValue(group.Name)
+	state.ExternallyManaged = types.BoolValue(group.ExternallyManaged != nil && *group.ExternallyManaged)
+	state.ExternalGroupID = types.StringPointerValue(group.ExternalGroupId)
 
-	groupUsers, err := r.sdk.AllGroupUsers(v4.RequestAllGroupUsers{GroupId: groupID}, nil)
+	groupUsers, err := allGroupUsers(r.client.SDK(ctx), groupID, groupMemberFields)
 	if err != nil {
 		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to get users for group %s: %v", groupID, err))
 		return
@@ -183,26 +231,168 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	for _, user := range groupUsers {
 		userIDs = append(userIDs, *user.Id)
 	}
+	if state.Authoritative.IsNull() {
+		state.Authoritative = types.BoolValue(true)
+	}
+	if state.ExternallyManaged.ValueBool() {
+		// Membership belongs to the identity provider; keep what was
+		// declared so that its changes do not show up as drift.
+		userIDs = nil
+		if !state.UserIDs.IsNull() {
+			resp.Diagnostics.Append(state.UserIDs.ElementsAs(ctx, &userIDs, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	} else if !state.Authoritative.ValueBool() {
+		// Only the declared members are tracked; others are not ours to report.
+		var declared []string
+		if !state.UserIDs.IsNull() {
+			resp.Diagnostics.Append(state.UserIDs.ElementsAs(ctx, &declared, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		isMember := make(map[string]bool, len(userIDs))
+		for _, id := range userIDs {
+			isMember[id] = true
+		}
+		userIDs = nil
+		for _, id := range declared {
+			if isMember[id] {
+				userIDs = append(userIDs, id)
+			}
+		}
+	}
 	userIdsSet, diags := types.SetValueFrom(ctx, types.StringType, userIDs)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if state.UserIDs.IsNull() && len(userIDs) == 0 && (!state.Authoritative.ValueBool() || state.ExternallyManaged.ValueBool()) {
+		userIdsSet = types.SetNull(types.StringType)
+	}
 	state.UserIDs = userIdsSet
+	state.Snapshot, diags = newMembershipSnapshot(ctx, groupUsers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	// NOTE: We only populate user_ids in the state, as this reflects the remote resource.
 	// user_emails is treated as a write-only convenience attribute.
 	state.UserEmails = types.SetNull(types.StringType)
 
+	metadata, _, err := readGroupMetadata(r.client.SDK(ctx), groupID)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+	if metadata.Description != "" || !state.Description.IsNull() {
+		state.Description = types.StringValue(metadata.Description)
+	}
+	if len(metadata.Labels) > 0 || !state.Labels.IsNull() {
+		state.Labels, diags = types.MapValueFrom(ctx, types.StringType, metadata.Labels)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// ModifyPlan warns when members are declared on a group whose membership is
+// controlled outside of Looker, and when removing members from a group that
+// holds an Admin role, or deleting it, would leave no admin.
+func (r *groupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state groupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if req.Plan.Raw.IsNull() {
+		if r.client != nil {
+			warnNoAdminLeft(r.client.SDK(ctx), adminChange{groupID: state.ID.ValueString()}, path.Root("id"), &resp.Diagnostics)
+		}
+		return
+	}
+
+	var plan groupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !state.ExternallyManaged.ValueBool() {
+		r.warnNoAdminLeft(ctx, plan, state, &resp.Diagnostics)
+		return
+	}
+	if !plan.UserIDs.IsNull() && !plan.UserIDs.Equal(state.UserIDs) {
+		warnExternallyManaged(state.ID.ValueString(), path.Root("user_ids"), &resp.Diagnostics)
+	}
+	if !plan.UserEmails.IsNull() {
+		warnExternallyManaged(state.ID.ValueString(), path.Root("user_emails"), &resp.Diagnostics)
+	}
+}
+
+// warnNoAdminLeft runs the last-admin check for the members a group keeps when
+// its plan removes user IDs. Members declared by email are not resolved at
+// plan time, so changes to user_emails are not checked.
+func (r *groupResource) warnNoAdminLeft(ctx context.Context, plan, state groupResourceModel, diags *diag.Diagnostics) {
+	if r.client == nil || plan.UserIDs.IsUnknown() || !plan.UserEmails.IsNull() || plan.UserIDs.Equal(state.UserIDs) {
+		return
+	}
+	var planned, current []string
+	diags.Append(state.UserIDs.ElementsAs(ctx, &current, false)...)
+	if !plan.UserIDs.IsNull() {
+		diags.Append(plan.UserIDs.ElementsAs(ctx, &planned, false)...)
+	}
+	if diags.HasError() {
+		return
+	}
+	removed := stringsNotIn(current, planned)
+	if len(removed) == 0 {
+		return
+	}
+
+	members := planned
+	if !plan.Authoritative.ValueBool() {
+		// Members added outside Terraform are kept.
+		live, err := allGroupUsers(r.client.SDK(ctx), state.ID.ValueString(), "id")
+		if err != nil {
+			return
+		}
+		gone := make(map[string]bool, len(removed))
+		for _, id := range removed {
+			gone[id] = true
+		}
+		members = nil
+		for _, user := range live {
+			if user.Id != nil && !gone[*user.Id] {
+				members = append(members, *user.Id)
+			}
+		}
+	}
+	warnNoAdminLeft(r.client.SDK(ctx), adminChange{groupID: state.ID.ValueString(), groupMembers: members}, path.Root("user_ids"), diags)
+}
+
+func warnExternallyManaged(groupID string, attribute path.Path, diags *diag.Diagnostics) {
+	diags.AddAttributeWarning(attribute, "Group membership is externally managed",
+		fmt.Sprintf("The membership of group %s is controlled outside of Looker (e.g. by SAML), so %s is ignored and no users will be added or removed.", groupID, attribute))
+}
+
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
 	var plan, state groupResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -212,36 +402,82 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 	groupID := state.ID.ValueString()
 
 	if !plan.Name.Equal(state.Name) {
-		_, err := r.sdk.UpdateGroup(groupID, v4.WriteGroup{Name: plan.Name.ValueStringPointer()}, "", nil)
+		_, err := r.client.SDK(ctx).UpdateGroup(groupID, v4.WriteGroup{Name: plan.Name.ValueStringPointer()}, "", nil)
 		if err != nil {
 			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to update group name for %s: %v", groupID, err))
 			return
 		}
 	}
 
+	if state.ExternallyManaged.ValueBool() {
+		tflog.Info(ctx, fmt.Sprintf("Group %s is externally managed, skipping membership changes", groupID))
+	} else {
+		r.updateMembers(ctx, groupID, plan, state, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.Description.Equal(state.Description) || !plan.Labels.Equal(state.Labels) {
+		metadata, diags := planGroupMetadata(ctx, plan.Description, plan.Labels)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := writeGroupMetadata(r.client.SDK(ctx), groupID, metadata); err != nil {
+			resp.Diagnostics.AddError("API error", err.Error())
+			return
+		}
+	}
+
+	snapshot, diags := groupMembershipSnapshot(ctx, r.client.SDK(ctx), groupID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Snapshot = snapshot
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// updateMembers adds and removes group members to go from state to plan.
+func (r *groupResource) updateMembers(ctx context.Context, groupID string, plan, state groupResourceModel, diags *diag.Diagnostics) {
 	// MODIFIED: Resolve planned emails to IDs for diffing
 	var planUserIDs []string
 	if !plan.UserIDs.IsNull() {
 		var userIDs []string
-		resp.Diagnostics.Append(plan.UserIDs.ElementsAs(ctx, &userIDs, false)...)
+		diags.Append(plan.UserIDs.ElementsAs(ctx, &userIDs, false)...)
 		planUserIDs = append(planUserIDs, userIDs...)
 	}
 	if !plan.UserEmails.IsNull() {
 		var userEmails []string
-		resp.Diagnostics.Append(plan.UserEmails.ElementsAs(ctx, &userEmails, false)...)
-		resolvedIDs, err := r.resolveUserEmailsToIDs(ctx, userEmails)
+		diags.Append(plan.UserEmails.ElementsAs(ctx, &userEmails, false)...)
+		resolvedIDs, err := resolveUserEmailsToIDs(ctx, r.client, userEmails)
 		if err != nil {
-			resp.Diagnostics.AddError("User resolution failed", err.Error())
+			diags.AddError("User resolution failed", err.Error())
 			return
 		}
 		planUserIDs = append(planUserIDs, resolvedIDs...)
 	}
 
 	var stateUserIDs []string
-	resp.Diagnostics.Append(state.UserIDs.ElementsAs(ctx, &stateUserIDs, false)...)
-	if resp.Diagnostics.HasError() {
+	diags.Append(state.UserIDs.ElementsAs(ctx, &stateUserIDs, false)...)
+	if diags.HasError() {
 		return
 	}
+	if plan.Authoritative.ValueBool() && !state.Authoritative.ValueBool() {
+		// Switching to authoritative: members added outside Terraform are
+		// not in state yet, so diff against the live member list.
+		members, err := allGroupUsers(r.client.SDK(ctx), groupID, "id")
+		if err != nil {
+			diags.AddError("API error", fmt.Sprintf("Failed to get users for group %s: %v", groupID, err))
+			return
+		}
+		stateUserIDs = nil
+		for _, member := range members {
+			stateUserIDs = append(stateUserIDs, *member.Id)
+		}
+	}
 
 	// Standard diffing logic
 	planUsers := make(map[string]bool)
@@ -253,36 +489,31 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		stateUsers[id] = true
 	}
 
+	var add, remove []string
 	for userID := range planUsers {
 		if !stateUsers[userID] {
-			_, err := r.sdk.AddGroupUser(groupID, v4.GroupIdForGroupUserInclusion{UserId: &userID}, nil)
-			if err != nil {
-				resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to add user %s to group %s: %v", userID, groupID, err))
-				return
-			}
+			add = append(add, userID)
 		}
 	}
-
 	for userID := range stateUsers {
 		if !planUsers[userID] {
-			err := r.sdk.DeleteGroupUser(groupID, userID, nil)
-			if err != nil {
-				resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to remove user %s from group %s: %v", userID, groupID, err))
-				return
-			}
+			remove = append(remove, userID)
 		}
 	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if err := changeGroupMembers(r.client.SDK(ctx), groupID, add, remove); err != nil {
+		diags.AddError("API error", err.Error())
+	}
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
 	var state groupResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -291,14 +522,26 @@ func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 	groupID := state.ID.ValueString()
 
-	_, err := r.sdk.DeleteGroup(groupID, nil)
+	_, err := r.client.SDK(ctx).DeleteGroup(groupID, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to delete group %s: %v", groupID, err))
 		return
 	}
+	if err := deleteGroupMetadata(r.client.SDK(ctx), groupID); err != nil {
+		tflog.Warn(ctx, err.Error())
+	}
 }
 
-// ImportState imports the resource into the Terraform state.
+// ImportState imports the resource into the Terraform state, by ID or by
+// name=<name>.
 func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByName(ctx, r.client, "group", func(sdk lookerapi.Client, name string) ([]namedObject, error) {
+		fields := "id,name"
+		groups, err := sdk.SearchGroups(v4.RequestSearchGroups{Name: &name, Fields: &fields}, nil)
+		objects := make([]namedObject, 0, len(groups))
+		for _, group := range groups {
+			objects = append(objects, namedObject{id: group.Id, name: group.Name})
+		}
+		return objects, err
+	}, req, resp)
 }
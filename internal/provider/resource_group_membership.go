@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                 = &groupMembershipResource{}
+	_ resource.ResourceWithConfigure    = &groupMembershipResource{}
+	_ resource.ResourceWithImportState  = &groupMembershipResource{}
+	_ resource.ResourceWithUpgradeState = &groupMembershipResource{}
+)
+
+// groupMembershipResource is the resource implementation.
+type groupMembershipResource struct {
+	baseResource
+}
+
+// groupMembershipResourceModel maps the resource schema data.
+type groupMembershipResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	GroupID        types.String   `tfsdk:"group_id"`
+	UserIDs        types.Set      `tfsdk:"user_ids"`
+	UserEmails     types.Set      `tfsdk:"user_emails"`
+	ManagedUserIDs types.Set      `tfsdk:"managed_user_ids"`
+	Snapshot       types.Object   `tfsdk:"membership_snapshot"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewGroupMembershipResource is a helper function to simplify the provider implementation.
+func NewGroupMembershipResource() resource.Resource {
+	return &groupMembershipResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *groupMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_membership"
+}
+
+// Schema defines the schema for the resource.
+func (r *groupMembershipResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Non-authoritatively manages members of a Looker group: only the declared users are added, and only they are removed on destroy. " +
+			"Other members, added by hand, by SCIM or by other `looker_group_membership` resources, are left alone. Do not combine with `user_ids` or `user_emails` on the `looker_group` of the same group.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "The ID of the group.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Description: "The ID of the group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_ids": schema.SetAttribute{
+				Description: "IDs of users to add to the group.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.AtLeastOneOf(path.MatchRoot("user_emails")),
+				},
+			},
+			"user_emails": schema.SetAttribute{
+				Description: "Emails of users to add to the group. The provider resolves these to user IDs.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"managed_user_ids": schema.SetAttribute{
+				Description: "IDs of all users managed by this resource, from `user_ids` and the resolved `user_emails`.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"membership_snapshot": membershipSnapshotAttribute,
+		},
+	}
+}
+
+// desiredUserIDs returns the IDs of the users declared in the model.
+func (r *groupMembershipResource) desiredUserIDs(ctx context.Context, m groupMembershipResourceModel) ([]string, error) {
+	var userIDs, userEmails []string
+	if !m.UserIDs.IsNull() {
+		if diags := m.UserIDs.ElementsAs(ctx, &userIDs, false); diags.HasError() {
+			return nil, fmt.Errorf("invalid user_ids")
+		}
+	}
+	if !m.UserEmails.IsNull() {
+		if diags := m.UserEmails.ElementsAs(ctx, &userEmails, false); diags.HasError() {
+			return nil, fmt.Errorf("invalid user_emails")
+		}
+	}
+	resolvedIDs, err := resolveUserEmailsToIDs(ctx, r.client, userEmails)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var desired []string
+	for _, id := range append(userIDs, resolvedIDs...) {
+		if !seen[id] {
+			seen[id] = true
+			desired = append(desired, id)
+		}
+	}
+	sort.Strings(desired)
+	return desired, nil
+}
+
+// apply adds the desired users that were not managed before and removes the
+// previously managed users that are no longer desired.
+func (r *groupMembershipResource) apply(ctx context.Context, groupID string, desired, previous []string) error {
+	return changeGroupMembers(r.client.SDK(ctx), groupID, stringsNotIn(desired, previous), stringsNotIn(previous, desired))
+}
+
+// Create adds the declared users to the group.
+func (r *groupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan groupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	groupID := plan.GroupID.ValueString()
+
+	desired, err := r.desiredUserIDs(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("User resolution failed", err.Error())
+		return
+	}
+	if err := r.apply(ctx, groupID, desired, nil); err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+
+	managed, diags := types.SetValueFrom(ctx, types.StringType, desired)
+	resp.Diagnostics.Append(diags...)
+	plan.Snapshot, diags = groupMembershipSnapshot(ctx, r.client.SDK(ctx), groupID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = plan.GroupID
+	plan.ManagedUserIDs = managed
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data. Declared users who
+// left the group are dropped from state, so the next plan adds them back.
+func (r *groupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state groupMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	groupID := state.GroupID.ValueString()
+
+	users, err := allGroupUsers(r.client.SDK(ctx), groupID, groupMemberFields)
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Group %s not found, removing from state", groupID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to get users for group %s: %v", groupID, err))
+		return
+	}
+	memberIDs := make(map[string]bool, len(users))
+	memberEmails := make(map[string]bool, len(users))
+	for _, user := range users {
+		if user.Id != nil {
+			memberIDs[*user.Id] = true
+		}
+		if user.Email != nil {
+			memberEmails[strings.ToLower(*user.Email)] = true
+		}
+	}
+
+	keep := func(set types.Set, member func(string) bool) (types.Set, error) {
+		if set.IsNull() {
+			return set, nil
+		}
+		var values, kept []string
+		if diags := set.ElementsAs(ctx, &values, false); diags.HasError() {
+			return set, fmt.Errorf("invalid state")
+		}
+		for _, value := range values {
+			if member(value) {
+				kept = append(kept, value)
+			} else {
+				tflog.Warn(ctx, fmt.Sprintf("User %s is no longer a member of group %s", value, groupID))
+			}
+		}
+		result, diags := types.SetValueFrom(ctx, types.StringType, kept)
+		if diags.HasError() {
+			return set, fmt.Errorf("invalid state")
+		}
+		return result, nil
+	}
+	isMemberID := func(id string) bool { return memberIDs[id] }
+	isMemberEmail := func(email string) bool { return memberEmails[strings.ToLower(email)] }
+
+	if state.UserIDs, err = keep(state.UserIDs, isMemberID); err == nil {
+		if state.UserEmails, err = keep(state.UserEmails, isMemberEmail); err == nil {
+			state.ManagedUserIDs, err = keep(state.ManagedUserIDs, isMemberID)
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Read error", fmt.Sprintf("Failed to refresh members of group %s: %v", groupID, err))
+		return
+	}
+
+	snapshot, diags := newMembershipSnapshot(ctx, users)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ID = state.GroupID
+	state.Snapshot = snapshot
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update adds newly declared users and removes users no longer declared.
+func (r *groupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
+	var plan, state groupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	groupID := state.GroupID.ValueString()
+
+	var previous []string
+	resp.Diagnostics.Append(state.ManagedUserIDs.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	desired, err := r.desiredUserIDs(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("User resolution failed", err.Error())
+		return
+	}
+	if err := r.apply(ctx, groupID, desired, previous); err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+
+	managed, diags := types.SetValueFrom(ctx, types.StringType, desired)
+	resp.Diagnostics.Append(diags...)
+	plan.Snapshot, diags = groupMembershipSnapshot(ctx, r.client.SDK(ctx), groupID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = plan.GroupID
+	plan.ManagedUserIDs = managed
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes only the users managed by this resource from the group.
+func (r *groupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
+	var state groupMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previous []string
+	resp.Diagnostics.Append(state.ManagedUserIDs.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.apply(ctx, state.GroupID.ValueString(), nil, previous); err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+}
+
+// ImportState imports the membership of given users in a group using
+// "<group_id>/<user_id>[,<user_id>...]".
+func (r *groupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <group_id>/<user_id>[,<user_id>...]. Got: %q", req.ID),
+		)
+		return
+	}
+
+	userIDs, diags := types.SetValueFrom(ctx, types.StringType, strings.Split(idParts[1], ","))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_ids"), userIDs)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("managed_user_ids"), userIDs)...)
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGroupMembershipResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &groupMembershipResource{baseResource{client: client}})
+	alice := v4.User{Id: ptr("10"), Email: ptr("alice@example.com")}
+	bob := v4.User{Id: ptr("11"), Email: ptr("bob@example.com")}
+	carol := v4.User{Id: ptr("12"), Email: ptr("carol@example.com")}
+
+	// Members are added concurrently, in any order.
+	api.EXPECT().SearchUsers(v4.RequestSearchUsers{Email: ptr("Bob@example.com"), Fields: ptr("id,email")}, nil).Return([]v4.User{bob}, nil)
+	api.EXPECT().AddGroupUser("20", v4.GroupIdForGroupUserInclusion{UserId: ptr("10")}, nil).Return(alice, nil)
+	api.EXPECT().AddGroupUser("20", v4.GroupIdForGroupUserInclusion{UserId: ptr("11")}, nil).Return(bob, nil)
+	api.EXPECT().AllGroupUsers(gomock.Any(), nil).Return([]v4.User{alice, bob, carol}, nil)
+	state, diags := tr.create(map[string]any{"group_id": "20", "user_ids": []string{"10"}, "user_emails": []string{"Bob@example.com"}})
+	requireNoErrors(t, "Create", diags)
+	var managed []string
+	tr.get(state, "managed_user_ids", &managed)
+	if !sameStrings(managed, []string{"10", "11"}) {
+		t.Errorf("managed_user_ids = %v, want [10 11]", managed)
+	}
+
+	// Bob left the group; Carol is not managed by the resource.
+	api.EXPECT().AllGroupUsers(gomock.Any(), nil).Return([]v4.User{alice, carol}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var emails []string
+	tr.get(state, "user_emails", &emails)
+	tr.get(state, "managed_user_ids", &managed)
+	if len(emails) != 0 || !sameStrings(managed, []string{"10"}) {
+		t.Errorf("user_emails, managed_user_ids = %v, %v, want [], [10]", emails, managed)
+	}
+
+	gomock.InOrder(
+		api.EXPECT().AddGroupUser("20", v4.GroupIdForGroupUserInclusion{UserId: ptr("12")}, nil).Return(carol, nil),
+		api.EXPECT().AllGroupUsers(gomock.Any(), nil).Return([]v4.User{alice, carol}, nil),
+	)
+	state, diags = tr.update(state, map[string]any{"id": "20", "group_id": "20", "user_ids": []string{"10", "12"}})
+	requireNoErrors(t, "Update", diags)
+
+	api.EXPECT().DeleteGroupUser("20", "10", nil).Return(nil)
+	api.EXPECT().DeleteGroupUser("20", "12", nil).Return(errNotFound)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestGroupMembershipResourceReadNotFound(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &groupMembershipResource{baseResource{client: client}})
+
+	api.EXPECT().AllGroupUsers(gomock.Any(), nil).Return(nil, errNotFound)
+	state, diags := tr.read(tr.state(map[string]any{"id": "20", "group_id": "20", "user_ids": []string{"10"}, "managed_user_ids": []string{"10"}}))
+	requireNoErrors(t, "Read", diags)
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want removed", state.Raw)
+	}
+}
@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                 = &groupRoleAssignmentsResource{}
+	_ resource.ResourceWithConfigure    = &groupRoleAssignmentsResource{}
+	_ resource.ResourceWithModifyPlan   = &groupRoleAssignmentsResource{}
+	_ resource.ResourceWithUpgradeState = &groupRoleAssignmentsResource{}
+)
+
+// groupRoleAssignmentsResource is the resource implementation.
+type groupRoleAssignmentsResource struct {
+	baseResource
+}
+
+// groupRoleAssignmentsResourceModel maps the resource schema data.
+type groupRoleAssignmentsResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Assignments types.Map    `tfsdk:"assignments"`
+
+	AllowAllUsers types.Bool     `tfsdk:"allow_all_users"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewGroupRoleAssignmentsResource is a helper function to simplify the provider implementation.
+func NewGroupRoleAssignmentsResource() resource.Resource {
+	return &groupRoleAssignmentsResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *groupRoleAssignmentsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_role_assignments"
+}
+
+// Schema defines the schema for the resource.
+func (r *groupRoleAssignmentsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the group assignments of several Looker roles at once. Each role listed in `assignments` is given exactly the declared set of groups. Do not combine with `looker_role_groups` for the same role.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"assignments": schema.MapAttribute{
+				Description: "Map of role ID to the set of group IDs assigned to that role.",
+				ElementType: types.SetType{ElemType: types.StringType},
+				Required:    true,
+			},
+			"allow_all_users": allowAllUsersAttribute,
+		},
+	}
+}
+
+// ModifyPlan warns when a role is about to be given to a group that holds
+// every user, unless allow_all_users is set.
+func (r *groupRoleAssignmentsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan groupRoleAssignmentsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.AllowAllUsers.ValueBool() || plan.Assignments.IsUnknown() {
+		return
+	}
+	var planned, current map[string][]string
+	resp.Diagnostics.Append(plan.Assignments.ElementsAs(ctx, &planned, false)...)
+	if !req.State.Raw.IsNull() {
+		var state groupRoleAssignmentsResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		resp.Diagnostics.Append(state.Assignments.ElementsAs(ctx, &current, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleIDs := make([]string, 0, len(planned))
+	for roleID := range planned {
+		roleIDs = append(roleIDs, roleID)
+	}
+	sort.Strings(roleIDs)
+	for _, roleID := range roleIDs {
+		warnAllUsersGroups(r.client.SDK(ctx), roleID, stringsNotIn(planned[roleID], current[roleID]), path.Root("assignments").AtMapKey(roleID), &resp.Diagnostics)
+	}
+}
+
+// setAssignments calls SetRoleGroups for every role in desired, and clears the
+// groups of every role in previous that is no longer declared.
+func (r *groupRoleAssignmentsResource) setAssignments(ctx context.Context, desired, previous map[string][]string) error {
+	for roleID, groupIDs := range desired {
+		if groupIDs == nil {
+			groupIDs = []string{}
+		}
+		if _, err := r.client.SDK(ctx).SetRoleGroups(roleID, groupIDs, nil); err != nil {
+			return fmt.Errorf("failed to set groups for role %s: %w", roleID, err)
+		}
+	}
+	for roleID := range previous {
+		if _, ok := desired[roleID]; ok {
+			continue
+		}
+		if _, err := r.client.SDK(ctx).SetRoleGroups(roleID, []string{}, nil); err != nil {
+			return fmt.Errorf("failed to clear groups for role %s: %w", roleID, err)
+		}
+	}
+	return nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *groupRoleAssignmentsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan groupRoleAssignmentsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var assignments map[string][]string
+	resp.Diagnostics.Append(plan.Assignments.ElementsAs(ctx, &assignments, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setAssignments(ctx, assignments, nil); err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue("group_role_assignments")
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *groupRoleAssignmentsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state groupRoleAssignmentsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previous map[string][]string
+	resp.Diagnostics.Append(state.Assignments.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assignments := make(map[string][]string, len(previous))
+	for roleID := range previous {
+		groups, err := r.client.SDK(ctx).RoleGroups(roleID, "id", nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read groups for role %s: %v", roleID, err))
+			return
+		}
+		groupIDs := []string{}
+		for _, group := range groups {
+			groupIDs = append(groupIDs, *group.Id)
+		}
+		assignments[roleID] = groupIDs
+	}
+
+	assignmentsMap, diags := types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, assignments)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Assignments = assignmentsMap
+	if state.AllowAllUsers.IsNull() {
+		state.AllowAllUsers = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *groupRoleAssignmentsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
+	var plan, state groupRoleAssignmentsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var assignments, previous map[string][]string
+	resp.Diagnostics.Append(plan.Assignments.ElementsAs(ctx, &assignments, false)...)
+	resp.Diagnostics.Append(state.Assignments.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setAssignments(ctx, assignments, previous); err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete clears the groups of every role managed by this resource.
+func (r *groupRoleAssignmentsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
+	var state groupRoleAssignmentsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previous map[string][]string
+	resp.Diagnostics.Append(state.Assignments.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setAssignments(ctx, map[string][]string{}, previous); err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"testing"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestGroupRoleAssignmentsResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &groupRoleAssignmentsResource{baseResource{client: client}})
+
+	api.EXPECT().SetRoleGroups("1", []string{"5"}, nil).Return(nil, nil)
+	api.EXPECT().SetRoleGroups("2", []string{"5", "6"}, nil).Return(nil, nil)
+	state, diags := tr.create(map[string]any{"assignments": map[string][]string{"1": {"5"}, "2": {"5", "6"}}, "allow_all_users": false})
+	requireNoErrors(t, "Create", diags)
+	var id string
+	tr.get(state, "id", &id)
+	if id != "group_role_assignments" {
+		t.Errorf("id = %q, want %q", id, "group_role_assignments")
+	}
+
+	// Group 7 was given role 2 outside Terraform.
+	api.EXPECT().RoleGroups("1", "id", nil).Return([]v4.Group{{Id: ptr("5")}}, nil)
+	api.EXPECT().RoleGroups("2", "id", nil).Return([]v4.Group{{Id: ptr("5")}, {Id: ptr("6")}, {Id: ptr("7")}}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var assignments map[string][]string
+	tr.get(state, "assignments", &assignments)
+	if !sameStrings(assignments["2"], []string{"5", "6", "7"}) {
+		t.Errorf("assignments[2] = %v, want [5 6 7]", assignments["2"])
+	}
+
+	// Role 1 is no longer declared and loses its groups.
+	api.EXPECT().SetRoleGroups("2", []string{"6"}, nil).Return(nil, nil)
+	api.EXPECT().SetRoleGroups("1", []string{}, nil).Return(nil, nil)
+	state, diags = tr.update(state, map[string]any{"id": "group_role_assignments", "assignments": map[string][]string{"2": {"6"}}, "allow_all_users": false})
+	requireNoErrors(t, "Update", diags)
+
+	api.EXPECT().SetRoleGroups("2", []string{}, nil).Return(nil, nil)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+// A role deleted outside Terraform cannot be assigned again, so Read reports
+// it instead of planning its groups anew.
+func TestGroupRoleAssignmentsResourceReadNotFound(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &groupRoleAssignmentsResource{baseResource{client: client}})
+
+	api.EXPECT().RoleGroups("1", "id", nil).Return(nil, errNotFound)
+	_, diags := tr.read(tr.state(map[string]any{"id": "group_role_assignments", "assignments": map[string][]string{"1": {"5"}}, "allow_all_users": false}))
+	if !diags.HasError() || diags.Errors()[0].Summary() != "API error" {
+		t.Errorf("diagnostics = %v, want an API error", diags)
+	}
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-looker/internal/lookertest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAccGroupResource(t *testing.T) {
+	srv := testAccServer(t)
+	alice := srv.AddUser("tf-acc-test-alice@example.com")
+	bob := srv.AddUser("tf-acc-test-bob@example.com")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(srv, "looker_group", lookertest.Groups),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig("tf-acc-test-group", alice),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("looker_group.test", "id"),
+					resource.TestCheckResourceAttr("looker_group.test", "name", "tf-acc-test-group"),
+					resource.TestCheckResourceAttr("looker_group.test", "user_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("looker_group.test", "user_ids.*", alice),
+					resource.TestCheckResourceAttr("looker_group.test", "membership_snapshot.user_emails.0", "tf-acc-test-alice@example.com"),
+					testAccCheckStored(srv, "looker_group.test", lookertest.Groups, "name", "tf-acc-test-group"),
+				),
+			},
+			{
+				ResourceName:      "looker_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupConfig("tf-acc-test-group-renamed", bob),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_group.test", "name", "tf-acc-test-group-renamed"),
+					resource.TestCheckResourceAttr("looker_group.test", "user_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("looker_group.test", "user_ids.*", bob),
+					testAccCheckStored(srv, "looker_group.test", lookertest.Groups, "name", "tf-acc-test-group-renamed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroupConfig(name, userID string) string {
+	return fmt.Sprintf(`
+resource "looker_group" "test" {
+  name     = %q
+  user_ids = [%q]
+}
+`, name, userID)
+}
+
+func TestGroupResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &groupResource{baseResource{client: client}})
+	alice := v4.User{Id: ptr("10"), Email: ptr("alice@example.com")}
+	bob := v4.User{Id: ptr("11"), Email: ptr("bob@example.com")}
+	metadataRequest := v4.RequestArtifact{Namespace: artifactNamespace, Key: "group/20"}
+
+	gomock.InOrder(
+		api.EXPECT().CreateGroup(v4.WriteGroup{Name: ptr("analysts")}, "", nil).Return(v4.Group{Id: ptr("20"), Name: ptr("analysts")}, nil),
+		api.EXPECT().AddGroupUser("20", v4.GroupIdForGroupUserInclusion{UserId: ptr("10")}, nil).Return(alice, nil),
+		api.EXPECT().AllGroupUsers(gomock.Any(), nil).Return([]v4.User{alice}, nil),
+	)
+	state, diags := tr.create(map[string]any{"name": "analysts", "user_ids": []string{"10"}, "authoritative": true})
+	requireNoErrors(t, "Create", diags)
+	var emails []string
+	tr.get(state, "membership_snapshot.user_emails", &emails)
+	if !sameStrings(emails, []string{"alice@example.com"}) {
+		t.Errorf("membership_snapshot.user_emails = %v, want [alice@example.com]", emails)
+	}
+
+	// Bob was added outside of Terraform.
+	gomock.InOrder(
+		api.EXPECT().Group("20", gomock.Any(), nil).Return(v4.Group{Id: ptr("20"), Name: ptr("analysts")}, nil),
+		api.EXPECT().AllGroupUsers(gomock.Any(), nil).Return([]v4.User{alice, bob}, nil),
+		api.EXPECT().Artifact(metadataRequest, nil).Return(nil, errNotFound),
+	)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var userIDs []string
+	tr.get(state, "user_ids", &userIDs)
+	if !sameStrings(userIDs, []string{"10", "11"}) {
+		t.Errorf("user_ids = %v, want [10 11]", userIDs)
+	}
+
+	gomock.InOrder(
+		api.EXPECT().UpdateGroup("20", v4.WriteGroup{Name: ptr("readers")}, "", nil).Return(v4.Group{Id: ptr("20"), Name: ptr("readers")}, nil),
+		api.EXPECT().DeleteGroupUser("20", "10", nil).Return(nil),
+		api.EXPECT().Artifact(metadataRequest, nil).Return(nil, errNotFound),
+		api.EXPECT().UpdateArtifacts(artifactNamespace, gomock.Len(1), "", nil).Return(nil, nil),
+		api.EXPECT().AllGroupUsers(gomock.Any(), nil).Return([]v4.User{bob}, nil),
+	)
+	state, diags = tr.update(state, map[string]any{
+		"id":                 "20",
+		"name":               "readers",
+		"user_ids":           []string{"11"},
+		"authoritative":      true,
+		"description":        "Read-only analysts",
+		"externally_managed": false,
+	})
+	requireNoErrors(t, "Update", diags)
+	tr.get(state, "membership_snapshot.user_emails", &emails)
+	if !sameStrings(emails, []string{"bob@example.com"}) {
+		t.Errorf("membership_snapshot.user_emails = %v, want [bob@example.com]", emails)
+	}
+
+	gomock.InOrder(
+		api.EXPECT().DeleteGroup("20", nil).Return("", nil),
+		api.EXPECT().DeleteArtifact(artifactNamespace, "group/20", nil).Return(nil),
+	)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestGroupResourceReadNotFound(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &groupResource{baseResource{client: client}})
+
+	api.EXPECT().Group("20", gomock.Any(), nil).Return(v4.Group{}, errNotFound)
+	state, diags := tr.read(tr.state(map[string]any{"id": "20", "name": "analysts"}))
+	requireNoErrors(t, "Read", diags)
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want removed", state.Raw)
+	}
+}
+
+func TestGroupResourceNonAuthoritativeRead(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &groupResource{baseResource{client: client}})
+
+	// Bob was added outside of Terraform and is not ours to report.
+	api.EXPECT().Group("20", gomock.Any(), nil).Return(v4.Group{Id: ptr("20"), Name: ptr("analysts")}, nil)
+	api.EXPECT().AllGroupUsers(gomock.Any(), nil).Return([]v4.User{{Id: ptr("10")}, {Id: ptr("11")}}, nil)
+	api.EXPECT().Artifact(gomock.Any(), nil).Return(nil, errNotFound)
+	state, diags := tr.read(tr.state(map[string]any{"id": "20", "name": "analysts", "user_ids": []string{"10"}, "authoritative": false}))
+	requireNoErrors(t, "Read", diags)
+	var userIDs []string
+	tr.get(state, "user_ids", &userIDs)
+	if !sameStrings(userIDs, []string{"10"}) {
+		t.Errorf("user_ids = %v, want [10]", userIDs)
+	}
+}
@@ -3,35 +3,55 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
 )
 
 var (
-	_ resource.Resource                = &modelSetResource{}
-	_ resource.ResourceWithConfigure   = &modelSetResource{}
-	_ resource.ResourceWithImportState = &modelSetResource{}
+	_ resource.Resource                 = &modelSetResource{}
+	_ resource.ResourceWithConfigure    = &modelSetResource{}
+	_ resource.ResourceWithImportState  = &modelSetResource{}
+	_ resource.ResourceWithModifyPlan   = &modelSetResource{}
+	_ resource.ResourceWithUpgradeState = &modelSetResource{}
+)
+
+// Values of `validate_models`.
+const (
+	modelValidationOff   = "off"
+	modelValidationWarn  = "warn"
+	modelValidationError = "error"
 )
 
 // modelSetResource is the resource implementation.
 type modelSetResource struct {
-	sdk *v4.LookerSDK
+	baseResource
 }
 
 // modelSetResourceModel maps the resource schema data.
 type modelSetResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Models    types.Set    `tfsdk:"models"`
-	BuiltIn   types.Bool   `tfsdk:"built_in"`
-	AllAccess types.Bool   `tfsdk:"all_access"`
-	URL       types.String `tfsdk:"url"`
+	ID        types.String   `tfsdk:"id"`
+	Name      types.String   `tfsdk:"name"`
+	Models    types.Set      `tfsdk:"models"`
+	Validate  types.String   `tfsdk:"validate_models"`
+	BuiltIn   types.Bool     `tfsdk:"built_in"`
+	AllAccess types.Bool     `tfsdk:"all_access"`
+	URL       types.String   `tfsdk:"url"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewModelSetResource is a helper function to simplify the provider implementation.
@@ -45,10 +65,11 @@ func (r *modelSetResource) Metadata(_ context.Context, req resource.MetadataRequ
 }
 
 // Schema defines the schema for the resource.
-func (r *modelSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *modelSetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages Looker model sets.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the model set.",
 				Computed:    true,
@@ -61,17 +82,32 @@ func (r *modelSetResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Required:    true,
 			},
 			"models": schema.SetAttribute{
-				Description: "The models in the model set.",
-				Required:    true,
+				Description: "The models in the model set. Required unless the model set has all access, such as the built-in All set, in which case it may be omitted and is not refreshed, since Looker lists every model of the instance.",
+				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"validate_models": schema.StringAttribute{
+				Description: "Whether the plan checks that every entry of models is a LookML model of the instance: `off`, `warn` or `error`. Defaults to `off`. A misspelled model makes the set grant nothing for it.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(modelValidationOff),
+				Validators: []validator.String{
+					stringvalidator.OneOf(modelValidationOff, modelValidationWarn, modelValidationError),
+				},
+			},
 			"built_in": schema.BoolAttribute{
-				Description: "Whether the model set is built-in.",
+				Description: "Whether the model set is built into Looker, e.g. All. Built-in model sets can be imported but not modified or deleted.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"all_access": schema.BoolAttribute{
-				Description: "Whether the model set has all access.",
+				Description: "Whether the model set has all access, i.e. grants every model of the instance.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"url": schema.StringAttribute{
 				Description: "The URL of the model set.",
@@ -81,22 +117,83 @@ func (r *modelSetResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
-// Configure adds the provider configured client to the resource.
-func (r *modelSetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if cb, ok := req.ProviderData.(*clientBundle); ok && cb.SDK != nil {
-		r.sdk = cb.SDK
-	} else if req.ProviderData != nil {
-		resp.Diagnostics.AddError("Unexpected provider data", "Missing Looker SDK client")
+// ModifyPlan refuses changes to built-in model sets, requires models unless
+// the model set has all access, and reports entries of models that are not
+// LookML models of the instance, as a warning or an error depending on
+// validate_models.
+func (r *modelSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if refuseBuiltInChange(ctx, req, "model set", &resp.Diagnostics) {
+		return
+	}
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan modelSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Models.IsNull() {
+		if !plan.AllAccess.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("models"), "Missing models",
+				"models is required unless the model set has all access, such as the built-in All set.")
+		}
+		return
+	}
+	mode := plan.Validate.ValueString()
+	if r.client == nil || mode == modelValidationOff || plan.Validate.IsUnknown() || plan.Models.IsUnknown() {
+		return
+	}
+
+	var models []string
+	resp.Diagnostics.Append(plan.Models.ElementsAs(ctx, &models, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields := "name"
+	known, err := r.client.SDK(ctx).AllLookmlModels(v4.RequestAllLookmlModels{Fields: &fields}, nil)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Model validation skipped", fmt.Sprintf("Failed to list LookML models: %v", err))
+		return
+	}
+	names := make(map[string]bool, len(known))
+	for _, model := range known {
+		if model.Name != nil {
+			names[*model.Name] = true
+		}
+	}
+
+	var unknown []string
+	for _, model := range models {
+		if !names[model] {
+			unknown = append(unknown, model)
+		}
+	}
+	if len(unknown) == 0 {
+		return
+	}
+	sort.Strings(unknown)
+
+	summary := "Unknown LookML models"
+	detail := fmt.Sprintf("The instance has no LookML model named %s; the model set grants nothing for them.", strings.Join(unknown, ", "))
+	if mode == modelValidationError {
+		resp.Diagnostics.AddAttributeError(path.Root("models"), summary, detail)
+	} else {
+		resp.Diagnostics.AddAttributeWarning(path.Root("models"), summary, detail)
 	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *modelSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
 	var plan modelSetResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -111,7 +208,7 @@ func (r *modelSetResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	ms, err := r.sdk.CreateModelSet(v4.WriteModelSet{
+	ms, err := r.client.SDK(ctx).CreateModelSet(v4.WriteModelSet{
 		Name:   plan.Name.ValueStringPointer(),
 		Models: &models,
 	}, nil)
@@ -131,11 +228,13 @@ func (r *modelSetResource) Create(ctx context.Context, req resource.CreateReques
 
 // Read refreshes the Terraform state with the latest data.
 func (r *modelSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
 	var state modelSetResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -143,7 +242,7 @@ func (r *modelSetResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	ms, err := r.sdk.ModelSet(state.ID.ValueString(), "", nil)
+	ms, err := r.client.SDK(ctx).ModelSet(state.ID.ValueString(), "", nil)
 	if err != nil {
 		// Handle not found error
 		resp.State.RemoveResource(ctx)
@@ -155,16 +254,24 @@ func (r *modelSetResource) Read(ctx context.Context, req resource.ReadRequest, r
 	state.AllAccess = types.BoolPointerValue(ms.AllAccess)
 	state.URL = types.StringPointerValue(ms.Url)
 
-	var models []string
-	if ms.Models != nil {
-		models = *ms.Models
+	// A model set with all access lists every model of the instance, which
+	// changes whenever a model is added; keep models as configured instead,
+	// or unset after an import.
+	if !state.AllAccess.ValueBool() {
+		var models []string
+		if ms.Models != nil {
+			models = *ms.Models
+		}
+		modelsSet, diags := types.SetValueFrom(ctx, types.StringType, models)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Models = modelsSet
 	}
-	modelsSet, diags := types.SetValueFrom(ctx, types.StringType, models)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if state.Validate.IsNull() {
+		state.Validate = types.StringValue(modelValidationOff)
 	}
-	state.Models = modelsSet
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -172,11 +279,13 @@ func (r *modelSetResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *modelSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
 	var plan modelSetResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -190,6 +299,9 @@ func (r *modelSetResource) Update(ctx context.Context, req resource.UpdateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if refuseBuiltIn(ctx, req.State, "model set", "modified", &resp.Diagnostics) {
+		return
+	}
 
 	var models []string
 	diags = plan.Models.ElementsAs(ctx, &models, false)
@@ -198,7 +310,7 @@ func (r *modelSetResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	ms, err := r.sdk.UpdateModelSet(state.ID.ValueString(), v4.WriteModelSet{
+	ms, err := r.client.SDK(ctx).UpdateModelSet(state.ID.ValueString(), v4.WriteModelSet{
 		Name:   plan.Name.ValueStringPointer(),
 		Models: &models,
 	}, nil)
@@ -218,11 +330,13 @@ func (r *modelSetResource) Update(ctx context.Context, req resource.UpdateReques
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *modelSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
 	var state modelSetResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -230,14 +344,31 @@ func (r *modelSetResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	_, err := r.sdk.DeleteModelSet(state.ID.ValueString(), nil)
+	if refuseBuiltIn(ctx, req.State, "model set", "deleted", &resp.Diagnostics) {
+		return
+	}
+
+	_, err := r.client.SDK(ctx).DeleteModelSet(state.ID.ValueString(), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to delete model set: %v", err))
 		return
 	}
 }
 
-// ImportState imports the resource into the Terraform state.
+// ImportState imports the resource into the Terraform state, by ID or by
+// name=<name>.
 func (r *modelSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByName(ctx, r.client, "model set", searchModelSetsByName, req, resp)
+}
+
+// searchModelSetsByName returns the model sets matching the name search
+// pattern name.
+func searchModelSetsByName(sdk lookerapi.Client, name string) ([]namedObject, error) {
+	fields := "id,name"
+	sets, err := sdk.SearchModelSets(v4.RequestSearchModelSets{Name: &name, Fields: &fields}, nil)
+	objects := make([]namedObject, 0, len(sets))
+	for _, set := range sets {
+		objects = append(objects, namedObject{id: set.Id, name: set.Name})
+	}
+	return objects, err
 }
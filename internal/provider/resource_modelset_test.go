@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"terraform-provider-looker/internal/lookertest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAccModelSetResource(t *testing.T) {
+	srv := testAccServer(t)
+	srv.AddModel("tf_acc_test_sales")
+	srv.AddModel("tf_acc_test_marketing")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(srv, "looker_model_set", lookertest.ModelSets),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelSetConfig("tf-acc-test-model-set", "off", "tf_acc_test_sales"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("looker_model_set.test", "id"),
+					resource.TestCheckResourceAttr("looker_model_set.test", "name", "tf-acc-test-model-set"),
+					resource.TestCheckResourceAttr("looker_model_set.test", "models.#", "1"),
+					resource.TestCheckTypeSetElemAttr("looker_model_set.test", "models.*", "tf_acc_test_sales"),
+					resource.TestCheckResourceAttr("looker_model_set.test", "all_access", "false"),
+				),
+			},
+			{
+				ResourceName:      "looker_model_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccModelSetConfig("tf-acc-test-model-set-renamed", "error", "tf_acc_test_sales", "tf_acc_test_marketing"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_model_set.test", "name", "tf-acc-test-model-set-renamed"),
+					resource.TestCheckResourceAttr("looker_model_set.test", "models.#", "2"),
+					resource.TestCheckTypeSetElemAttr("looker_model_set.test", "models.*", "tf_acc_test_marketing"),
+					testAccCheckStored(srv, "looker_model_set.test", lookertest.ModelSets, "name", "tf-acc-test-model-set-renamed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccModelSetConfig(name, validate string, models ...string) string {
+	return fmt.Sprintf(`
+resource "looker_model_set" "test" {
+  name            = %q
+  models          = [%s]
+  validate_models = %q
+}
+`, name, testAccQuotedList(models), validate)
+}
+
+func TestModelSetResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &modelSetResource{baseResource{client: client}})
+
+	api.EXPECT().CreateModelSet(v4.WriteModelSet{
+		Name:   ptr("sales"),
+		Models: &[]string{"orders"},
+	}, nil).Return(v4.ModelSet{
+		Id:        ptr("3"),
+		Name:      ptr("sales"),
+		Models:    &[]string{"orders"},
+		BuiltIn:   ptr(false),
+		AllAccess: ptr(false),
+	}, nil)
+	state, diags := tr.create(map[string]any{"name": "sales", "models": []string{"orders"}, "validate_models": "off"})
+	requireNoErrors(t, "Create", diags)
+	var id string
+	tr.get(state, "id", &id)
+	if id != "3" {
+		t.Errorf("id = %q, want %q", id, "3")
+	}
+
+	// The model was added outside of Terraform.
+	api.EXPECT().ModelSet("3", "", nil).Return(v4.ModelSet{
+		Id:        ptr("3"),
+		Name:      ptr("sales"),
+		Models:    &[]string{"orders", "returns"},
+		BuiltIn:   ptr(false),
+		AllAccess: ptr(false),
+	}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var models []string
+	tr.get(state, "models", &models)
+	if !sameStrings(models, []string{"orders", "returns"}) {
+		t.Errorf("models = %v, want [orders returns]", models)
+	}
+
+	api.EXPECT().UpdateModelSet("3", v4.WriteModelSet{
+		Name:   ptr("sales"),
+		Models: &[]string{"orders"},
+	}, nil).Return(v4.ModelSet{
+		Id:        ptr("3"),
+		Name:      ptr("sales"),
+		Models:    &[]string{"orders"},
+		BuiltIn:   ptr(false),
+		AllAccess: ptr(false),
+	}, nil)
+	state, diags = tr.update(state, map[string]any{"id": "3", "name": "sales", "models": []string{"orders"}, "validate_models": "off"})
+	requireNoErrors(t, "Update", diags)
+	tr.get(state, "models", &models)
+	if !sameStrings(models, []string{"orders"}) {
+		t.Errorf("models = %v, want [orders]", models)
+	}
+
+	api.EXPECT().DeleteModelSet("3", nil).Return("", nil)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestModelSetResourceReadNotFound(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &modelSetResource{baseResource{client: client}})
+
+	api.EXPECT().ModelSet("3", "", nil).Return(v4.ModelSet{}, errNotFound)
+	state, diags := tr.read(tr.state(map[string]any{"id": "3", "name": "sales"}))
+	requireNoErrors(t, "Read", diags)
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want removed", state.Raw)
+	}
+}
+
+func TestModelSetResourceValidateModels(t *testing.T) {
+	tests := map[string]struct {
+		mode     string
+		err      error
+		errors   int
+		warnings int
+	}{
+		"warn":         {mode: "warn", warnings: 1},
+		"error":        {mode: "error", errors: 1},
+		"list failure": {mode: "error", err: errors.New("response error. status=500 Internal Server Error"), warnings: 1},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, api := newMockClient(t)
+			tr := newTestResource(t, &modelSetResource{baseResource{client: client}})
+
+			api.EXPECT().AllLookmlModels(gomock.Any(), nil).Return([]v4.LookmlModel{{Name: ptr("orders")}}, test.err)
+			_, diags := tr.modifyPlan(tr.emptyState(), map[string]any{
+				"name":            "sales",
+				"models":          []string{"orders", "typo"},
+				"validate_models": test.mode,
+			})
+			if got := diags.ErrorsCount(); got != test.errors {
+				t.Errorf("errors = %d, want %d: %v", got, test.errors, diags)
+			}
+			if got := diags.WarningsCount(); got != test.warnings {
+				t.Errorf("warnings = %d, want %d: %v", got, test.warnings, diags)
+			}
+		})
+	}
+}
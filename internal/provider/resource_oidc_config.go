@@ -0,0 +1,540 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// oidcConfigID is the ID of the singleton looker_oidc_config resource.
+const oidcConfigID = "oidc_config"
+
+// oidcGroupMappingObjectType is the object type of a `group_mappings` entry.
+var oidcGroupMappingObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"name":              types.StringType,
+	"looker_group_name": types.StringType,
+	"role_ids":          types.SetType{ElemType: types.StringType},
+}}
+
+// oidcUserAttributeObjectType is the object type of a `user_attributes` entry.
+var oidcUserAttributeObjectType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"name":               types.StringType,
+	"required":           types.BoolType,
+	"user_attribute_ids": types.SetType{ElemType: types.StringType},
+}}
+
+var (
+	_ resource.Resource                 = &oidcConfigResource{}
+	_ resource.ResourceWithConfigure    = &oidcConfigResource{}
+	_ resource.ResourceWithImportState  = &oidcConfigResource{}
+	_ resource.ResourceWithUpgradeState = &oidcConfigResource{}
+)
+
+// oidcConfigResource is the resource implementation.
+type oidcConfigResource struct {
+	baseResource
+}
+
+// oidcConfigResourceModel maps the resource schema data.
+type oidcConfigResourceModel struct {
+	ID                         types.String   `tfsdk:"id"`
+	Enabled                    types.Bool     `tfsdk:"enabled"`
+	Issuer                     types.String   `tfsdk:"issuer"`
+	Audience                   types.String   `tfsdk:"audience"`
+	Identifier                 types.String   `tfsdk:"identifier"`
+	Secret                     types.String   `tfsdk:"secret"`
+	AuthorizationEndpoint      types.String   `tfsdk:"authorization_endpoint"`
+	TokenEndpoint              types.String   `tfsdk:"token_endpoint"`
+	UserinfoEndpoint           types.String   `tfsdk:"userinfo_endpoint"`
+	Scopes                     types.List     `tfsdk:"scopes"`
+	UserAttributeMapEmail      types.String   `tfsdk:"user_attribute_map_email"`
+	UserAttributeMapFirstName  types.String   `tfsdk:"user_attribute_map_first_name"`
+	UserAttributeMapLastName   types.String   `tfsdk:"user_attribute_map_last_name"`
+	UserAttributes             types.Set      `tfsdk:"user_attributes"`
+	GroupsAttribute            types.String   `tfsdk:"groups_attribute"`
+	SetRolesFromGroups         types.Bool     `tfsdk:"set_roles_from_groups"`
+	GroupMappings              types.Set      `tfsdk:"group_mappings"`
+	DefaultNewUserGroupIDs     types.Set      `tfsdk:"default_new_user_group_ids"`
+	DefaultNewUserRoleIDs      types.Set      `tfsdk:"default_new_user_role_ids"`
+	AuthRequiresRole           types.Bool     `tfsdk:"auth_requires_role"`
+	AlternateEmailLoginAllowed types.Bool     `tfsdk:"alternate_email_login_allowed"`
+	NewUserMigrationTypes      types.String   `tfsdk:"new_user_migration_types"`
+	Timeouts                   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// oidcGroupMappingModel maps a `group_mappings` entry.
+type oidcGroupMappingModel struct {
+	Name            types.String `tfsdk:"name"`
+	LookerGroupName types.String `tfsdk:"looker_group_name"`
+	RoleIDs         []string     `tfsdk:"role_ids"`
+}
+
+// oidcUserAttributeModel maps a `user_attributes` entry.
+type oidcUserAttributeModel struct {
+	Name             types.String `tfsdk:"name"`
+	Required         types.Bool   `tfsdk:"required"`
+	UserAttributeIDs []string     `tfsdk:"user_attribute_ids"`
+}
+
+// NewOIDCConfigResource is a helper function to simplify the provider implementation.
+func NewOIDCConfigResource() resource.Resource {
+	return &oidcConfigResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *oidcConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oidc_config"
+}
+
+// Schema defines the schema for the resource.
+func (r *oidcConfigResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	optionalComputedString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	optionalComputedBool := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Description: description,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the OpenID Connect (OIDC) single sign-on settings of the Looker instance. There is only one OIDC configuration per instance; destroying the resource disables OIDC authentication.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "Always `oidc_config`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether OIDC authentication is enabled. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"issuer": schema.StringAttribute{
+				Description: "The OpenID Provider issuer.",
+				Required:    true,
+			},
+			"audience":   optionalComputedString("The OpenID Provider audience."),
+			"identifier": schema.StringAttribute{Description: "The relying party identifier (client ID) provided by the OpenID Provider.", Required: true, Sensitive: true},
+			"secret": schema.StringAttribute{
+				Description: "The relying party secret (client secret) provided by the OpenID Provider. Looker never returns it, so changes made outside Terraform are not detected.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"authorization_endpoint": schema.StringAttribute{Description: "The OpenID Provider authorization URL.", Required: true},
+			"token_endpoint":         schema.StringAttribute{Description: "The OpenID Provider token URL.", Required: true},
+			"userinfo_endpoint":      schema.StringAttribute{Description: "The OpenID Provider user information URL.", Required: true},
+			"scopes": schema.ListAttribute{
+				Description: "The scopes to request, e.g. `[\"openid\", \"email\", \"profile\"]`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_attribute_map_email":      optionalComputedString("The OIDC claim holding the user's email address."),
+			"user_attribute_map_first_name": optionalComputedString("The OIDC claim holding the user's first name."),
+			"user_attribute_map_last_name":  optionalComputedString("The OIDC claim holding the user's last name."),
+			"user_attributes": schema.SetNestedAttribute{
+				Description: "Mappings of OIDC claims to Looker user attributes.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the OIDC claim.",
+							Required:    true,
+						},
+						"required": schema.BoolAttribute{
+							Description: "Whether the claim must be present for the login to succeed.",
+							Required:    true,
+						},
+						"user_attribute_ids": schema.SetAttribute{
+							Description: "IDs of the Looker user attributes set from the claim.",
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+			"groups_attribute":      optionalComputedString("The OIDC claim holding the user's groups."),
+			"set_roles_from_groups": optionalComputedBool("Whether Looker roles are assigned from the user's OIDC groups."),
+			"group_mappings": schema.SetNestedAttribute{
+				Description: "Mappings of OIDC groups to Looker roles. Looker creates a mirrored Looker group for each OIDC group.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the OIDC group.",
+							Required:    true,
+						},
+						"looker_group_name": schema.StringAttribute{
+							Description: "The name of the mirrored Looker group. Defaults to the OIDC group name.",
+							Optional:    true,
+						},
+						"role_ids": schema.SetAttribute{
+							Description: "IDs of the Looker roles given to members of the group.",
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+			"default_new_user_group_ids": schema.SetAttribute{
+				Description: "IDs of the groups new users are added to on their first OIDC login.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"default_new_user_role_ids": schema.SetAttribute{
+				Description: "IDs of the roles new users are given on their first OIDC login.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"auth_requires_role":            optionalComputedBool("Whether users without any role found in OIDC are refused login."),
+			"alternate_email_login_allowed": optionalComputedBool("Whether admins and users with the `login_special_email` permission can still log in with email and password."),
+			"new_user_migration_types":      optionalComputedString("Comma separated credential types, e.g. `email,google`, used to merge a first OIDC login into an existing account with the same email."),
+		},
+	}
+}
+
+// writeOIDCConfig builds the API write body from the planned attributes.
+func (m *oidcConfigResourceModel) writeOIDCConfig(ctx context.Context) (v4.WriteOIDCConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	body := v4.WriteOIDCConfig{
+		Enabled:               m.Enabled.ValueBoolPointer(),
+		Issuer:                m.Issuer.ValueStringPointer(),
+		Identifier:            m.Identifier.ValueStringPointer(),
+		Secret:                m.Secret.ValueStringPointer(),
+		AuthorizationEndpoint: m.AuthorizationEndpoint.ValueStringPointer(),
+		TokenEndpoint:         m.TokenEndpoint.ValueStringPointer(),
+		UserinfoEndpoint:      m.UserinfoEndpoint.ValueStringPointer(),
+	}
+	if !m.Audience.IsUnknown() {
+		body.Audience = m.Audience.ValueStringPointer()
+	}
+	if !m.UserAttributeMapEmail.IsUnknown() {
+		body.UserAttributeMapEmail = m.UserAttributeMapEmail.ValueStringPointer()
+	}
+	if !m.UserAttributeMapFirstName.IsUnknown() {
+		body.UserAttributeMapFirstName = m.UserAttributeMapFirstName.ValueStringPointer()
+	}
+	if !m.UserAttributeMapLastName.IsUnknown() {
+		body.UserAttributeMapLastName = m.UserAttributeMapLastName.ValueStringPointer()
+	}
+	if !m.GroupsAttribute.IsUnknown() {
+		body.GroupsAttribute = m.GroupsAttribute.ValueStringPointer()
+	}
+	if !m.SetRolesFromGroups.IsUnknown() {
+		body.SetRolesFromGroups = m.SetRolesFromGroups.ValueBoolPointer()
+	}
+	if !m.AuthRequiresRole.IsUnknown() {
+		body.AuthRequiresRole = m.AuthRequiresRole.ValueBoolPointer()
+	}
+	if !m.AlternateEmailLoginAllowed.IsUnknown() {
+		body.AlternateEmailLoginAllowed = m.AlternateEmailLoginAllowed.ValueBoolPointer()
+	}
+	if !m.NewUserMigrationTypes.IsUnknown() {
+		body.NewUserMigrationTypes = m.NewUserMigrationTypes.ValueStringPointer()
+	}
+	if !m.Scopes.IsNull() && !m.Scopes.IsUnknown() {
+		var scopes []string
+		diags.Append(m.Scopes.ElementsAs(ctx, &scopes, false)...)
+		body.Scopes = &scopes
+	}
+
+	// Collections are always sent so that removing them from the
+	// configuration clears them in Looker.
+	var mappings []oidcGroupMappingModel
+	if !m.GroupMappings.IsNull() {
+		diags.Append(m.GroupMappings.ElementsAs(ctx, &mappings, false)...)
+	}
+	groups := []v4.OIDCGroupWrite{}
+	for _, mapping := range mappings {
+		roleIDs := mapping.RoleIDs
+		groups = append(groups, v4.OIDCGroupWrite{
+			Name:            mapping.Name.ValueStringPointer(),
+			LookerGroupName: mapping.LookerGroupName.ValueStringPointer(),
+			RoleIds:         &roleIDs,
+		})
+	}
+	body.GroupsWithRoleIds = &groups
+
+	var attributes []oidcUserAttributeModel
+	if !m.UserAttributes.IsNull() {
+		diags.Append(m.UserAttributes.ElementsAs(ctx, &attributes, false)...)
+	}
+	userAttributes := []v4.OIDCUserAttributeWrite{}
+	for _, attribute := range attributes {
+		ids := attribute.UserAttributeIDs
+		userAttributes = append(userAttributes, v4.OIDCUserAttributeWrite{
+			Name:             attribute.Name.ValueStringPointer(),
+			Required:         attribute.Required.ValueBoolPointer(),
+			UserAttributeIds: &ids,
+		})
+	}
+	body.UserAttributesWithIds = &userAttributes
+
+	groupIDs := []string{}
+	if !m.DefaultNewUserGroupIDs.IsNull() {
+		diags.Append(m.DefaultNewUserGroupIDs.ElementsAs(ctx, &groupIDs, false)...)
+	}
+	body.DefaultNewUserGroupIds = &groupIDs
+	roleIDs := []string{}
+	if !m.DefaultNewUserRoleIDs.IsNull() {
+		diags.Append(m.DefaultNewUserRoleIDs.ElementsAs(ctx, &roleIDs, false)...)
+	}
+	body.DefaultNewUserRoleIds = &roleIDs
+
+	return body, diags
+}
+
+// refresh copies the API representation of the OIDC config into the model.
+// The secret is write-only and kept from the prior value. Empty collections
+// stay null when they were not configured.
+func (m *oidcConfigResourceModel) refresh(ctx context.Context, config v4.OIDCConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.ID = types.StringValue(oidcConfigID)
+	m.Enabled = types.BoolPointerValue(config.Enabled)
+	m.Issuer = types.StringPointerValue(config.Issuer)
+	m.Audience = types.StringPointerValue(config.Audience)
+	m.Identifier = types.StringPointerValue(config.Identifier)
+	m.AuthorizationEndpoint = types.StringPointerValue(config.AuthorizationEndpoint)
+	m.TokenEndpoint = types.StringPointerValue(config.TokenEndpoint)
+	m.UserinfoEndpoint = types.StringPointerValue(config.UserinfoEndpoint)
+	m.UserAttributeMapEmail = types.StringPointerValue(config.UserAttributeMapEmail)
+	m.UserAttributeMapFirstName = types.StringPointerValue(config.UserAttributeMapFirstName)
+	m.UserAttributeMapLastName = types.StringPointerValue(config.UserAttributeMapLastName)
+	m.GroupsAttribute = types.StringPointerValue(config.GroupsAttribute)
+	m.SetRolesFromGroups = types.BoolPointerValue(config.SetRolesFromGroups)
+	m.AuthRequiresRole = types.BoolPointerValue(config.AuthRequiresRole)
+	m.AlternateEmailLoginAllowed = types.BoolPointerValue(config.AlternateEmailLoginAllowed)
+	m.NewUserMigrationTypes = types.StringPointerValue(config.NewUserMigrationTypes)
+
+	scopes := []string{}
+	if config.Scopes != nil {
+		scopes = *config.Scopes
+	}
+	var d diag.Diagnostics
+	m.Scopes, d = types.ListValueFrom(ctx, types.StringType, scopes)
+	diags.Append(d...)
+
+	// looker_group_name defaults to the OIDC group name; keep it null when it
+	// was not configured and Looker applied the default.
+	var prior []oidcGroupMappingModel
+	if !m.GroupMappings.IsNull() && !m.GroupMappings.IsUnknown() {
+		diags.Append(m.GroupMappings.ElementsAs(ctx, &prior, false)...)
+	}
+	unnamed := make(map[string]bool, len(prior))
+	for _, mapping := range prior {
+		if mapping.LookerGroupName.IsNull() {
+			unnamed[mapping.Name.ValueString()] = true
+		}
+	}
+	mappings := []oidcGroupMappingModel{}
+	if config.GroupsWithRoleIds != nil {
+		for _, group := range *config.GroupsWithRoleIds {
+			roleIDs := []string{}
+			if group.RoleIds != nil {
+				roleIDs = *group.RoleIds
+			}
+			mapping := oidcGroupMappingModel{
+				Name:            types.StringPointerValue(group.Name),
+				LookerGroupName: types.StringPointerValue(group.LookerGroupName),
+				RoleIDs:         roleIDs,
+			}
+			if unnamed[mapping.Name.ValueString()] && mapping.LookerGroupName.Equal(mapping.Name) {
+				mapping.LookerGroupName = types.StringNull()
+			}
+			mappings = append(mappings, mapping)
+		}
+	}
+	if len(mappings) > 0 || !m.GroupMappings.IsNull() {
+		m.GroupMappings, d = types.SetValueFrom(ctx, oidcGroupMappingObjectType, mappings)
+		diags.Append(d...)
+	}
+
+	attributes := []oidcUserAttributeModel{}
+	if config.UserAttributesWithIds != nil {
+		for _, attribute := range *config.UserAttributesWithIds {
+			ids := []string{}
+			if attribute.UserAttributeIds != nil {
+				ids = *attribute.UserAttributeIds
+			}
+			attributes = append(attributes, oidcUserAttributeModel{
+				Name:             types.StringPointerValue(attribute.Name),
+				Required:         types.BoolPointerValue(attribute.Required),
+				UserAttributeIDs: ids,
+			})
+		}
+	}
+	if len(attributes) > 0 || !m.UserAttributes.IsNull() {
+		m.UserAttributes, d = types.SetValueFrom(ctx, oidcUserAttributeObjectType, attributes)
+		diags.Append(d...)
+	}
+
+	groupIDs := []string{}
+	if config.DefaultNewUserGroups != nil {
+		for _, group := range *config.DefaultNewUserGroups {
+			if group.Id != nil {
+				groupIDs = append(groupIDs, *group.Id)
+			}
+		}
+	}
+	if len(groupIDs) > 0 || !m.DefaultNewUserGroupIDs.IsNull() {
+		m.DefaultNewUserGroupIDs, d = types.SetValueFrom(ctx, types.StringType, groupIDs)
+		diags.Append(d...)
+	}
+	roleIDs := []string{}
+	if config.DefaultNewUserRoles != nil {
+		for _, role := range *config.DefaultNewUserRoles {
+			if role.Id != nil {
+				roleIDs = append(roleIDs, *role.Id)
+			}
+		}
+	}
+	if len(roleIDs) > 0 || !m.DefaultNewUserRoleIDs.IsNull() {
+		m.DefaultNewUserRoleIDs, d = types.SetValueFrom(ctx, types.StringType, roleIDs)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// apply writes the planned configuration and stores the result in plan.
+func (r *oidcConfigResource) apply(ctx context.Context, plan *oidcConfigResourceModel) diag.Diagnostics {
+	body, diags := plan.writeOIDCConfig(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	config, err := r.client.SDK(ctx).UpdateOidcConfig(body, nil)
+	if err != nil {
+		diags.AddError("API error", fmt.Sprintf("Failed to update OIDC config: %v", err))
+		return diags
+	}
+	diags.Append(plan.refresh(ctx, config)...)
+	return diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *oidcConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan oidcConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *oidcConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state oidcConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.client.SDK(ctx).OidcConfig(nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read OIDC config: %v", err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.refresh(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *oidcConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
+	var plan oidcConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete disables OIDC authentication; the settings themselves are kept.
+func (r *oidcConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
+	disabled := false
+	if _, err := r.client.SDK(ctx).UpdateOidcConfig(v4.WriteOIDCConfig{Enabled: &disabled}, nil); err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to disable OIDC config: %v", err))
+		return
+	}
+	tflog.Info(ctx, "Disabled OIDC authentication")
+}
+
+// ImportState imports the OIDC config; any import ID is accepted.
+func (r *oidcConfigResource) ImportState(ctx context.Context, _ resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), oidcConfigID)...)
+}
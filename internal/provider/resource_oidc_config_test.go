@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestOIDCConfigResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &oidcConfigResource{baseResource{client: client}})
+	values := map[string]any{
+		"enabled":                true,
+		"issuer":                 "https://idp.example.com",
+		"identifier":             "looker",
+		"secret":                 "s3cret",
+		"authorization_endpoint": "https://idp.example.com/authorize",
+		"token_endpoint":         "https://idp.example.com/token",
+		"userinfo_endpoint":      "https://idp.example.com/userinfo",
+		"group_mappings": []oidcGroupMappingModel{
+			{Name: types.StringValue("eng"), LookerGroupName: types.StringNull(), RoleIDs: []string{"2"}},
+		},
+	}
+	body := v4.WriteOIDCConfig{
+		Enabled:                ptr(true),
+		Issuer:                 ptr("https://idp.example.com"),
+		Identifier:             ptr("looker"),
+		Secret:                 ptr("s3cret"),
+		AuthorizationEndpoint:  ptr("https://idp.example.com/authorize"),
+		TokenEndpoint:          ptr("https://idp.example.com/token"),
+		UserinfoEndpoint:       ptr("https://idp.example.com/userinfo"),
+		GroupsWithRoleIds:      &[]v4.OIDCGroupWrite{{Name: ptr("eng"), RoleIds: &[]string{"2"}}},
+		UserAttributesWithIds:  &[]v4.OIDCUserAttributeWrite{},
+		DefaultNewUserGroupIds: &[]string{},
+		DefaultNewUserRoleIds:  &[]string{},
+	}
+	config := v4.OIDCConfig{
+		Enabled:               ptr(true),
+		Issuer:                ptr("https://idp.example.com"),
+		Identifier:            ptr("looker"),
+		AuthorizationEndpoint: ptr("https://idp.example.com/authorize"),
+		TokenEndpoint:         ptr("https://idp.example.com/token"),
+		UserinfoEndpoint:      ptr("https://idp.example.com/userinfo"),
+		Scopes:                &[]string{"openid"},
+		GroupsWithRoleIds:     &[]v4.OIDCGroupWrite{{Name: ptr("eng"), LookerGroupName: ptr("eng"), RoleIds: &[]string{"2"}}},
+	}
+
+	api.EXPECT().UpdateOidcConfig(body, nil).Return(config, nil)
+	state, diags := tr.create(values)
+	requireNoErrors(t, "Create", diags)
+	var id, secret string
+	var mappings []oidcGroupMappingModel
+	tr.get(state, "id", &id)
+	tr.get(state, "secret", &secret)
+	tr.get(state, "group_mappings", &mappings)
+	if id != oidcConfigID || secret != "s3cret" {
+		t.Errorf("id, secret = %q, %q, want %q, s3cret", id, secret, oidcConfigID)
+	}
+	if len(mappings) != 1 || !mappings[0].LookerGroupName.IsNull() {
+		t.Errorf("group_mappings = %v, want eng with a null looker_group_name", mappings)
+	}
+
+	api.EXPECT().OidcConfig(nil).Return(config, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+
+	values["id"] = oidcConfigID
+	values["scopes"] = []string{"openid", "email"}
+	body.Scopes = &[]string{"openid", "email"}
+	config.Scopes = &[]string{"openid", "email"}
+	api.EXPECT().UpdateOidcConfig(body, nil).Return(config, nil)
+	state, diags = tr.update(state, values)
+	requireNoErrors(t, "Update", diags)
+	var scopes []string
+	tr.get(state, "scopes", &scopes)
+	if !sameStrings(scopes, []string{"openid", "email"}) {
+		t.Errorf("scopes = %v, want [openid email]", scopes)
+	}
+
+	api.EXPECT().UpdateOidcConfig(v4.WriteOIDCConfig{Enabled: ptr(false)}, nil).Return(v4.OIDCConfig{}, nil)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestOIDCConfigResourceReadNotFound(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &oidcConfigResource{baseResource{client: client}})
+
+	// The OIDC config is a singleton that always exists, so any error while
+	// reading it is reported rather than removing the resource.
+	api.EXPECT().OidcConfig(nil).Return(v4.OIDCConfig{}, errNotFound)
+	_, diags := tr.read(tr.state(map[string]any{"id": oidcConfigID, "enabled": true}))
+	if !diags.HasError() || diags.Errors()[0].Summary() != "API error" {
+		t.Errorf("diagnostics = %v, want an API error", diags)
+	}
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,24 +18,27 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &permissionSetResource{}
-	_ resource.ResourceWithConfigure   = &permissionSetResource{}
-	_ resource.ResourceWithImportState = &permissionSetResource{}
+	_ resource.Resource                 = &permissionSetResource{}
+	_ resource.ResourceWithConfigure    = &permissionSetResource{}
+	_ resource.ResourceWithImportState  = &permissionSetResource{}
+	_ resource.ResourceWithModifyPlan   = &permissionSetResource{}
+	_ resource.ResourceWithUpgradeState = &permissionSetResource{}
 )
 
 // permissionSetResource is the resource implementation.
 type permissionSetResource struct {
-	sdk *v4.LookerSDK
+	baseResource
 }
 
 // permissionSetResourceModel maps the resource schema data.
 type permissionSetResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Permissions types.Set    `tfsdk:"permissions"`
-	BuiltIn     types.Bool   `tfsdk:"built_in"`
-	AllAccess   types.Bool   `tfsdk:"all_access"`
-	URL         types.String `tfsdk:"url"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Permissions types.Set      `tfsdk:"permissions"`
+	BuiltIn     types.Bool     `tfsdk:"built_in"`
+	AllAccess   types.Bool     `tfsdk:"all_access"`
+	URL         types.String   `tfsdk:"url"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewPermissionSetResource is a helper function to simplify the provider implementation.
@@ -46,10 +52,11 @@ func (r *permissionSetResource) Metadata(_ context.Context, req resource.Metadat
 }
 
 // Schema defines the schema for the resource.
-func (r *permissionSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *permissionSetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages Looker permission sets.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the permission set.",
 				Computed:    true,
@@ -62,13 +69,16 @@ func (r *permissionSetResource) Schema(_ context.Context, _ resource.SchemaReque
 				Required:    true,
 			},
 			"permissions": schema.SetAttribute{
-				Description: "The permissions of the permission set.",
+				Description: "The permissions of the permission set. Looker also grants the parents of each permission, and every permission with `administer`; these implied permissions are not reported as drift.",
 				Required:    true,
 				ElementType: types.StringType,
 			},
 			"built_in": schema.BoolAttribute{
-				Description: "Whether the permission set is built-in.",
+				Description: "Whether the permission set is built into Looker, e.g. Admin. Built-in permission sets can be imported but not modified or deleted.",
 				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"all_access": schema.BoolAttribute{
 				Description: "Whether the permission set has all access.",
@@ -82,22 +92,20 @@ func (r *permissionSetResource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
-// Configure adds the provider configured client to the resource.
-func (r *permissionSetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if cb, ok := req.ProviderData.(*clientBundle); ok && cb.SDK != nil {
-		r.sdk = cb.SDK
-	} else if req.ProviderData != nil {
-		resp.Diagnostics.AddError("Unexpected provider data", "Missing Looker SDK client")
-	}
+// ModifyPlan refuses changes to built-in permission sets.
+func (r *permissionSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	refuseBuiltInChange(ctx, req, "permission set", &resp.Diagnostics)
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *permissionSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
 	// Retrieve values from plan
 	var plan permissionSetResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -115,7 +123,7 @@ func (r *permissionSetResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	// Create new permission set
-	ps, err := r.sdk.CreatePermissionSet(
+	ps, err := r.client.SDK(ctx).CreatePermissionSet(
 		v4.WritePermissionSet{
 			Name:        plan.Name.ValueStringPointer(),
 			Permissions: &permissions,
@@ -143,11 +151,13 @@ func (r *permissionSetResource) Create(ctx context.Context, req resource.CreateR
 
 // Read refreshes the Terraform state with the latest data.
 func (r *permissionSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
 	// Get current state
 	var state permissionSetResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -157,7 +167,7 @@ func (r *permissionSetResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Get refreshed permission set value from Looker
-	ps, err := r.sdk.PermissionSet(state.ID.ValueString(), "", nil)
+	ps, err := r.client.SDK(ctx).PermissionSet(state.ID.ValueString(), "", nil)
 	if err != nil {
 		// Handle not found error
 		resp.State.RemoveResource(ctx)
@@ -174,7 +184,7 @@ func (r *permissionSetResource) Read(ctx context.Context, req resource.ReadReque
 	if ps.Permissions != nil {
 		perms = *ps.Permissions
 	}
-	permsSet, diags := types.SetValueFrom(ctx, types.StringType, perms)
+	permsSet, diags := refreshPermissions(ctx, r.client.SDK(ctx), state.Permissions, perms)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
@@ -191,11 +201,13 @@ func (r *permissionSetResource) Read(ctx context.Context, req resource.ReadReque
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *permissionSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
 	// Retrieve values from plan
 	var plan permissionSetResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -211,6 +223,9 @@ func (r *permissionSetResource) Update(ctx context.Context, req resource.UpdateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if refuseBuiltIn(ctx, req.State, "permission set", "modified", &resp.Diagnostics) {
+		return
+	}
 
 	// Convert permissions from types.Set to []string
 	var permissions []string
@@ -221,7 +236,7 @@ func (r *permissionSetResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	// Update existing permission set
-	ps, err := r.sdk.UpdatePermissionSet(
+	ps, err := r.client.SDK(ctx).UpdatePermissionSet(
 		state.ID.ValueString(),
 		v4.WritePermissionSet{
 			Name:        plan.Name.ValueStringPointer(),
@@ -249,11 +264,13 @@ func (r *permissionSetResource) Update(ctx context.Context, req resource.UpdateR
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *permissionSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
 	// Retrieve values from state
 	var state permissionSetResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -262,15 +279,32 @@ func (r *permissionSetResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	if refuseBuiltIn(ctx, req.State, "permission set", "deleted", &resp.Diagnostics) {
+		return
+	}
+
 	// Delete existing permission set
-	_, err := r.sdk.DeletePermissionSet(state.ID.ValueString(), nil)
+	_, err := r.client.SDK(ctx).DeletePermissionSet(state.ID.ValueString(), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to delete permission set: %v", err))
 		return
 	}
 }
 
-// ImportState imports the resource into the Terraform state.
+// ImportState imports the resource into the Terraform state, by ID or by
+// name=<name>.
 func (r *permissionSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByName(ctx, r.client, "permission set", searchPermissionSetsByName, req, resp)
+}
+
+// searchPermissionSetsByName returns the permission sets matching the name
+// search pattern name.
+func searchPermissionSetsByName(sdk lookerapi.Client, name string) ([]namedObject, error) {
+	fields := "id,name"
+	sets, err := sdk.SearchPermissionSets(v4.RequestSearchPermissionSets{Name: &name, Fields: &fields}, nil)
+	objects := make([]namedObject, 0, len(sets))
+	for _, set := range sets {
+		objects = append(objects, namedObject{id: set.Id, name: set.Name})
+	}
+	return objects, err
 }
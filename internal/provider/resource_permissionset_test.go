@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"terraform-provider-looker/internal/lookertest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAccPermissionSetResource(t *testing.T) {
+	srv := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(srv, "looker_permission_set", lookertest.PermissionSets),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetConfig("tf-acc-test-permission-set", "access_data", "see_looks"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("looker_permission_set.test", "id"),
+					resource.TestCheckResourceAttr("looker_permission_set.test", "name", "tf-acc-test-permission-set"),
+					resource.TestCheckResourceAttr("looker_permission_set.test", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("looker_permission_set.test", "permissions.*", "see_looks"),
+					resource.TestCheckResourceAttr("looker_permission_set.test", "built_in", "false"),
+				),
+			},
+			{
+				ResourceName:      "looker_permission_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPermissionSetConfig("tf-acc-test-permission-set-renamed", "access_data", "see_looks", "see_user_dashboards"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_permission_set.test", "name", "tf-acc-test-permission-set-renamed"),
+					resource.TestCheckResourceAttr("looker_permission_set.test", "permissions.#", "3"),
+					resource.TestCheckTypeSetElemAttr("looker_permission_set.test", "permissions.*", "see_user_dashboards"),
+					testAccCheckStored(srv, "looker_permission_set.test", lookertest.PermissionSets, "name", "tf-acc-test-permission-set-renamed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPermissionSetConfig(name string, permissions ...string) string {
+	return fmt.Sprintf(`
+resource "looker_permission_set" "test" {
+  name        = %q
+  permissions = [%s]
+}
+`, name, testAccQuotedList(permissions))
+}
+
+// testAccQuotedList renders values as the elements of an HCL list.
+func testAccQuotedList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func TestPermissionSetResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &permissionSetResource{baseResource{client: client}})
+
+	api.EXPECT().CreatePermissionSet(v4.WritePermissionSet{
+		Name:        ptr("viewers"),
+		Permissions: &[]string{"access_data"},
+	}, nil).Return(v4.PermissionSet{
+		Id:          ptr("7"),
+		Name:        ptr("viewers"),
+		Permissions: &[]string{"access_data"},
+		BuiltIn:     ptr(false),
+		AllAccess:   ptr(false),
+		Url:         ptr("/api/4.0/permission_sets/7"),
+	}, nil)
+	state, diags := tr.create(map[string]any{"name": "viewers", "permissions": []string{"access_data"}})
+	requireNoErrors(t, "Create", diags)
+	var id string
+	tr.get(state, "id", &id)
+	if id != "7" {
+		t.Errorf("id = %q, want %q", id, "7")
+	}
+
+	api.EXPECT().PermissionSet("7", "", nil).Return(v4.PermissionSet{
+		Id:          ptr("7"),
+		Name:        ptr("viewers"),
+		Permissions: &[]string{"access_data"},
+		BuiltIn:     ptr(false),
+		AllAccess:   ptr(false),
+	}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var permissions []string
+	tr.get(state, "permissions", &permissions)
+	if !sameStrings(permissions, []string{"access_data"}) {
+		t.Errorf("permissions = %v, want [access_data]", permissions)
+	}
+
+	api.EXPECT().UpdatePermissionSet("7", v4.WritePermissionSet{
+		Name:        ptr("readers"),
+		Permissions: &[]string{"access_data"},
+	}, nil).Return(v4.PermissionSet{
+		Id:        ptr("7"),
+		Name:      ptr("readers"),
+		BuiltIn:   ptr(false),
+		AllAccess: ptr(false),
+	}, nil)
+	state, diags = tr.update(state, map[string]any{"id": "7", "name": "readers", "permissions": []string{"access_data"}})
+	requireNoErrors(t, "Update", diags)
+	var name string
+	tr.get(state, "name", &name)
+	if name != "readers" {
+		t.Errorf("name = %q, want %q", name, "readers")
+	}
+
+	api.EXPECT().DeletePermissionSet("7", nil).Return("", nil)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestPermissionSetResourceReadNotFound(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &permissionSetResource{baseResource{client: client}})
+
+	api.EXPECT().PermissionSet("7", "", nil).Return(v4.PermissionSet{}, errNotFound)
+	state, diags := tr.read(tr.state(map[string]any{"id": "7", "name": "viewers"}))
+	requireNoErrors(t, "Read", diags)
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want removed", state.Raw)
+	}
+}
+
+func TestPermissionSetResourceBuiltIn(t *testing.T) {
+	client, _ := newMockClient(t)
+	tr := newTestResource(t, &permissionSetResource{baseResource{client: client}})
+	state := tr.state(map[string]any{"id": "1", "name": "Admin", "built_in": true})
+
+	// The mock fails the test on any API call.
+	if _, diags := tr.update(state, map[string]any{"id": "1", "name": "Admins", "built_in": true}); !diags.HasError() {
+		t.Error("Update of a built-in permission set succeeded, want an error")
+	}
+	if diags := tr.delete(state); !diags.HasError() {
+		t.Error("Delete of a built-in permission set succeeded, want an error")
+	}
+}
+
+func TestPermissionSetResourceCreateError(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &permissionSetResource{baseResource{client: client}})
+
+	api.EXPECT().CreatePermissionSet(gomock.Any(), nil).Return(v4.PermissionSet{}, errors.New("response error. status=422 Unprocessable Entity"))
+	state, diags := tr.create(map[string]any{"name": "viewers", "permissions": []string{"nope"}})
+	if !diags.HasError() {
+		t.Fatal("Create succeeded, want an error")
+	}
+	if got := diags.Errors()[0].Summary(); got != "API error" {
+		t.Errorf("summary = %q, want %q", got, "API error")
+	}
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want none", state.Raw)
+	}
+}
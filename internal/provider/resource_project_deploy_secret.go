@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var (
+	_ resource.Resource                 = &projectDeploySecretResource{}
+	_ resource.ResourceWithConfigure    = &projectDeploySecretResource{}
+	_ resource.ResourceWithImportState  = &projectDeploySecretResource{}
+	_ resource.ResourceWithUpgradeState = &projectDeploySecretResource{}
+)
+
+// projectDeploySecretResource is the resource implementation.
+type projectDeploySecretResource struct {
+	baseResource
+}
+
+// projectDeploySecretModel maps the resource schema data.
+type projectDeploySecretModel struct {
+	ID        types.String   `tfsdk:"id"`
+	ProjectID types.String   `tfsdk:"project_id"`
+	Secret    types.String   `tfsdk:"secret"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewProjectDeploySecretResource is a helper function to simplify the provider implementation.
+func NewProjectDeploySecretResource() resource.Resource {
+	return &projectDeploySecretResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *projectDeploySecretResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_deploy_secret"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectDeploySecretResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sets the secret that authenticates requests to the deploy webhook of a LookML project. Change `secret` to rotate it; destroying the resource unsets the secret, leaving the webhook unauthenticated.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "The ID of the project.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the LookML project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"secret": schema.StringAttribute{
+				Description: "The secret token CI systems send with deploy webhook requests. Looker never returns it, so changes made outside Terraform are not detected.",
+				Required:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// inDevWorkspace runs fn with the API session in the dev workspace, which
+// Looker requires to change a project, and switches back to production after.
+func inDevWorkspace(sdk lookerapi.Client, fn func() error) error {
+	dev, production := "dev", "production"
+	if _, err := sdk.UpdateSession(v4.WriteApiSession{WorkspaceId: &dev}, nil); err != nil {
+		return fmt.Errorf("failed to switch to the dev workspace: %w", err)
+	}
+	fnErr := fn()
+	if _, err := sdk.UpdateSession(v4.WriteApiSession{WorkspaceId: &production}, nil); err != nil && fnErr == nil {
+		return fmt.Errorf("failed to switch back to the production workspace: %w", err)
+	}
+	return fnErr
+}
+
+// updateDeploySecret sets, or unsets when secret is nil, the deploy secret of a project.
+func (r *projectDeploySecretResource) updateDeploySecret(ctx context.Context, projectID string, secret *string) error {
+	body := v4.WriteProject{DeploySecret: secret}
+	if secret == nil {
+		unset := true
+		body.UnsetDeploySecret = &unset
+	}
+	sdk := r.client.SDK(ctx)
+	return inDevWorkspace(sdk, func() error {
+		_, err := sdk.UpdateProject(projectID, body, "id", nil)
+		return err
+	})
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *projectDeploySecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan projectDeploySecretModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := plan.ProjectID.ValueString()
+
+	if err := r.updateDeploySecret(ctx, projectID, plan.Secret.ValueStringPointer()); err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to set deploy secret of project %s: %v", projectID, err))
+		return
+	}
+
+	plan.ID = plan.ProjectID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data. The secret itself
+// cannot be read back, so only the existence of the project is checked.
+func (r *projectDeploySecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state projectDeploySecretModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := state.ProjectID.ValueString()
+
+	_, err := r.client.SDK(ctx).Project(projectID, "id", nil)
+	if isNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Project %s not found, removing from state", projectID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read project %s: %v", projectID, err))
+		return
+	}
+
+	state.ID = state.ProjectID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update rotates the secret.
+func (r *projectDeploySecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
+	var plan projectDeploySecretModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := plan.ProjectID.ValueString()
+
+	if err := r.updateDeploySecret(ctx, projectID, plan.Secret.ValueStringPointer()); err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to rotate deploy secret of project %s: %v", projectID, err))
+		return
+	}
+
+	plan.ID = plan.ProjectID
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete unsets the deploy secret of the project.
+func (r *projectDeploySecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
+	var state projectDeploySecretModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := state.ProjectID.ValueString()
+
+	if err := r.updateDeploySecret(ctx, projectID, nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to unset deploy secret of project %s: %v", projectID, err))
+		return
+	}
+}
+
+// ImportState imports the deploy secret of a project using its project ID.
+// The secret cannot be read back and is set again on the next apply.
+func (r *projectDeploySecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("project_id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
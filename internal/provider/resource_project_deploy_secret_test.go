@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-looker/internal/lookerapi/lookerapimock"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+// expectProjectUpdate expects body to be written to project 7 from the dev
+// workspace.
+func expectProjectUpdate(api *lookerapimock.MockClient, body v4.WriteProject, err error) {
+	gomock.InOrder(
+		api.EXPECT().UpdateSession(v4.WriteApiSession{WorkspaceId: ptr("dev")}, nil).Return(v4.ApiSession{}, nil),
+		api.EXPECT().UpdateProject("7", body, "id", nil).Return(v4.Project{Id: ptr("7")}, err),
+		api.EXPECT().UpdateSession(v4.WriteApiSession{WorkspaceId: ptr("production")}, nil).Return(v4.ApiSession{}, nil),
+	)
+}
+
+func TestProjectDeploySecretResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &projectDeploySecretResource{baseResource{client: client}})
+
+	expectProjectUpdate(api, v4.WriteProject{DeploySecret: ptr("first")}, nil)
+	state, diags := tr.create(map[string]any{"project_id": "7", "secret": "first"})
+	requireNoErrors(t, "Create", diags)
+	var id string
+	tr.get(state, "id", &id)
+	if id != "7" {
+		t.Errorf("id = %q, want %q", id, "7")
+	}
+
+	api.EXPECT().Project("7", "id", nil).Return(v4.Project{Id: ptr("7")}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+
+	expectProjectUpdate(api, v4.WriteProject{DeploySecret: ptr("second")}, nil)
+	state, diags = tr.update(state, map[string]any{"id": "7", "project_id": "7", "secret": "second"})
+	requireNoErrors(t, "Update", diags)
+
+	expectProjectUpdate(api, v4.WriteProject{UnsetDeploySecret: ptr(true)}, errNotFound)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestProjectDeploySecretResourceReadNotFound(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &projectDeploySecretResource{baseResource{client: client}})
+
+	api.EXPECT().Project("7", "id", nil).Return(v4.Project{}, errNotFound)
+	state, diags := tr.read(tr.state(map[string]any{"id": "7", "project_id": "7", "secret": "first"}))
+	requireNoErrors(t, "Read", diags)
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want removed", state.Raw)
+	}
+}
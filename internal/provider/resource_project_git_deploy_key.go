@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                 = &projectGitDeployKeyResource{}
+	_ resource.ResourceWithConfigure    = &projectGitDeployKeyResource{}
+	_ resource.ResourceWithImportState  = &projectGitDeployKeyResource{}
+	_ resource.ResourceWithUpgradeState = &projectGitDeployKeyResource{}
+)
+
+// projectGitDeployKeyResource is the resource implementation.
+type projectGitDeployKeyResource struct {
+	baseResource
+}
+
+// projectGitDeployKeyModel maps the resource and data source schema data.
+type projectGitDeployKeyModel struct {
+	ID        types.String   `tfsdk:"id"`
+	ProjectID types.String   `tfsdk:"project_id"`
+	PublicKey types.String   `tfsdk:"public_key"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewProjectGitDeployKeyResource is a helper function to simplify the provider implementation.
+func NewProjectGitDeployKeyResource() resource.Resource {
+	return &projectGitDeployKeyResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *projectGitDeployKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_git_deploy_key"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectGitDeployKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates the SSH deploy key Looker uses to access the git repository of a LookML project and exposes its public key. An existing key is adopted rather than regenerated. Looker cannot delete deploy keys, so destroying the resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "The ID of the project.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the LookML project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Description: "The SSH public key to register as a deploy key on the remote git repository.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *projectGitDeployKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan projectGitDeployKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := plan.ProjectID.ValueString()
+
+	publicKey, err := r.client.SDK(ctx).GitDeployKey(projectID, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read deploy key of project %s: %v", projectID, err))
+		return
+	}
+	if strings.TrimSpace(publicKey) == "" {
+		publicKey, err = r.client.SDK(ctx).CreateGitDeployKey(projectID, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to create deploy key for project %s: %v", projectID, err))
+			return
+		}
+	} else {
+		tflog.Info(ctx, fmt.Sprintf("Project %s already has a deploy key, adopting it", projectID))
+	}
+
+	plan.ID = plan.ProjectID
+	plan.PublicKey = types.StringValue(strings.TrimSpace(publicKey))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *projectGitDeployKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state projectGitDeployKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID := state.ProjectID.ValueString()
+
+	publicKey, err := r.client.SDK(ctx).GitDeployKey(projectID, nil)
+	if isNotFound(err) || (err == nil && strings.TrimSpace(publicKey) == "") {
+		tflog.Warn(ctx, fmt.Sprintf("Deploy key of project %s not found, removing from state", projectID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read deploy key of project %s: %v", projectID, err))
+		return
+	}
+
+	state.ID = state.ProjectID
+	state.PublicKey = types.StringValue(strings.TrimSpace(publicKey))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called: project_id requires replacement.
+func (r *projectGitDeployKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan projectGitDeployKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the resource from state; Looker has no API to delete a deploy key.
+func (r *projectGitDeployKeyResource) Delete(ctx context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Warn(ctx, "Deleting a 'looker_project_git_deploy_key' does not remove the key from Looker or from the git remote. Revoke it on the git remote if needed.")
+}
+
+// ImportState imports the deploy key of a project using its project ID.
+func (r *projectGitDeployKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("project_id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
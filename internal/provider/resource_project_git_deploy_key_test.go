@@ -0,0 +1,65 @@
+package provider
+
+import "testing"
+
+func TestProjectGitDeployKeyResourceCRUD(t *testing.T) {
+	tests := map[string]struct {
+		existing string
+		create   bool
+	}{
+		"new key":      {create: true},
+		"existing key": {existing: "ssh-rsa AAAA existing\n"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, api := newMockClient(t)
+			tr := newTestResource(t, &projectGitDeployKeyResource{baseResource{client: client}})
+			want := "ssh-rsa AAAA existing"
+
+			if test.create {
+				want = "ssh-rsa AAAA created"
+				api.EXPECT().GitDeployKey("7", nil).Return("", errNotFound)
+				api.EXPECT().CreateGitDeployKey("7", nil).Return(want+"\n", nil)
+			} else {
+				api.EXPECT().GitDeployKey("7", nil).Return(test.existing, nil)
+			}
+			state, diags := tr.create(map[string]any{"project_id": "7"})
+			requireNoErrors(t, "Create", diags)
+			var publicKey string
+			tr.get(state, "public_key", &publicKey)
+			if publicKey != want {
+				t.Errorf("public_key = %q, want %q", publicKey, want)
+			}
+
+			api.EXPECT().GitDeployKey("7", nil).Return(want, nil)
+			state, diags = tr.read(state)
+			requireNoErrors(t, "Read", diags)
+
+			// Looker has no API to delete a deploy key.
+			requireNoErrors(t, "Delete", tr.delete(state))
+		})
+	}
+}
+
+func TestProjectGitDeployKeyResourceReadNotFound(t *testing.T) {
+	tests := map[string]struct {
+		publicKey string
+		err       error
+	}{
+		"project deleted": {err: errNotFound},
+		"no key":          {publicKey: " \n"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, api := newMockClient(t)
+			tr := newTestResource(t, &projectGitDeployKeyResource{baseResource{client: client}})
+
+			api.EXPECT().GitDeployKey("7", nil).Return(test.publicKey, test.err)
+			state, diags := tr.read(tr.state(map[string]any{"id": "7", "project_id": "7", "public_key": "ssh-rsa AAAA"}))
+			requireNoErrors(t, "Read", diags)
+			if !state.Raw.IsNull() {
+				t.Errorf("state = %v, want removed", state.Raw)
+			}
+		})
+	}
+}
@@ -0,0 +1,384 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var (
+	_ resource.Resource                 = &roleBundleResource{}
+	_ resource.ResourceWithConfigure    = &roleBundleResource{}
+	_ resource.ResourceWithImportState  = &roleBundleResource{}
+	_ resource.ResourceWithUpgradeState = &roleBundleResource{}
+)
+
+// roleBundleResource manages a role together with the permission set and the
+// model set it uses.
+type roleBundleResource struct {
+	baseResource
+}
+
+// roleBundleResourceModel maps the resource schema data.
+type roleBundleResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	PermissionSetName types.String   `tfsdk:"permission_set_name"`
+	Permissions       types.Set      `tfsdk:"permissions"`
+	ModelSetName      types.String   `tfsdk:"model_set_name"`
+	Models            types.Set      `tfsdk:"models"`
+	PermissionSetID   types.String   `tfsdk:"permission_set_id"`
+	ModelSetID        types.String   `tfsdk:"model_set_id"`
+	URL               types.String   `tfsdk:"url"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewRoleBundleResource is a helper function to simplify the provider implementation.
+func NewRoleBundleResource() resource.Resource {
+	return &roleBundleResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *roleBundleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_bundle"
+}
+
+// Schema defines the schema for the resource.
+func (r *roleBundleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Looker role together with a permission set and a model set used only by it. They are created, updated and deleted as one; when creating one of them fails, those already created are removed again.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the role.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the role.",
+				Required:    true,
+			},
+			"permission_set_name": schema.StringAttribute{
+				Description: "The name of the permission set.",
+				Required:    true,
+			},
+			"permissions": schema.SetAttribute{
+				Description: "The permissions of the permission set.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"model_set_name": schema.StringAttribute{
+				Description: "The name of the model set.",
+				Required:    true,
+			},
+			"models": schema.SetAttribute{
+				Description: "The models of the model set.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"permission_set_id": schema.StringAttribute{
+				Description: "The ID of the permission set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"model_set_id": schema.StringAttribute{
+				Description: "The ID of the model set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL of the role.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// bundleSets returns the bodies of the permission set and model set of plan.
+func bundleSets(ctx context.Context, plan *roleBundleResourceModel) (v4.WritePermissionSet, v4.WriteModelSet, diag.Diagnostics) {
+	var permissions, models []string
+	diags := plan.Permissions.ElementsAs(ctx, &permissions, false)
+	diags.Append(plan.Models.ElementsAs(ctx, &models, false)...)
+	return v4.WritePermissionSet{
+		Name:        plan.PermissionSetName.ValueStringPointer(),
+		Permissions: &permissions,
+	}, v4.WriteModelSet{
+		Name:   plan.ModelSetName.ValueStringPointer(),
+		Models: &models,
+	}, diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *roleBundleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan roleBundleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	permissionSet, modelSet, diags := bundleSets(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sdk := r.client.SDK(ctx)
+	var undo []func() error
+
+	ps, err := sdk.CreatePermissionSet(permissionSet, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to create permission set of role bundle: %v", err))
+		return
+	}
+	psID := *ps.Id
+	undo = append(undo, func() error {
+		_, err := sdk.DeletePermissionSet(psID, nil)
+		return err
+	})
+
+	ms, err := sdk.CreateModelSet(modelSet, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to create model set of role bundle: %v", err))
+		undoInlineSets(undo, &resp.Diagnostics)
+		return
+	}
+	msID := *ms.Id
+	undo = append(undo, func() error {
+		_, err := sdk.DeleteModelSet(msID, nil)
+		return err
+	})
+
+	role, err := sdk.CreateRole(v4.WriteRole{
+		Name:            plan.Name.ValueStringPointer(),
+		PermissionSetId: &psID,
+		ModelSetId:      &msID,
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to create role of role bundle: %v", err))
+		undoInlineSets(undo, &resp.Diagnostics)
+		return
+	}
+
+	plan.ID = types.StringPointerValue(role.Id)
+	plan.PermissionSetID = types.StringValue(psID)
+	plan.ModelSetID = types.StringValue(msID)
+	plan.URL = types.StringPointerValue(role.Url)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *roleBundleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state roleBundleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sdk := r.client.SDK(ctx)
+	role, err := sdk.Role(state.ID.ValueString(), nil)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read role of role bundle: %v", err))
+		return
+	}
+	state.Name = types.StringPointerValue(role.Name)
+	state.URL = types.StringPointerValue(role.Url)
+	// After an import only the role ID is known; the sets are those of the
+	// role.
+	if state.PermissionSetID.IsNull() && role.PermissionSet != nil {
+		state.PermissionSetID = types.StringPointerValue(role.PermissionSet.Id)
+	}
+	if state.ModelSetID.IsNull() && role.ModelSet != nil {
+		state.ModelSetID = types.StringPointerValue(role.ModelSet.Id)
+	}
+
+	// A set deleted outside Terraform is recreated by the next apply.
+	ps, err := sdk.PermissionSet(state.PermissionSetID.ValueString(), "", nil)
+	switch {
+	case isNotFound(err):
+		state.PermissionSetName = types.StringValue("")
+	case err != nil:
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read permission set of role bundle: %v", err))
+		return
+	default:
+		state.PermissionSetName = types.StringPointerValue(ps.Name)
+		var permissions []string
+		if ps.Permissions != nil {
+			permissions = *ps.Permissions
+		}
+		state.Permissions, diags = refreshPermissions(ctx, sdk, state.Permissions, permissions)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	ms, err := sdk.ModelSet(state.ModelSetID.ValueString(), "", nil)
+	switch {
+	case isNotFound(err):
+		state.ModelSetName = types.StringValue("")
+	case err != nil:
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read model set of role bundle: %v", err))
+		return
+	default:
+		state.ModelSetName = types.StringPointerValue(ms.Name)
+		var models []string
+		if ms.Models != nil {
+			models = *ms.Models
+		}
+		state.Models, diags = types.SetValueFrom(ctx, types.StringType, models)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *roleBundleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
+	var plan, state roleBundleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	permissionSet, modelSet, diags := bundleSets(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sdk := r.client.SDK(ctx)
+	psID := state.PermissionSetID.ValueString()
+	if _, err := sdk.UpdatePermissionSet(psID, permissionSet, nil); isNotFound(err) {
+		ps, err := sdk.CreatePermissionSet(permissionSet, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to recreate permission set of role bundle: %v", err))
+			return
+		}
+		psID = *ps.Id
+	} else if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to update permission set of role bundle: %v", err))
+		return
+	}
+
+	msID := state.ModelSetID.ValueString()
+	if _, err := sdk.UpdateModelSet(msID, modelSet, nil); isNotFound(err) {
+		ms, err := sdk.CreateModelSet(modelSet, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to recreate model set of role bundle: %v", err))
+			return
+		}
+		msID = *ms.Id
+	} else if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to update model set of role bundle: %v", err))
+		return
+	}
+
+	role, err := sdk.UpdateRole(state.ID.ValueString(), v4.WriteRole{
+		Name:            plan.Name.ValueStringPointer(),
+		PermissionSetId: &psID,
+		ModelSetId:      &msID,
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to update role of role bundle: %v", err))
+		return
+	}
+
+	plan.ID = types.StringPointerValue(role.Id)
+	plan.PermissionSetID = types.StringValue(psID)
+	plan.ModelSetID = types.StringValue(msID)
+	plan.URL = types.StringPointerValue(role.Url)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the role, then its sets, which Looker refuses to delete
+// while a role uses them.
+func (r *roleBundleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
+	var state roleBundleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sdk := r.client.SDK(ctx)
+	if _, err := sdk.DeleteRole(state.ID.ValueString(), nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to delete role of role bundle: %v", err))
+		return
+	}
+	if _, err := sdk.DeletePermissionSet(state.PermissionSetID.ValueString(), nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to delete permission set of role bundle: %v", err))
+	}
+	if _, err := sdk.DeleteModelSet(state.ModelSetID.ValueString(), nil); err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to delete model set of role bundle: %v", err))
+	}
+}
+
+// ImportState imports the resource into the Terraform state by the ID of the
+// role; its permission set and model set are then managed with it.
+func (r *roleBundleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"testing"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRoleBundleResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &roleBundleResource{baseResource{client: client}})
+	values := map[string]any{
+		"name":                "Analyst",
+		"permission_set_name": "Analyst permissions",
+		"permissions":         []string{"access_data"},
+		"model_set_name":      "Analyst models",
+		"models":              []string{"shop"},
+	}
+	permissionSet := v4.WritePermissionSet{Name: ptr("Analyst permissions"), Permissions: &[]string{"access_data"}}
+	modelSet := v4.WriteModelSet{Name: ptr("Analyst models"), Models: &[]string{"shop"}}
+
+	gomock.InOrder(
+		api.EXPECT().CreatePermissionSet(permissionSet, nil).Return(v4.PermissionSet{Id: ptr("4")}, nil),
+		api.EXPECT().CreateModelSet(modelSet, nil).Return(v4.ModelSet{Id: ptr("5")}, nil),
+		api.EXPECT().CreateRole(v4.WriteRole{Name: ptr("Analyst"), PermissionSetId: ptr("4"), ModelSetId: ptr("5")}, nil).
+			Return(v4.Role{Id: ptr("6"), Url: ptr("/api/4.0/roles/6")}, nil),
+	)
+	state, diags := tr.create(values)
+	requireNoErrors(t, "Create", diags)
+	var id, permissionSetID, modelSetID string
+	tr.get(state, "id", &id)
+	tr.get(state, "permission_set_id", &permissionSetID)
+	tr.get(state, "model_set_id", &modelSetID)
+	if id != "6" || permissionSetID != "4" || modelSetID != "5" {
+		t.Errorf("id, permission_set_id, model_set_id = %q, %q, %q, want 6, 4, 5", id, permissionSetID, modelSetID)
+	}
+
+	api.EXPECT().Role("6", nil).Return(v4.Role{Id: ptr("6"), Name: ptr("Analyst"), Url: ptr("/api/4.0/roles/6")}, nil)
+	api.EXPECT().PermissionSet("4", "", nil).Return(v4.PermissionSet{Id: ptr("4"), Name: ptr("Analyst permissions"), Permissions: &[]string{"access_data"}}, nil)
+	api.EXPECT().ModelSet("5", "", nil).Return(v4.ModelSet{Id: ptr("5"), Name: ptr("Analyst models"), Models: &[]string{"shop"}}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+
+	// The model set was deleted outside Terraform and is recreated.
+	values["id"] = "6"
+	values["models"] = []string{"shop", "finance"}
+	values["permission_set_id"] = "4"
+	values["model_set_id"] = "5"
+	modelSet.Models = &[]string{"shop", "finance"}
+	gomock.InOrder(
+		api.EXPECT().UpdatePermissionSet("4", permissionSet, nil).Return(v4.PermissionSet{Id: ptr("4")}, nil),
+		api.EXPECT().UpdateModelSet("5", gomock.Any(), nil).Return(v4.ModelSet{}, errNotFound),
+		api.EXPECT().CreateModelSet(gomock.Any(), nil).DoAndReturn(func(body v4.WriteModelSet, _ any) (v4.ModelSet, error) {
+			if !sameStrings(*body.Models, *modelSet.Models) {
+				t.Errorf("models = %v, want %v", *body.Models, *modelSet.Models)
+			}
+			return v4.ModelSet{Id: ptr("8")}, nil
+		}),
+		api.EXPECT().UpdateRole("6", v4.WriteRole{Name: ptr("Analyst"), PermissionSetId: ptr("4"), ModelSetId: ptr("8")}, nil).
+			Return(v4.Role{Id: ptr("6"), Url: ptr("/api/4.0/roles/6")}, nil),
+	)
+	state, diags = tr.update(state, values)
+	requireNoErrors(t, "Update", diags)
+	tr.get(state, "model_set_id", &modelSetID)
+	if modelSetID != "8" {
+		t.Errorf("model_set_id = %q, want %q", modelSetID, "8")
+	}
+
+	gomock.InOrder(
+		api.EXPECT().DeleteRole("6", nil).Return("", nil),
+		api.EXPECT().DeletePermissionSet("4", nil).Return("", nil),
+		api.EXPECT().DeleteModelSet("8", nil).Return("", errNotFound),
+	)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestRoleBundleResourceCreateUndo(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &roleBundleResource{baseResource{client: client}})
+
+	gomock.InOrder(
+		api.EXPECT().CreatePermissionSet(gomock.Any(), nil).Return(v4.PermissionSet{Id: ptr("4")}, nil),
+		api.EXPECT().CreateModelSet(gomock.Any(), nil).Return(v4.ModelSet{Id: ptr("5")}, nil),
+		api.EXPECT().CreateRole(gomock.Any(), nil).Return(v4.Role{}, errNotFound),
+	)
+	api.EXPECT().DeletePermissionSet("4", nil).Return("", nil)
+	api.EXPECT().DeleteModelSet("5", nil).Return("", nil)
+	state, diags := tr.create(map[string]any{
+		"name":                "Analyst",
+		"permission_set_name": "Analyst permissions",
+		"permissions":         []string{"access_data"},
+		"model_set_name":      "Analyst models",
+		"models":              []string{"shop"},
+	})
+	if !diags.HasError() {
+		t.Fatal("Create succeeded, want an error")
+	}
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want none", state.Raw)
+	}
+}
+
+func TestRoleBundleResourceReadNotFound(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &roleBundleResource{baseResource{client: client}})
+
+	api.EXPECT().Role("6", nil).Return(v4.Role{}, errNotFound)
+	state, diags := tr.read(tr.state(map[string]any{"id": "6", "name": "Analyst", "permission_set_id": "4", "model_set_id": "5"}))
+	requireNoErrors(t, "Read", diags)
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want removed", state.Raw)
+	}
+}
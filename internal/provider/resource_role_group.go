@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                 = &roleGroupResource{}
+	_ resource.ResourceWithConfigure    = &roleGroupResource{}
+	_ resource.ResourceWithImportState  = &roleGroupResource{}
+	_ resource.ResourceWithModifyPlan   = &roleGroupResource{}
+	_ resource.ResourceWithUpgradeState = &roleGroupResource{}
+)
+
+// roleGroupResource is the resource implementation.
+type roleGroupResource struct {
+	baseResource
+}
+
+// roleGroupResourceModel maps the resource schema data.
+type roleGroupResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	RoleID  types.String `tfsdk:"role_id"`
+	GroupID types.String `tfsdk:"group_id"`
+
+	AllowAllUsers types.Bool     `tfsdk:"allow_all_users"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewRoleGroupResource is a helper function to simplify the provider implementation.
+func NewRoleGroupResource() resource.Resource {
+	return &roleGroupResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *roleGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *roleGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assigns a single group to a single Looker role, leaving the other groups of the role alone. Do not combine with `looker_role_groups` or `looker_group_role_assignments` for the same role.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "The role and group IDs, as `<role_id>/<group_id>`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Description: "The ID of the role.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Description: "The ID of the group to assign to the role.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allow_all_users": allowAllUsersAttribute,
+		},
+	}
+}
+
+// ModifyPlan warns when the role is about to be given to a group that holds
+// every user, unless allow_all_users is set.
+func (r *roleGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan roleGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.AllowAllUsers.ValueBool() || plan.GroupID.IsUnknown() {
+		return
+	}
+
+	warnAllUsersGroups(r.client.SDK(ctx), plan.RoleID.ValueString(), []string{plan.GroupID.ValueString()}, path.Root("group_id"), &resp.Diagnostics)
+}
+
+// roleGroupIDs returns the IDs of the groups currently assigned to a role.
+func roleGroupIDs(sdk lookerapi.Client, roleID string) ([]string, error) {
+	groups, err := sdk.RoleGroups(roleID, "id", nil)
+	if err != nil {
+		return nil, err
+	}
+	var groupIDs []string
+	for _, group := range groups {
+		groupIDs = append(groupIDs, *group.Id)
+	}
+	return groupIDs, nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *roleGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan roleGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	roleID := plan.RoleID.ValueString()
+	groupID := plan.GroupID.ValueString()
+
+	// Looker only replaces the whole group list of a role, so add the group
+	// to the current list.
+	groupIDs, err := roleGroupIDs(r.client.SDK(ctx), roleID)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read groups for role %s: %v", roleID, err))
+		return
+	}
+	if len(stringsNotIn([]string{groupID}, groupIDs)) > 0 {
+		_, err = r.client.SDK(ctx).SetRoleGroups(roleID, append(groupIDs, groupID), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to add group %s to role %s: %v", groupID, roleID, err))
+			return
+		}
+	} else {
+		tflog.Info(ctx, fmt.Sprintf("Group %s already has role %s", groupID, roleID))
+	}
+
+	plan.ID = types.StringValue(roleID + "/" + groupID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *roleGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state roleGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	roleID := state.RoleID.ValueString()
+	groupID := state.GroupID.ValueString()
+
+	groupIDs, err := roleGroupIDs(r.client.SDK(ctx), roleID)
+	if isNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Role %s not found, removing from state", roleID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read groups for role %s: %v", roleID, err))
+		return
+	}
+	if len(stringsNotIn([]string{groupID}, groupIDs)) > 0 {
+		tflog.Warn(ctx, fmt.Sprintf("Group %s no longer has role %s, removing from state", groupID, roleID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(roleID + "/" + groupID)
+	if state.AllowAllUsers.IsNull() {
+		state.AllowAllUsers = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only stores allow_all_users; the other attributes require replacement.
+func (r *roleGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan roleGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the group from the role, keeping the other groups.
+func (r *roleGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
+	var state roleGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	roleID := state.RoleID.ValueString()
+	groupID := state.GroupID.ValueString()
+
+	groupIDs, err := roleGroupIDs(r.client.SDK(ctx), roleID)
+	if isNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read groups for role %s: %v", roleID, err))
+		return
+	}
+	remaining := stringsNotIn(groupIDs, []string{groupID})
+	if len(remaining) == len(groupIDs) {
+		return
+	}
+	if remaining == nil {
+		remaining = []string{}
+	}
+	_, err = r.client.SDK(ctx).SetRoleGroups(roleID, remaining, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to remove group %s from role %s: %v", groupID, roleID, err))
+		return
+	}
+}
+
+// ImportState imports the resource using "<role_id>/<group_id>".
+func (r *roleGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <role_id>/<group_id>. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), parts[1])...)
+}
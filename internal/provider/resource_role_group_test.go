@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"testing"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRoleGroupResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &roleGroupResource{baseResource{client: client}})
+
+	// Looker replaces the whole group list, so the other groups are kept.
+	gomock.InOrder(
+		api.EXPECT().RoleGroups("1", "id", nil).Return([]v4.Group{{Id: ptr("3")}}, nil),
+		api.EXPECT().SetRoleGroups("1", []string{"3", "5"}, nil).Return(nil, nil),
+	)
+	state, diags := tr.create(map[string]any{"role_id": "1", "group_id": "5", "allow_all_users": false})
+	requireNoErrors(t, "Create", diags)
+	var id string
+	tr.get(state, "id", &id)
+	if id != "1/5" {
+		t.Errorf("id = %q, want %q", id, "1/5")
+	}
+
+	api.EXPECT().RoleGroups("1", "id", nil).Return([]v4.Group{{Id: ptr("3")}, {Id: ptr("5")}}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+
+	state, diags = tr.update(state, map[string]any{"id": "1/5", "role_id": "1", "group_id": "5", "allow_all_users": true})
+	requireNoErrors(t, "Update", diags)
+	var allowAllUsers bool
+	tr.get(state, "allow_all_users", &allowAllUsers)
+	if !allowAllUsers {
+		t.Error("allow_all_users = false, want true")
+	}
+
+	gomock.InOrder(
+		api.EXPECT().RoleGroups("1", "id", nil).Return([]v4.Group{{Id: ptr("3")}, {Id: ptr("5")}}, nil),
+		api.EXPECT().SetRoleGroups("1", []string{"3"}, nil).Return(nil, nil),
+	)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestRoleGroupResourceReadNotFound(t *testing.T) {
+	tests := map[string]struct {
+		groups []v4.Group
+		err    error
+	}{
+		"role deleted":     {err: errNotFound},
+		"group unassigned": {groups: []v4.Group{{Id: ptr("3")}}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, api := newMockClient(t)
+			tr := newTestResource(t, &roleGroupResource{baseResource{client: client}})
+
+			api.EXPECT().RoleGroups("1", "id", nil).Return(test.groups, test.err)
+			state, diags := tr.read(tr.state(map[string]any{"id": "1/5", "role_id": "1", "group_id": "5", "allow_all_users": false}))
+			requireNoErrors(t, "Read", diags)
+			if !state.Raw.IsNull() {
+				t.Errorf("state = %v, want removed", state.Raw)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+var (
+	_ resource.Resource                 = &roleUsersResource{}
+	_ resource.ResourceWithConfigure    = &roleUsersResource{}
+	_ resource.ResourceWithImportState  = &roleUsersResource{}
+	_ resource.ResourceWithUpgradeState = &roleUsersResource{}
+)
+
+// roleUsersResource is the resource implementation.
+type roleUsersResource struct {
+	baseResource
+}
+
+// roleUsersResourceModel maps the resource schema data.
+type roleUsersResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	RoleID   types.String   `tfsdk:"role_id"`
+	UserIDs  types.Set      `tfsdk:"user_ids"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewRoleUsersResource is a helper function to simplify the provider implementation.
+func NewRoleUsersResource() resource.Resource {
+	return &roleUsersResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *roleUsersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_users"
+}
+
+// Schema defines the schema for the resource.
+func (r *roleUsersResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the complete set of users directly assigned to a single Looker role. Users holding the role through a group are not affected.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Description: "The ID of the role.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_ids": schema.SetAttribute{
+				Description: "The IDs of the users to assign directly to the role. Any other direct assignment is removed.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// setRoleUsers is a helper function for Create and Update.
+func (r *roleUsersResource) setRoleUsers(ctx context.Context, plan *roleUsersResourceModel) error {
+	var userIDs []string
+	diags := plan.UserIDs.ElementsAs(ctx, &userIDs, false)
+	if diags.HasError() {
+		return fmt.Errorf("could not get user IDs from plan")
+	}
+
+	_, err := r.client.SDK(ctx).SetRoleUsers(plan.RoleID.ValueString(), userIDs, nil)
+	return err
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *roleUsersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan roleUsersResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setRoleUsers(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to set users for role %s: %v", plan.RoleID.ValueString(), err))
+		return
+	}
+
+	plan.ID = plan.RoleID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *roleUsersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state roleUsersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	roleID := state.RoleID.ValueString()
+
+	// Users holding the role only through a group are managed by looker_role_groups.
+	fields := "id"
+	directOnly := true
+	users, err := r.client.SDK(ctx).RoleUsers(v4.RequestRoleUsers{RoleId: roleID, Fields: &fields, DirectAssociationOnly: &directOnly}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read users for role %s: %v", roleID, err))
+		return
+	}
+
+	var userIDs []string
+	for _, user := range users {
+		userIDs = append(userIDs, *user.Id)
+	}
+
+	userIDsSet, diags := types.SetValueFrom(ctx, types.StringType, userIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.UserIDs = userIDsSet
+	state.ID = state.RoleID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *roleUsersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
+	var plan roleUsersResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setRoleUsers(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to update users for role %s: %v", plan.RoleID.ValueString(), err))
+		return
+	}
+
+	plan.ID = plan.RoleID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource. This means setting the users for the role to an empty list.
+func (r *roleUsersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
+	var state roleUsersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.SDK(ctx).SetRoleUsers(state.RoleID.ValueString(), []string{}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to clear users for role %s: %v", state.RoleID.ValueString(), err))
+		return
+	}
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *roleUsersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("role_id"), req, resp)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestRoleUsersResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &roleUsersResource{baseResource{client: client}})
+	request := v4.RequestRoleUsers{RoleId: "1", Fields: ptr("id"), DirectAssociationOnly: ptr(true)}
+
+	api.EXPECT().SetRoleUsers("1", []string{"10"}, nil).Return(nil, nil)
+	state, diags := tr.create(map[string]any{"role_id": "1", "user_ids": []string{"10"}})
+	requireNoErrors(t, "Create", diags)
+	var id string
+	tr.get(state, "id", &id)
+	if id != "1" {
+		t.Errorf("id = %q, want %q", id, "1")
+	}
+
+	// Users holding the role through a group are not read.
+	api.EXPECT().RoleUsers(request, nil).Return([]v4.User{{Id: ptr("10")}, {Id: ptr("11")}}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var userIDs []string
+	tr.get(state, "user_ids", &userIDs)
+	if !sameStrings(userIDs, []string{"10", "11"}) {
+		t.Errorf("user_ids = %v, want [10 11]", userIDs)
+	}
+
+	api.EXPECT().SetRoleUsers("1", []string{"12"}, nil).Return(nil, nil)
+	state, diags = tr.update(state, map[string]any{"id": "1", "role_id": "1", "user_ids": []string{"12"}})
+	requireNoErrors(t, "Update", diags)
+
+	api.EXPECT().SetRoleUsers("1", []string{}, nil).Return(nil, nil)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestRoleUsersResourceReadNotFound(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &roleUsersResource{baseResource{client: client}})
+
+	// Read does not tell a deleted role apart from other errors.
+	api.EXPECT().RoleUsers(v4.RequestRoleUsers{RoleId: "1", Fields: ptr("id"), DirectAssociationOnly: ptr(true)}, nil).Return(nil, errNotFound)
+	_, diags := tr.read(tr.state(map[string]any{"id": "1", "role_id": "1", "user_ids": []string{"10"}}))
+	if !diags.HasError() || diags.Errors()[0].Summary() != "API error" {
+		t.Errorf("diagnostics = %v, want an API error", diags)
+	}
+}
@@ -3,34 +3,221 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
 )
 
 var (
-	_ resource.Resource                = &roleResource{}
-	_ resource.ResourceWithConfigure   = &roleResource{}
-	_ resource.ResourceWithImportState = &roleResource{}
+	_ resource.Resource                 = &roleResource{}
+	_ resource.ResourceWithConfigure    = &roleResource{}
+	_ resource.ResourceWithImportState  = &roleResource{}
+	_ resource.ResourceWithModifyPlan   = &roleResource{}
+	_ resource.ResourceWithUpgradeState = &roleResource{}
 )
 
 // roleResource is the resource implementation.
 type roleResource struct {
-	sdk *v4.LookerSDK
+	baseResource
 }
 
 // roleResourceModel maps the resource schema data.
 type roleResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	PermissionSetID types.String `tfsdk:"permission_set_id"`
-	ModelSetID      types.String `tfsdk:"model_set_id"`
-	URL             types.String `tfsdk:"url"`
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	PermissionSetID   types.String   `tfsdk:"permission_set_id"`
+	PermissionSetName types.String   `tfsdk:"permission_set_name"`
+	ModelSetID        types.String   `tfsdk:"model_set_id"`
+	ModelSetName      types.String   `tfsdk:"model_set_name"`
+	Permissions       types.Set      `tfsdk:"permissions"`
+	Models            types.Set      `tfsdk:"models"`
+	URL               types.String   `tfsdk:"url"`
+	Capabilities      types.Object   `tfsdk:"capabilities"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// roleCapabilitiesAttrTypes describes the capabilities summary of a role.
+var roleCapabilitiesAttrTypes = map[string]attr.Type{
+	"permission_set_name": types.StringType,
+	"permissions":         types.ListType{ElemType: types.StringType},
+	"model_set_name":      types.StringType,
+	"models":              types.ListType{ElemType: types.StringType},
+}
+
+// roleCapabilities summarizes the permission set and model set attached to a
+// role, as returned by the role endpoint. Lists are sorted to keep diffs stable.
+func roleCapabilities(ctx context.Context, role *v4.Role) (types.Object, diag.Diagnostics) {
+	var permissionSetName, modelSetName *string
+	permissions, models := []string{}, []string{}
+	if role.PermissionSet != nil {
+		permissionSetName = role.PermissionSet.Name
+		if role.PermissionSet.Permissions != nil {
+			permissions = append(permissions, *role.PermissionSet.Permissions...)
+		}
+	}
+	if role.ModelSet != nil {
+		modelSetName = role.ModelSet.Name
+		if role.ModelSet.Models != nil {
+			models = append(models, *role.ModelSet.Models...)
+		}
+	}
+	sort.Strings(permissions)
+	sort.Strings(models)
+
+	var diags diag.Diagnostics
+	permissionList, d := types.ListValueFrom(ctx, types.StringType, permissions)
+	diags.Append(d...)
+	modelList, d := types.ListValueFrom(ctx, types.StringType, models)
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.ObjectNull(roleCapabilitiesAttrTypes), diags
+	}
+
+	return types.ObjectValue(roleCapabilitiesAttrTypes, map[string]attr.Value{
+		"permission_set_name": types.StringPointerValue(permissionSetName),
+		"permissions":         permissionList,
+		"model_set_name":      types.StringPointerValue(modelSetName),
+		"models":              modelList,
+	})
+}
+
+// inlinePermissionSetName and inlineModelSetName name the sets a role
+// materializes from inline permissions and models.
+func inlinePermissionSetName(role string) string { return role + " (role permissions)" }
+func inlineModelSetName(role string) string      { return role + " (role models)" }
+
+// syncInlineSets creates or updates the permission set and model set backing
+// the inline permissions and models of plan, and points plan at them. state is
+// nil on create. It returns an undo function for every set created by this
+// call, so that the caller can remove them again when the role itself cannot
+// be saved.
+func syncInlineSets(ctx context.Context, sdk lookerapi.Client, plan, state *roleResourceModel) (undo []func() error, diags diag.Diagnostics) {
+	name := plan.Name.ValueString()
+
+	if !plan.Permissions.IsNull() {
+		var permissions []string
+		diags.Append(plan.Permissions.ElementsAs(ctx, &permissions, false)...)
+		if diags.HasError() {
+			return undo, diags
+		}
+		setName := inlinePermissionSetName(name)
+		body := v4.WritePermissionSet{
+			Name:        &setName,
+			Permissions: &permissions,
+		}
+		if state != nil && !state.Permissions.IsNull() {
+			if _, err := sdk.UpdatePermissionSet(state.PermissionSetID.ValueString(), body, nil); err != nil {
+				diags.AddError("API error", fmt.Sprintf("Failed to update permission set of role: %v", err))
+				return undo, diags
+			}
+			plan.PermissionSetID = state.PermissionSetID
+		} else {
+			ps, err := sdk.CreatePermissionSet(body, nil)
+			if err != nil {
+				diags.AddError("API error", fmt.Sprintf("Failed to create permission set of role: %v", err))
+				return undo, diags
+			}
+			plan.PermissionSetID = types.StringPointerValue(ps.Id)
+			id := *ps.Id
+			undo = append(undo, func() error {
+				_, err := sdk.DeletePermissionSet(id, nil)
+				return err
+			})
+		}
+	}
+
+	if !plan.Models.IsNull() {
+		var models []string
+		diags.Append(plan.Models.ElementsAs(ctx, &models, false)...)
+		if diags.HasError() {
+			return undo, diags
+		}
+		setName := inlineModelSetName(name)
+		body := v4.WriteModelSet{
+			Name:   &setName,
+			Models: &models,
+		}
+		if state != nil && !state.Models.IsNull() {
+			if _, err := sdk.UpdateModelSet(state.ModelSetID.ValueString(), body, nil); err != nil {
+				diags.AddError("API error", fmt.Sprintf("Failed to update model set of role: %v", err))
+				return undo, diags
+			}
+			plan.ModelSetID = state.ModelSetID
+		} else {
+			ms, err := sdk.CreateModelSet(body, nil)
+			if err != nil {
+				diags.AddError("API error", fmt.Sprintf("Failed to create model set of role: %v", err))
+				return undo, diags
+			}
+			plan.ModelSetID = types.StringPointerValue(ms.Id)
+			id := *ms.Id
+			undo = append(undo, func() error {
+				_, err := sdk.DeleteModelSet(id, nil)
+				return err
+			})
+		}
+	}
+
+	return undo, diags
+}
+
+// resolveSetNames points plan at the existing permission set and model set
+// named by permission_set_name and model_set_name, if set.
+func resolveSetNames(sdk lookerapi.Client, plan *roleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !plan.PermissionSetName.IsNull() {
+		id, d := findByName(sdk, "permission set", plan.PermissionSetName.ValueString(), searchPermissionSetsByName)
+		diags.Append(d...)
+		plan.PermissionSetID = types.StringValue(id)
+	}
+	if !plan.ModelSetName.IsNull() {
+		id, d := findByName(sdk, "model set", plan.ModelSetName.ValueString(), searchModelSetsByName)
+		diags.Append(d...)
+		plan.ModelSetID = types.StringValue(id)
+	}
+	return diags
+}
+
+// deleteInlineSets removes the sets materialized for the inline permissions
+// and models of state, except those still used by plan. plan is nil when the
+// role itself is deleted.
+func deleteInlineSets(sdk lookerapi.Client, state, plan *roleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !state.Permissions.IsNull() && (plan == nil || plan.Permissions.IsNull()) {
+		if _, err := sdk.DeletePermissionSet(state.PermissionSetID.ValueString(), nil); err != nil && !isNotFound(err) {
+			diags.AddError("API error", fmt.Sprintf("Failed to delete permission set of role: %v", err))
+		}
+	}
+	if !state.Models.IsNull() && (plan == nil || plan.Models.IsNull()) {
+		if _, err := sdk.DeleteModelSet(state.ModelSetID.ValueString(), nil); err != nil && !isNotFound(err) {
+			diags.AddError("API error", fmt.Sprintf("Failed to delete model set of role: %v", err))
+		}
+	}
+	return diags
+}
+
+// undoInlineSets runs the undo functions returned by syncInlineSets. Failures
+// are only warnings, the error that made the undo necessary is what matters.
+func undoInlineSets(undo []func() error, diags *diag.Diagnostics) {
+	for _, fn := range undo {
+		if err := fn(); err != nil {
+			diags.AddWarning("Orphaned role set", fmt.Sprintf("Failed to remove a set created for the role: %v", err))
+		}
+	}
 }
 
 // NewRoleResource is a helper function to simplify the provider implementation.
@@ -44,10 +231,11 @@ func (r *roleResource) Metadata(_ context.Context, req resource.MetadataRequest,
 }
 
 // Schema defines the schema for the resource.
-func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *roleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages Looker roles.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the role.",
 				Computed:    true,
@@ -60,37 +248,105 @@ func (r *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 			},
 			"permission_set_id": schema.StringAttribute{
-				Description: "The ID of the permission set for this role.",
-				Required:    true,
+				Description: "The ID of the permission set for this role. Exactly one of permission_set_id, permission_set_name or permissions must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("permission_set_name"), path.MatchRoot("permissions")),
+				},
+			},
+			"permission_set_name": schema.StringAttribute{
+				Description: "The exact name of an existing permission set for this role, resolved to permission_set_id when the role is created or updated.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 			"model_set_id": schema.StringAttribute{
-				Description: "The ID of the model set for this role.",
-				Required:    true,
+				Description: "The ID of the model set for this role. Exactly one of model_set_id, model_set_name or models must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("model_set_name"), path.MatchRoot("models")),
+				},
+			},
+			"model_set_name": schema.StringAttribute{
+				Description: "The exact name of an existing model set for this role, resolved to model_set_id when the role is created or updated.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				Description: "Permissions granted by the role. The provider manages a dedicated permission set named after the role for them, and deletes it with the role.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"models": schema.SetAttribute{
+				Description: "Models covered by the role. The provider manages a dedicated model set named after the role for them, and deletes it with the role.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
 			},
 			"url": schema.StringAttribute{
 				Description: "The URL of the role.",
 				Computed:    true,
 			},
+			"capabilities": schema.SingleNestedAttribute{
+				Description: "The effective capability of the role: the permissions of its permission set and the models of its model set, as read back from Looker.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"permission_set_name": schema.StringAttribute{
+						Description: "The name of the attached permission set.",
+						Computed:    true,
+					},
+					"permissions": schema.ListAttribute{
+						Description: "Sorted permissions granted by the permission set.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"model_set_name": schema.StringAttribute{
+						Description: "The name of the attached model set.",
+						Computed:    true,
+					},
+					"models": schema.ListAttribute{
+						Description: "Sorted models covered by the model set.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+				},
+			},
 		},
 	}
 }
 
-// Configure adds the provider configured client to the resource.
-func (r *roleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if cb, ok := req.ProviderData.(*clientBundle); ok && cb.SDK != nil {
-		r.sdk = cb.SDK
-	} else if req.ProviderData != nil {
-		resp.Diagnostics.AddError("Unexpected provider data", "Missing Looker SDK client")
+// ModifyPlan refuses to destroy an Admin role.
+func (r *roleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var state roleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	refuseAdminRoleDelete(r.client.SDK(ctx), state.ID.ValueString(), &resp.Diagnostics)
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
 	var plan roleResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -98,18 +354,36 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	role, err := r.sdk.CreateRole(v4.WriteRole{
+	sdk := r.client.SDK(ctx)
+	resp.Diagnostics.Append(resolveSetNames(sdk, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	undo, diags := syncInlineSets(ctx, sdk, &plan, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		undoInlineSets(undo, &resp.Diagnostics)
+		return
+	}
+
+	role, err := sdk.CreateRole(v4.WriteRole{
 		Name:            plan.Name.ValueStringPointer(),
 		PermissionSetId: plan.PermissionSetID.ValueStringPointer(),
 		ModelSetId:      plan.ModelSetID.ValueStringPointer(),
 	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to create role: %v", err))
+		undoInlineSets(undo, &resp.Diagnostics)
 		return
 	}
 
 	plan.ID = types.StringPointerValue(role.Id)
 	plan.URL = types.StringPointerValue(role.Url)
+	plan.Capabilities, diags = roleCapabilities(ctx, &role)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -117,11 +391,13 @@ func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 // Read refreshes the Terraform state with the latest data.
 func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
 	var state roleResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -130,7 +406,7 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// CORRECTED: The Role() function does not take a 'fields' argument.
-	role, err := r.sdk.Role(state.ID.ValueString(), nil)
+	role, err := r.client.SDK(ctx).Role(state.ID.ValueString(), nil)
 	if err != nil {
 		// Handle not found error by removing the resource from state
 		resp.State.RemoveResource(ctx)
@@ -141,9 +417,28 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	state.URL = types.StringPointerValue(role.Url)
 	if role.PermissionSet != nil {
 		state.PermissionSetID = types.StringPointerValue(role.PermissionSet.Id)
+		if !state.PermissionSetName.IsNull() {
+			state.PermissionSetName = types.StringPointerValue(role.PermissionSet.Name)
+		}
 	}
 	if role.ModelSet != nil {
 		state.ModelSetID = types.StringPointerValue(role.ModelSet.Id)
+		if !state.ModelSetName.IsNull() {
+			state.ModelSetName = types.StringPointerValue(role.ModelSet.Name)
+		}
+	}
+	if !state.Permissions.IsNull() && role.PermissionSet != nil && role.PermissionSet.Permissions != nil {
+		state.Permissions, diags = types.SetValueFrom(ctx, types.StringType, *role.PermissionSet.Permissions)
+		resp.Diagnostics.Append(diags...)
+	}
+	if !state.Models.IsNull() && role.ModelSet != nil && role.ModelSet.Models != nil {
+		state.Models, diags = types.SetValueFrom(ctx, types.StringType, *role.ModelSet.Models)
+		resp.Diagnostics.Append(diags...)
+	}
+	state.Capabilities, diags = roleCapabilities(ctx, &role)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	diags = resp.State.Set(ctx, &state)
@@ -152,11 +447,13 @@ func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
 	var plan roleResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -171,18 +468,40 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	role, err := r.sdk.UpdateRole(state.ID.ValueString(), v4.WriteRole{
+	sdk := r.client.SDK(ctx)
+	resp.Diagnostics.Append(resolveSetNames(sdk, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	undo, diags := syncInlineSets(ctx, sdk, &plan, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		undoInlineSets(undo, &resp.Diagnostics)
+		return
+	}
+
+	role, err := sdk.UpdateRole(state.ID.ValueString(), v4.WriteRole{
 		Name:            plan.Name.ValueStringPointer(),
 		PermissionSetId: plan.PermissionSetID.ValueStringPointer(),
 		ModelSetId:      plan.ModelSetID.ValueStringPointer(),
 	}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to update role: %v", err))
+		undoInlineSets(undo, &resp.Diagnostics)
 		return
 	}
 
+	// Sets that backed inline permissions or models no longer used by the
+	// role are removed once the role has been repointed.
+	resp.Diagnostics.Append(deleteInlineSets(sdk, &state, &plan)...)
+
 	plan.ID = types.StringPointerValue(role.Id)
 	plan.URL = types.StringPointerValue(role.Url)
+	plan.Capabilities, diags = roleCapabilities(ctx, &role)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -190,11 +509,13 @@ func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
 	var state roleResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -202,14 +523,29 @@ func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	_, err := r.sdk.DeleteRole(state.ID.ValueString(), nil)
+	sdk := r.client.SDK(ctx)
+	if refuseAdminRoleDelete(sdk, state.ID.ValueString(), &resp.Diagnostics) {
+		return
+	}
+	_, err := sdk.DeleteRole(state.ID.ValueString(), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to delete role: %v", err))
 		return
 	}
+
+	resp.Diagnostics.Append(deleteInlineSets(sdk, &state, nil)...)
 }
 
-// ImportState imports the resource into the Terraform state.
+// ImportState imports the resource into the Terraform state, by ID or by
+// name=<name>.
 func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByName(ctx, r.client, "role", func(sdk lookerapi.Client, name string) ([]namedObject, error) {
+		fields := "id,name"
+		roles, err := sdk.SearchRoles(v4.RequestSearchRoles{Name: &name, Fields: &fields}, nil)
+		objects := make([]namedObject, 0, len(roles))
+		for _, role := range roles {
+			objects = append(objects, namedObject{id: role.Id, name: role.Name})
+		}
+		return objects, err
+	}, req, resp)
 }
@@ -4,24 +4,26 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
 )
 
 var (
-	_ resource.Resource                = &roleGroupsResource{}
-	_ resource.ResourceWithConfigure   = &roleGroupsResource{}
-	_ resource.ResourceWithImportState = &roleGroupsResource{}
+	_ resource.Resource                 = &roleGroupsResource{}
+	_ resource.ResourceWithConfigure    = &roleGroupsResource{}
+	_ resource.ResourceWithImportState  = &roleGroupsResource{}
+	_ resource.ResourceWithModifyPlan   = &roleGroupsResource{}
+	_ resource.ResourceWithUpgradeState = &roleGroupsResource{}
 )
 
 // roleGroupsResource is the resource implementation.
 type roleGroupsResource struct {
-	sdk *v4.LookerSDK
+	baseResource
 }
 
 // roleGroupsResourceModel maps the resource schema data.
@@ -29,6 +31,9 @@ type roleGroupsResourceModel struct {
 	ID       types.String `tfsdk:"id"`
 	RoleID   types.String `tfsdk:"role_id"`
 	GroupIDs types.Set    `tfsdk:"group_ids"`
+
+	AllowAllUsers types.Bool     `tfsdk:"allow_all_users"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewRoleGroupsResource is a helper function to simplify the provider implementation.
@@ -42,10 +47,11 @@ func (r *roleGroupsResource) Metadata(_ context.Context, req resource.MetadataRe
 }
 
 // Schema defines the schema for the resource.
-func (r *roleGroupsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *roleGroupsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages the assignment of a set of groups to a single Looker role.",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
 			"id": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -61,16 +67,51 @@ func (r *roleGroupsResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:    true,
 				ElementType: types.StringType,
 			},
+			"allow_all_users": allowAllUsersAttribute,
 		},
 	}
 }
 
-// Configure adds the provider configured client to the resource.
-func (r *roleGroupsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if cb, ok := req.ProviderData.(*clientBundle); ok && cb.SDK != nil {
-		r.sdk = cb.SDK
-	} else if req.ProviderData != nil {
-		resp.Diagnostics.AddError("Unexpected provider data", "Missing Looker SDK client")
+// ModifyPlan warns when the role is about to be given to a group that holds
+// every user, unless allow_all_users is set, and when removing groups from an
+// Admin role would leave no admin.
+func (r *roleGroupsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	var state roleGroupsResourceModel
+	var current []string
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		resp.Diagnostics.Append(state.GroupIDs.ElementsAs(ctx, &current, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if req.Plan.Raw.IsNull() {
+		if len(current) > 0 {
+			warnNoAdminLeft(r.client.SDK(ctx), adminChange{roleID: state.RoleID.ValueString()}, path.Root("group_ids"), &resp.Diagnostics)
+		}
+		return
+	}
+
+	var plan roleGroupsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.GroupIDs.IsUnknown() {
+		return
+	}
+	var planned []string
+	resp.Diagnostics.Append(plan.GroupIDs.ElementsAs(ctx, &planned, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(stringsNotIn(current, planned)) > 0 && plan.RoleID.Equal(state.RoleID) {
+		warnNoAdminLeft(r.client.SDK(ctx), adminChange{roleID: plan.RoleID.ValueString(), roleGroups: planned}, path.Root("group_ids"), &resp.Diagnostics)
+	}
+	if !plan.AllowAllUsers.ValueBool() {
+		warnAllUsersGroups(r.client.SDK(ctx), plan.RoleID.ValueString(), stringsNotIn(planned, current), path.Root("group_ids"), &resp.Diagnostics)
 	}
 }
 
@@ -82,17 +123,19 @@ func (r *roleGroupsResource) setRoleGroups(ctx context.Context, plan *roleGroups
 		return fmt.Errorf("could not get group IDs from plan")
 	}
 
-	_, err := r.sdk.SetRoleGroups(plan.RoleID.ValueString(), groupIDs, nil)
+	_, err := r.client.SDK(ctx).SetRoleGroups(plan.RoleID.ValueString(), groupIDs, nil)
 	return err
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *roleGroupsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
 	var plan roleGroupsResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -113,11 +156,13 @@ func (r *roleGroupsResource) Create(ctx context.Context, req resource.CreateRequ
 
 // Read refreshes the Terraform state with the latest data.
 func (r *roleGroupsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
 	var state roleGroupsResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -127,7 +172,7 @@ func (r *roleGroupsResource) Read(ctx context.Context, req resource.ReadRequest,
 	roleID := state.RoleID.ValueString()
 
 	// The SDK method to get groups for a role is RoleGroups.
-	groups, err := r.sdk.RoleGroups(roleID, "id", nil)
+	groups, err := r.client.SDK(ctx).RoleGroups(roleID, "id", nil)
 	if err != nil {
 		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read groups for role %s: %v", roleID, err))
 		return
@@ -145,17 +190,22 @@ func (r *roleGroupsResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 	state.GroupIDs = groupIDsSet
 	state.ID = state.RoleID
+	if state.AllowAllUsers.IsNull() {
+		state.AllowAllUsers = types.BoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *roleGroupsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
 	var plan roleGroupsResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -177,11 +227,13 @@ func (r *roleGroupsResource) Update(ctx context.Context, req resource.UpdateRequ
 
 // Delete deletes the resource. This means setting the groups for the role to an empty list.
 func (r *roleGroupsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	if r.sdk == nil {
-		resp.Diagnostics.AddError("Unconfigured client", "Provider did not set Looker SDK client")
+	if !r.configured(&resp.Diagnostics) {
 		return
 	}
 
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
 	var state roleGroupsResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -190,7 +242,7 @@ func (r *roleGroupsResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	// Deleting the assignment means setting the list of groups to empty.
-	_, err := r.sdk.SetRoleGroups(state.RoleID.ValueString(), []string{}, nil)
+	_, err := r.client.SDK(ctx).SetRoleGroups(state.RoleID.ValueString(), []string{}, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to clear groups for role %s: %v", state.RoleID.ValueString(), err))
 		return
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"testing"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestRoleGroupsResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &roleGroupsResource{baseResource{client: client}})
+
+	api.EXPECT().SetRoleGroups("1", []string{"5"}, nil).Return(nil, nil)
+	state, diags := tr.create(map[string]any{"role_id": "1", "group_ids": []string{"5"}, "allow_all_users": false})
+	requireNoErrors(t, "Create", diags)
+	var id string
+	tr.get(state, "id", &id)
+	if id != "1" {
+		t.Errorf("id = %q, want %q", id, "1")
+	}
+
+	api.EXPECT().RoleGroups("1", "id", nil).Return([]v4.Group{{Id: ptr("5")}, {Id: ptr("6")}}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var groupIDs []string
+	tr.get(state, "group_ids", &groupIDs)
+	if !sameStrings(groupIDs, []string{"5", "6"}) {
+		t.Errorf("group_ids = %v, want [5 6]", groupIDs)
+	}
+
+	api.EXPECT().SetRoleGroups("1", []string{"7"}, nil).Return(nil, nil)
+	state, diags = tr.update(state, map[string]any{"id": "1", "role_id": "1", "group_ids": []string{"7"}, "allow_all_users": false})
+	requireNoErrors(t, "Update", diags)
+
+	api.EXPECT().SetRoleGroups("1", []string{}, nil).Return(nil, nil)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestRoleGroupsResourceReadNotFound(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &roleGroupsResource{baseResource{client: client}})
+
+	// Read does not tell a deleted role apart from other errors.
+	api.EXPECT().RoleGroups("1", "id", nil).Return(nil, errNotFound)
+	_, diags := tr.read(tr.state(map[string]any{"id": "1", "role_id": "1", "group_ids": []string{"5"}, "allow_all_users": false}))
+	if !diags.HasError() || diags.Errors()[0].Summary() != "API error" {
+		t.Errorf("diagnostics = %v, want an API error", diags)
+	}
+}
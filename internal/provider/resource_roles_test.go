@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"terraform-provider-looker/internal/lookertest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAccRoleResource(t *testing.T) {
+	srv := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(srv, "looker_role", lookertest.Roles),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleConfig("tf-acc-test-role", "viewer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("looker_role.test", "id"),
+					resource.TestCheckResourceAttr("looker_role.test", "name", "tf-acc-test-role"),
+					resource.TestCheckResourceAttrPair("looker_role.test", "permission_set_id", "looker_permission_set.viewer", "id"),
+					resource.TestCheckResourceAttrPair("looker_role.test", "model_set_id", "looker_model_set.test", "id"),
+					resource.TestCheckResourceAttr("looker_role.test", "capabilities.permission_set_name", "tf-acc-test-viewer"),
+				),
+			},
+			{
+				ResourceName:      "looker_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoleConfig("tf-acc-test-role-renamed", "developer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_role.test", "name", "tf-acc-test-role-renamed"),
+					resource.TestCheckResourceAttrPair("looker_role.test", "permission_set_id", "looker_permission_set.developer", "id"),
+					resource.TestCheckResourceAttr("looker_role.test", "capabilities.permission_set_name", "tf-acc-test-developer"),
+					testAccCheckStored(srv, "looker_role.test", lookertest.Roles, "name", "tf-acc-test-role-renamed"),
+				),
+			},
+		},
+	})
+}
+
+// testAccRoleConfig declares a role named name using the permission set
+// permissionSet, "viewer" or "developer".
+func testAccRoleConfig(name, permissionSet string) string {
+	return fmt.Sprintf(`
+resource "looker_permission_set" "viewer" {
+  name        = "tf-acc-test-viewer"
+  permissions = ["access_data", "see_looks"]
+}
+
+resource "looker_permission_set" "developer" {
+  name        = "tf-acc-test-developer"
+  permissions = ["access_data", "see_looks", "develop"]
+}
+
+resource "looker_model_set" "test" {
+  name   = "tf-acc-test-models"
+  models = ["tf_acc_test_sales"]
+}
+
+resource "looker_role" "test" {
+  name              = %q
+  permission_set_id = looker_permission_set.%s.id
+  model_set_id      = looker_model_set.test.id
+}
+`, name, permissionSet)
+}
+
+func TestRoleResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &roleResource{baseResource{client: client}})
+	viewer := &v4.PermissionSet{Id: ptr("2"), Name: ptr("viewer"), Permissions: &[]string{"access_data"}}
+	developer := &v4.PermissionSet{Id: ptr("4"), Name: ptr("developer"), Permissions: &[]string{"develop"}}
+	sales := &v4.ModelSet{Id: ptr("3"), Name: ptr("sales"), Models: &[]string{"orders"}}
+
+	api.EXPECT().CreateRole(v4.WriteRole{
+		Name:            ptr("analyst"),
+		PermissionSetId: ptr("2"),
+		ModelSetId:      ptr("3"),
+	}, nil).Return(v4.Role{Id: ptr("5"), Name: ptr("analyst"), PermissionSet: viewer, ModelSet: sales}, nil)
+	state, diags := tr.create(map[string]any{"name": "analyst", "permission_set_id": "2", "model_set_id": "3"})
+	requireNoErrors(t, "Create", diags)
+	var id string
+	tr.get(state, "id", &id)
+	if id != "5" {
+		t.Errorf("id = %q, want %q", id, "5")
+	}
+	var permissions []string
+	tr.get(state, "capabilities.permissions", &permissions)
+	if !sameStrings(permissions, []string{"access_data"}) {
+		t.Errorf("capabilities.permissions = %v, want [access_data]", permissions)
+	}
+
+	api.EXPECT().Role("5", nil).Return(v4.Role{Id: ptr("5"), Name: ptr("analyst"), PermissionSet: developer, ModelSet: sales}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var permissionSetID string
+	tr.get(state, "permission_set_id", &permissionSetID)
+	if permissionSetID != "4" {
+		t.Errorf("permission_set_id = %q, want %q", permissionSetID, "4")
+	}
+
+	api.EXPECT().UpdateRole("5", v4.WriteRole{
+		Name:            ptr("analyst"),
+		PermissionSetId: ptr("2"),
+		ModelSetId:      ptr("3"),
+	}, nil).Return(v4.Role{Id: ptr("5"), Name: ptr("analyst"), PermissionSet: viewer, ModelSet: sales}, nil)
+	state, diags = tr.update(state, map[string]any{"id": "5", "name": "analyst", "permission_set_id": "2", "model_set_id": "3"})
+	requireNoErrors(t, "Update", diags)
+	tr.get(state, "permission_set_id", &permissionSetID)
+	if permissionSetID != "2" {
+		t.Errorf("permission_set_id = %q, want %q", permissionSetID, "2")
+	}
+
+	gomock.InOrder(
+		api.EXPECT().Role("5", nil).Return(v4.Role{Id: ptr("5"), PermissionSet: viewer}, nil),
+		api.EXPECT().DeleteRole("5", nil).Return("", nil),
+	)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestRoleResourceReadNotFound(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &roleResource{baseResource{client: client}})
+
+	api.EXPECT().Role("5", nil).Return(v4.Role{}, errNotFound)
+	state, diags := tr.read(tr.state(map[string]any{"id": "5", "name": "analyst"}))
+	requireNoErrors(t, "Read", diags)
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want removed", state.Raw)
+	}
+}
+
+func TestRoleResourceCreateUndoesInlineSets(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &roleResource{baseResource{client: client}})
+
+	gomock.InOrder(
+		api.EXPECT().CreatePermissionSet(v4.WritePermissionSet{
+			Name:        ptr("analyst (role permissions)"),
+			Permissions: &[]string{"access_data"},
+		}, nil).Return(v4.PermissionSet{Id: ptr("8")}, nil),
+		api.EXPECT().CreateRole(gomock.Any(), nil).Return(v4.Role{}, errors.New("response error. status=422 Unprocessable Entity")),
+		api.EXPECT().DeletePermissionSet("8", nil).Return("", nil),
+	)
+	state, diags := tr.create(map[string]any{"name": "analyst", "permissions": []string{"access_data"}, "model_set_id": "3"})
+	if !diags.HasError() {
+		t.Fatal("Create succeeded, want an error")
+	}
+	if got := diags.WarningsCount(); got != 0 {
+		t.Errorf("warnings = %d, want 0: %v", got, diags)
+	}
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want none", state.Raw)
+	}
+}
+
+func TestRoleResourceDeleteAdmin(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &roleResource{baseResource{client: client}})
+
+	// DeleteRole is not expected, so calling it fails the test.
+	api.EXPECT().Role("1", nil).Return(v4.Role{
+		Id:            ptr("1"),
+		Name:          ptr("Admin"),
+		PermissionSet: &v4.PermissionSet{BuiltIn: ptr(true), AllAccess: ptr(true)},
+	}, nil)
+	if diags := tr.delete(tr.state(map[string]any{"id": "1", "name": "Admin"})); !diags.HasError() {
+		t.Error("Delete of an Admin role succeeded, want an error")
+	}
+}
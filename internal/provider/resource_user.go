@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+const userResourceFields = "id,first_name,last_name,email,is_disabled,locale,home_folder_id,personal_folder_id,display_name,credentials_email"
+
+var (
+	_ resource.Resource                 = &userResource{}
+	_ resource.ResourceWithConfigure    = &userResource{}
+	_ resource.ResourceWithImportState  = &userResource{}
+	_ resource.ResourceWithUpgradeState = &userResource{}
+)
+
+// userResource is the resource implementation.
+type userResource struct {
+	baseResource
+}
+
+// userResourceModel maps the resource schema data.
+type userResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	FirstName        types.String   `tfsdk:"first_name"`
+	LastName         types.String   `tfsdk:"last_name"`
+	Email            types.String   `tfsdk:"email"`
+	IsDisabled       types.Bool     `tfsdk:"is_disabled"`
+	Locale           types.String   `tfsdk:"locale"`
+	HomeFolderID     types.String   `tfsdk:"home_folder_id"`
+	PersonalFolderID types.String   `tfsdk:"personal_folder_id"`
+	DisplayName      types.String   `tfsdk:"display_name"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewUserResource is a helper function to simplify the provider implementation.
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the schema for the resource.
+func (r *userResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages Looker users and their email login credential.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"first_name": schema.StringAttribute{
+				Description: "The first name of the user.",
+				Optional:    true,
+			},
+			"last_name": schema.StringAttribute{
+				Description: "The last name of the user.",
+				Optional:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address used by the user's email/password login credential.",
+				Optional:    true,
+			},
+			"is_disabled": schema.BoolAttribute{
+				Description: "Whether the user account is disabled. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"locale": schema.StringAttribute{
+				Description: "The user's preferred locale, e.g. `en` or `en-US`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"home_folder_id": schema.StringAttribute{
+				Description: "The ID of the user's home folder.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"personal_folder_id": schema.StringAttribute{
+				Description: "The ID of the user's personal folder.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The full name of the user, available when both first and last name are set.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// writeUser builds the API write body from the planned attributes.
+func (m *userResourceModel) writeUser() v4.WriteUser {
+	body := v4.WriteUser{
+		FirstName:  m.FirstName.ValueStringPointer(),
+		LastName:   m.LastName.ValueStringPointer(),
+		IsDisabled: m.IsDisabled.ValueBoolPointer(),
+	}
+	if !m.Locale.IsUnknown() {
+		body.Locale = m.Locale.ValueStringPointer()
+	}
+	if !m.HomeFolderID.IsUnknown() {
+		body.HomeFolderId = m.HomeFolderID.ValueStringPointer()
+	}
+	return body
+}
+
+// refresh copies the API representation of a user into the model.
+func (m *userResourceModel) refresh(user v4.User) {
+	m.ID = types.StringPointerValue(user.Id)
+	m.FirstName = types.StringPointerValue(user.FirstName)
+	m.LastName = types.StringPointerValue(user.LastName)
+	m.IsDisabled = types.BoolPointerValue(user.IsDisabled)
+	m.Locale = types.StringPointerValue(user.Locale)
+	m.HomeFolderID = types.StringPointerValue(user.HomeFolderId)
+	m.PersonalFolderID = types.StringPointerValue(user.PersonalFolderId)
+	m.DisplayName = types.StringPointerValue(user.DisplayName)
+	if user.CredentialsEmail != nil {
+		m.Email = types.StringPointerValue(user.CredentialsEmail.Email)
+	} else {
+		m.Email = types.StringNull()
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan userResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := plan.writeUser()
+	if !plan.Email.IsNull() {
+		body.CredentialsEmail = &v4.WriteCredentialsEmail{Email: plan.Email.ValueStringPointer()}
+	}
+
+	user, err := r.client.SDK(ctx).CreateUser(body, userResourceFields, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to create user: %v", err))
+		return
+	}
+
+	plan.refresh(user)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state userResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.SDK(ctx).User(state.ID.ValueString(), userResourceFields, nil)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("User %s not found, removing from state", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.refresh(user)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
+	var plan, state userResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	userID := state.ID.ValueString()
+
+	if !plan.Email.Equal(state.Email) {
+		var err error
+		credentials := v4.WriteCredentialsEmail{Email: plan.Email.ValueStringPointer()}
+		switch {
+		case plan.Email.IsNull():
+			_, err = r.client.SDK(ctx).DeleteUserCredentialsEmail(userID, nil)
+		case state.Email.IsNull():
+			_, err = r.client.SDK(ctx).CreateUserCredentialsEmail(userID, credentials, "", nil)
+		default:
+			_, err = r.client.SDK(ctx).UpdateUserCredentialsEmail(userID, credentials, "", nil)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to update email credentials for user %s: %v", userID, err))
+			return
+		}
+	}
+
+	user, err := r.client.SDK(ctx).UpdateUser(userID, plan.writeUser(), userResourceFields, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to update user %s: %v", userID, err))
+		return
+	}
+
+	plan.refresh(user)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
+	var state userResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.SDK(ctx).DeleteUser(state.ID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to delete user %s: %v", state.ID.ValueString(), err))
+		return
+	}
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// userAttributeSourceUser is the source reported for values set directly on a user.
+const userAttributeSourceUser = "user"
+
+var (
+	_ resource.Resource                 = &userAttributeUserValueResource{}
+	_ resource.ResourceWithConfigure    = &userAttributeUserValueResource{}
+	_ resource.ResourceWithImportState  = &userAttributeUserValueResource{}
+	_ resource.ResourceWithUpgradeState = &userAttributeUserValueResource{}
+)
+
+// userAttributeUserValueResource is the resource implementation.
+type userAttributeUserValueResource struct {
+	baseResource
+}
+
+// userAttributeUserValueResourceModel maps the resource schema data.
+type userAttributeUserValueResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	UserID          types.String   `tfsdk:"user_id"`
+	UserAttributeID types.String   `tfsdk:"user_attribute_id"`
+	Value           types.String   `tfsdk:"value"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewUserAttributeUserValueResource is a helper function to simplify the provider implementation.
+func NewUserAttributeUserValueResource() resource.Resource {
+	return &userAttributeUserValueResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *userAttributeUserValueResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_attribute_user_value"
+}
+
+// Schema defines the schema for the resource.
+func (r *userAttributeUserValueResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sets the value of a user attribute for an individual user. Destroying the resource removes the user-level value, so the user falls back to group or default values.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "Identifier in the form `<user_id>/<user_attribute_id>`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_attribute_id": schema.StringAttribute{
+				Description: "The ID of the user attribute.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "The value of the attribute for the user. Values of hidden user attributes cannot be read back, so drift is not detected for them.",
+				Required:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// set writes the planned value to the user and stores it in the model.
+func (r *userAttributeUserValueResource) set(ctx context.Context, plan *userAttributeUserValueResourceModel) error {
+	userID := plan.UserID.ValueString()
+	attributeID := plan.UserAttributeID.ValueString()
+	_, err := r.client.SDK(ctx).SetUserAttributeUserValue(userID, attributeID, v4.WriteUserAttributeWithValue{Value: plan.Value.ValueStringPointer()}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to set user attribute %s for user %s: %w", attributeID, userID, err)
+	}
+	plan.ID = types.StringValue(userID + "/" + attributeID)
+	return nil
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *userAttributeUserValueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan userAttributeUserValueResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.set(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userAttributeUserValueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
+	var state userAttributeUserValueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	userID := state.UserID.ValueString()
+	attributeID := state.UserAttributeID.ValueString()
+
+	values, err := r.client.SDK(ctx).UserAttributeUserValues(v4.RequestUserAttributeUserValues{
+		UserId:           userID,
+		UserAttributeIds: &rtl.DelimString{attributeID},
+	}, nil)
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("User %s not found, removing user attribute value from state", userID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to read user attribute %s for user %s: %v", attributeID, userID, err))
+		return
+	}
+
+	var value *v4.UserAttributeWithValue
+	for i := range values {
+		if values[i].UserAttributeId != nil && *values[i].UserAttributeId == attributeID &&
+			values[i].Source != nil && *values[i].Source == userAttributeSourceUser {
+			value = &values[i]
+			break
+		}
+	}
+	if value == nil {
+		tflog.Warn(ctx, fmt.Sprintf("User attribute %s has no user-level value for user %s, removing from state", attributeID, userID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if value.ValueIsHidden == nil || !*value.ValueIsHidden {
+		state.Value = types.StringPointerValue(value.Value)
+	}
+	state.ID = types.StringValue(userID + "/" + attributeID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *userAttributeUserValueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
+	var plan userAttributeUserValueResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.set(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the user-level value so the user falls back to group or default values.
+func (r *userAttributeUserValueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
+	var state userAttributeUserValueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SDK(ctx).DeleteUserAttributeUserValue(state.UserID.ValueString(), state.UserAttributeID.ValueString(), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to reset user attribute %s for user %s: %v", state.UserAttributeID.ValueString(), state.UserID.ValueString(), err))
+		return
+	}
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *userAttributeUserValueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <user_id>/<user_attribute_id>. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_attribute_id"), parts[1])...)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestUserAttributeUserValueResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &userAttributeUserValueResource{baseResource{client: client}})
+	request := v4.RequestUserAttributeUserValues{UserId: "10", UserAttributeIds: &rtl.DelimString{"3"}}
+
+	api.EXPECT().SetUserAttributeUserValue("10", "3", v4.WriteUserAttributeWithValue{Value: ptr("emea")}, nil).Return(v4.UserAttributeWithValue{}, nil)
+	state, diags := tr.create(map[string]any{"user_id": "10", "user_attribute_id": "3", "value": "emea"})
+	requireNoErrors(t, "Create", diags)
+	var id string
+	tr.get(state, "id", &id)
+	if id != "10/3" {
+		t.Errorf("id = %q, want %q", id, "10/3")
+	}
+
+	// A hidden value is not read back, so the configured one is kept.
+	api.EXPECT().UserAttributeUserValues(request, nil).Return([]v4.UserAttributeWithValue{
+		{UserAttributeId: ptr("3"), Source: ptr("user"), ValueIsHidden: ptr(true)},
+	}, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var value string
+	tr.get(state, "value", &value)
+	if value != "emea" {
+		t.Errorf("value = %q, want %q", value, "emea")
+	}
+
+	api.EXPECT().SetUserAttributeUserValue("10", "3", v4.WriteUserAttributeWithValue{Value: ptr("apac")}, nil).Return(v4.UserAttributeWithValue{}, nil)
+	state, diags = tr.update(state, map[string]any{"id": "10/3", "user_id": "10", "user_attribute_id": "3", "value": "apac"})
+	requireNoErrors(t, "Update", diags)
+
+	api.EXPECT().DeleteUserAttributeUserValue("10", "3", nil).Return(errNotFound)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestUserAttributeUserValueResourceReadNotFound(t *testing.T) {
+	tests := map[string]struct {
+		values []v4.UserAttributeWithValue
+		err    error
+	}{
+		"user deleted": {err: errNotFound},
+		"group value only": {values: []v4.UserAttributeWithValue{
+			{UserAttributeId: ptr("3"), Source: ptr("group"), Value: ptr("emea")},
+		}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, api := newMockClient(t)
+			tr := newTestResource(t, &userAttributeUserValueResource{baseResource{client: client}})
+
+			api.EXPECT().UserAttributeUserValues(v4.RequestUserAttributeUserValues{UserId: "10", UserAttributeIds: &rtl.DelimString{"3"}}, nil).Return(test.values, test.err)
+			state, diags := tr.read(tr.state(map[string]any{"id": "10/3", "user_id": "10", "user_attribute_id": "3", "value": "emea"}))
+			requireNoErrors(t, "Read", diags)
+			if !state.Raw.IsNull() {
+				t.Errorf("state = %v, want removed", state.Raw)
+			}
+		})
+	}
+}
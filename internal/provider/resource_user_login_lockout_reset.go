@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                 = &userLoginLockoutResetResource{}
+	_ resource.ResourceWithConfigure    = &userLoginLockoutResetResource{}
+	_ resource.ResourceWithUpgradeState = &userLoginLockoutResetResource{}
+)
+
+// userLoginLockoutResetResource is the resource implementation.
+type userLoginLockoutResetResource struct {
+	baseResource
+}
+
+// userLoginLockoutResetResourceModel maps the resource schema data.
+type userLoginLockoutResetResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	UserID       types.String   `tfsdk:"user_id"`
+	Triggers     types.Map      `tfsdk:"triggers"`
+	ClearedCount types.Int64    `tfsdk:"cleared_count"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewUserLoginLockoutResetResource is a helper function to simplify the provider implementation.
+func NewUserLoginLockoutResetResource() resource.Resource {
+	return &userLoginLockoutResetResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *userLoginLockoutResetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_login_lockout_reset"
+}
+
+// Schema defines the schema for the resource.
+func (r *userLoginLockoutResetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Clears the login lockouts of a user when created. Change `triggers` to clear them again; destroying the resource does nothing.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
+			"id": schema.StringAttribute{
+				Description: "The ID of the user.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "The ID of the user whose lockouts are cleared.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that, when changed, clear the lockouts again, e.g. a support ticket number.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"cleared_count": schema.Int64Attribute{
+				Description: "Number of lockouts cleared by the last run.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create clears the lockouts of the user.
+func (r *userLoginLockoutResetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
+	var plan userLoginLockoutResetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	userID := plan.UserID.ValueString()
+
+	fields := "key,user_id"
+	lockouts, err := r.client.SDK(ctx).AllUserLoginLockouts(fields, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to list login lockouts: %v", err))
+		return
+	}
+	var cleared int64
+	for _, lockout := range lockouts {
+		if lockout.UserId == nil || *lockout.UserId != userID || lockout.Key == nil {
+			continue
+		}
+		if _, err := r.client.SDK(ctx).DeleteUserLoginLockout(*lockout.Key, nil); err != nil && !isNotFound(err) {
+			resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to clear login lockout of user %s: %v", userID, err))
+			return
+		}
+		cleared++
+	}
+	if cleared == 0 {
+		tflog.Info(ctx, fmt.Sprintf("User %s has no login lockouts", userID))
+	}
+
+	plan.ID = plan.UserID
+	plan.ClearedCount = types.Int64Value(cleared)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read keeps the state as is: the reset is a one-off operation.
+func (r *userLoginLockoutResetResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+// Update is never called since every argument forces replacement.
+func (r *userLoginLockoutResetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan userLoginLockoutResetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete only removes the resource from state.
+func (r *userLoginLockoutResetResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
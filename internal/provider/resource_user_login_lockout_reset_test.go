@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+func TestUserLoginLockoutResetResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &userLoginLockoutResetResource{baseResource{client: client}})
+
+	api.EXPECT().AllUserLoginLockouts("key,user_id", nil).Return([]v4.UserLoginLockout{
+		{Key: ptr("a"), UserId: ptr("10")},
+		{Key: ptr("b"), UserId: ptr("11")},
+		{Key: ptr("c"), UserId: ptr("10")},
+	}, nil)
+	api.EXPECT().DeleteUserLoginLockout("a", nil).Return("", nil)
+	api.EXPECT().DeleteUserLoginLockout("c", nil).Return("", errNotFound)
+	state, diags := tr.create(map[string]any{"user_id": "10"})
+	requireNoErrors(t, "Create", diags)
+	var clearedCount int64
+	tr.get(state, "cleared_count", &clearedCount)
+	if clearedCount != 2 {
+		t.Errorf("cleared_count = %d, want 2", clearedCount)
+	}
+
+	// The reset is a one-off: Read and Delete do not call Looker.
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	if state.Raw.IsNull() {
+		t.Fatal("Read removed the reset")
+	}
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-looker/internal/lookerapi/lookerapimock"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUserResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &userResource{baseResource{client: client}})
+	user := v4.User{
+		Id:               ptr("10"),
+		FirstName:        ptr("Ada"),
+		LastName:         ptr("Lovelace"),
+		IsDisabled:       ptr(false),
+		Locale:           ptr("en"),
+		HomeFolderId:     ptr("1"),
+		PersonalFolderId: ptr("20"),
+		DisplayName:      ptr("Ada Lovelace"),
+		CredentialsEmail: &v4.CredentialsEmail{Email: ptr("ada@example.com")},
+	}
+
+	api.EXPECT().CreateUser(v4.WriteUser{
+		FirstName:        ptr("Ada"),
+		LastName:         ptr("Lovelace"),
+		IsDisabled:       ptr(false),
+		CredentialsEmail: &v4.WriteCredentialsEmail{Email: ptr("ada@example.com")},
+	}, userResourceFields, nil).Return(user, nil)
+	state, diags := tr.create(map[string]any{"first_name": "Ada", "last_name": "Lovelace", "email": "ada@example.com", "is_disabled": false})
+	requireNoErrors(t, "Create", diags)
+	var id, personalFolderID string
+	tr.get(state, "id", &id)
+	tr.get(state, "personal_folder_id", &personalFolderID)
+	if id != "10" || personalFolderID != "20" {
+		t.Errorf("id, personal_folder_id = %q, %q, want 10, 20", id, personalFolderID)
+	}
+
+	api.EXPECT().User("10", userResourceFields, nil).Return(user, nil)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+
+	user.IsDisabled = ptr(true)
+	api.EXPECT().UpdateUser("10", v4.WriteUser{
+		FirstName:    ptr("Ada"),
+		LastName:     ptr("Lovelace"),
+		IsDisabled:   ptr(true),
+		Locale:       ptr("en"),
+		HomeFolderId: ptr("1"),
+	}, userResourceFields, nil).Return(user, nil)
+	state, diags = tr.update(state, map[string]any{
+		"id":                 "10",
+		"first_name":         "Ada",
+		"last_name":          "Lovelace",
+		"email":              "ada@example.com",
+		"is_disabled":        true,
+		"locale":             "en",
+		"home_folder_id":     "1",
+		"personal_folder_id": "20",
+		"display_name":       "Ada Lovelace",
+	})
+	requireNoErrors(t, "Update", diags)
+	var isDisabled bool
+	tr.get(state, "is_disabled", &isDisabled)
+	if !isDisabled {
+		t.Error("is_disabled = false, want true")
+	}
+
+	api.EXPECT().DeleteUser("10", nil).Return("", nil)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestUserResourceUpdateEmail(t *testing.T) {
+	tests := map[string]struct {
+		from, to types.String
+		expect   func(api *lookerapimock.MockClient) *gomock.Call
+	}{
+		"added": {
+			from: types.StringNull(),
+			to:   types.StringValue("ada@example.com"),
+			expect: func(api *lookerapimock.MockClient) *gomock.Call {
+				return api.EXPECT().CreateUserCredentialsEmail("10", v4.WriteCredentialsEmail{Email: ptr("ada@example.com")}, "", nil).Return(v4.CredentialsEmail{}, nil)
+			},
+		},
+		"changed": {
+			from: types.StringValue("ada@example.com"),
+			to:   types.StringValue("ada@example.org"),
+			expect: func(api *lookerapimock.MockClient) *gomock.Call {
+				return api.EXPECT().UpdateUserCredentialsEmail("10", v4.WriteCredentialsEmail{Email: ptr("ada@example.org")}, "", nil).Return(v4.CredentialsEmail{}, nil)
+			},
+		},
+		"removed": {
+			from: types.StringValue("ada@example.com"),
+			to:   types.StringNull(),
+			expect: func(api *lookerapimock.MockClient) *gomock.Call {
+				return api.EXPECT().DeleteUserCredentialsEmail("10", nil).Return("", nil)
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, api := newMockClient(t)
+			tr := newTestResource(t, &userResource{baseResource{client: client}})
+
+			// Email credentials are changed before the user itself.
+			gomock.InOrder(
+				test.expect(api),
+				api.EXPECT().UpdateUser("10", gomock.Any(), userResourceFields, nil).Return(v4.User{Id: ptr("10")}, nil),
+			)
+			_, diags := tr.update(
+				tr.state(map[string]any{"id": "10", "email": test.from, "is_disabled": false}),
+				map[string]any{"id": "10", "email": test.to, "is_disabled": false},
+			)
+			requireNoErrors(t, "Update", diags)
+		})
+	}
+}
+
+func TestUserResourceReadNotFound(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &userResource{baseResource{client: client}})
+
+	api.EXPECT().User("10", userResourceFields, nil).Return(v4.User{}, errNotFound)
+	state, diags := tr.read(tr.state(map[string]any{"id": "10", "first_name": "Ada", "is_disabled": false}))
+	requireNoErrors(t, "Read", diags)
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want removed", state.Raw)
+	}
+}
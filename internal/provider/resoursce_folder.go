@@ -3,33 +3,49 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"terraform-provider-looker/internal/lookerapi"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
 )
 
 var (
-	_ resource.Resource                = &folderResource{}
-	_ resource.ResourceWithConfigure   = &folderResource{}
-	_ resource.ResourceWithImportState = &folderResource{}
+	_ resource.Resource                   = &folderResource{}
+	_ resource.ResourceWithConfigure      = &folderResource{}
+	_ resource.ResourceWithImportState    = &folderResource{}
+	_ resource.ResourceWithValidateConfig = &folderResource{}
+	_ resource.ResourceWithUpgradeState   = &folderResource{}
 )
 
 type folderResource struct {
-	sdk *v4.LookerSDK
+	baseResource
 }
 
 type folderResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Name                types.String `tfsdk:"name"`
-	ParentID            types.String `tfsdk:"parent_id"`
-	ContentMetadataID   types.String `tfsdk:"content_metadata_id"`
-	InheritsPermissions types.Bool   `tfsdk:"inherits_permissions"`
+	ID                  types.String   `tfsdk:"id"`
+	Name                types.String   `tfsdk:"name"`
+	ParentID            types.String   `tfsdk:"parent_id"`
+	ContentMetadataID   types.String   `tfsdk:"content_metadata_id"`
+	InheritsPermissions types.Bool     `tfsdk:"inherits_permissions"`
+	ArchiveOnDestroy    types.Bool     `tfsdk:"archive_on_destroy"`
+	ArchiveFolderID     types.String   `tfsdk:"archive_folder_id"`
+	WebURL              types.String   `tfsdk:"web_url"`
+	DeleteContents      types.Bool     `tfsdk:"delete_contents"`
+	DeletionProtection  types.Bool     `tfsdk:"deletion_protection"`
+	SudoUserID          types.String   `tfsdk:"sudo_user_id"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewFolderResource() resource.Resource {
@@ -40,10 +56,11 @@ func (r *folderResource) Metadata(_ context.Context, req resource.MetadataReques
 	resp.TypeName = req.ProviderTypeName + "_folder"
 }
 
-func (r *folderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *folderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages Looker folders (spaces).",
 		Attributes: map[string]schema.Attribute{
+			"timeouts": timeoutsAttribute(ctx),
 			"id": schema.StringAttribute{
 				Computed:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
@@ -54,6 +71,17 @@ func (r *folderResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description: "The ID of the content metadata for this folder, used for access grants.",
 				Computed:    true,
 			},
+			"sudo_user_id": schema.StringAttribute{
+				Description: "ID of the user the folder is created as, who becomes its owner. Overrides the provider's sudo_user_id. Only used when the folder is created; changing it does not modify an existing folder.",
+				Optional:    true,
+			},
+			"web_url": schema.StringAttribute{
+				Description: "Link to the folder in the Looker web UI.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"inherits_permissions": schema.BoolAttribute{
 				Description: "If true, the folder inherits permissions from its parent. If false, the folder has its own explicit permissions. Must be set to `false` to use `looker_folder_access` on this folder.",
 				Optional:    true,
@@ -62,17 +90,52 @@ func (r *folderResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"archive_on_destroy": schema.BoolAttribute{
+				Description: "If true, destroying the resource archives the folder instead of deleting it: the folder is renamed with an `-archived-<YYYY-MM-DD>` suffix, moved under `archive_folder_id`, and its explicit access grants are removed. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"archive_folder_id": schema.StringAttribute{
+				Description: "The ID of the folder archived folders are moved under. Required when `archive_on_destroy` is true.",
+				Optional:    true,
+			},
+			"delete_contents": schema.BoolAttribute{
+				Description: "If true, destroying the resource deletes the folder together with its dashboards, looks and subfolders. If false, destroying a folder that is not empty fails. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: "If true, destroying the resource fails, whether it would delete or archive the folder. Set it to `false` and apply before destroying. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 		},
 	}
 }
 
-func (r *folderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if cb, ok := req.ProviderData.(*clientBundle); ok && cb.SDK != nil {
-		r.sdk = cb.SDK
+func (r *folderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg folderResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if cfg.ArchiveOnDestroy.ValueBool() && cfg.ArchiveFolderID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("archive_folder_id"), "Missing archive folder",
+			"archive_folder_id must be set when archive_on_destroy is true.")
 	}
 }
 
 func (r *folderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "create")
+	defer cancel()
+
 	var plan folderResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -80,10 +143,10 @@ func (r *folderResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	folder, err := r.sdk.CreateFolder(v4.CreateFolder{
+	folder, err := createFolder(ctx, r.client.asUser(plan.SudoUserID.ValueString()), v4.CreateFolder{
 		Name:     plan.Name.ValueString(),
 		ParentId: plan.ParentID.ValueString(),
-	}, nil)
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("API error on CreateFolder", fmt.Sprintf("Failed to create folder: %v", err))
 		return
@@ -91,9 +154,10 @@ func (r *folderResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	plan.ID = types.StringPointerValue(folder.Id)
 	plan.ContentMetadataID = types.StringPointerValue(folder.ContentMetadataId)
+	plan.WebURL = r.client.webURL("folders", folder.Id)
 
 	if !plan.InheritsPermissions.IsNull() && !plan.InheritsPermissions.ValueBool() {
-		_, err := r.sdk.UpdateContentMetadata(
+		_, err := r.client.SDK(ctx).UpdateContentMetadata(
 			*folder.ContentMetadataId,
 			v4.WriteContentMeta{Inherits: types.BoolValue(false).ValueBoolPointer()},
 			nil,
@@ -110,7 +174,51 @@ func (r *folderResource) Create(ctx context.Context, req resource.CreateRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
+// Bounds for retrying folder creation while the parent folder is not visible yet.
+const (
+	folderParentRetryTimeout = 30 * time.Second
+	folderParentRetryMaxWait = 8 * time.Second
+)
+
+// isParentNotFound reports whether a folder write failed because its parent
+// does not exist (yet).
+func isParentNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return isNotFound(err) || (strings.Contains(msg, "status=422") && strings.Contains(msg, "parent"))
+}
+
+// createFolder creates a folder, retrying briefly when the parent is not found.
+// Under high parallelism a parent created moments ago in the same apply may
+// not be visible to the next request yet, which makes deep trees flaky.
+func createFolder(ctx context.Context, client *clientBundle, body v4.CreateFolder) (v4.Folder, error) {
+	deadline := time.Now().Add(folderParentRetryTimeout)
+	wait := time.Second
+	for {
+		folder, err := client.SDK(ctx).CreateFolder(body, nil)
+		if !isParentNotFound(err) || time.Now().Add(wait).After(deadline) {
+			return folder, err
+		}
+		tflog.Warn(ctx, fmt.Sprintf("Parent folder %s not found, retrying in %s", body.ParentId, wait))
+		select {
+		case <-ctx.Done():
+			return folder, err
+		case <-time.After(wait):
+		}
+		wait = min(2*wait, folderParentRetryMaxWait)
+	}
+}
+
 func (r *folderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "read")
+	defer cancel()
+
 	var state folderResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -118,13 +226,13 @@ func (r *folderResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	folder, err := r.sdk.Folder(state.ID.ValueString(), "id,name,parent_id,content_metadata_id", nil)
+	folder, err := r.client.SDK(ctx).Folder(state.ID.ValueString(), "id,name,parent_id,content_metadata_id", nil)
 	if err != nil {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	contentMeta, err := r.sdk.ContentMetadata(*folder.ContentMetadataId, "inherits", nil)
+	contentMeta, err := r.client.SDK(ctx).ContentMetadata(*folder.ContentMetadataId, "inherits", nil)
 	if err != nil {
 		resp.Diagnostics.AddError("API error on ContentMetadata", fmt.Sprintf("Failed to read content metadata for folder %s: %v", state.ID.ValueString(), err))
 		return
@@ -133,12 +241,26 @@ func (r *folderResource) Read(ctx context.Context, req resource.ReadRequest, res
 	state.Name = types.StringValue(folder.Name)
 	state.ParentID = types.StringPointerValue(folder.ParentId)
 	state.ContentMetadataID = types.StringPointerValue(folder.ContentMetadataId)
+	state.WebURL = r.client.webURL("folders", folder.Id)
 	state.InheritsPermissions = types.BoolPointerValue(contentMeta.Inherits)
+	if state.DeleteContents.IsNull() {
+		state.DeleteContents = types.BoolValue(false)
+	}
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *folderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.Plan, "update")
+	defer cancel()
+
 	var plan, state folderResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -147,7 +269,7 @@ func (r *folderResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	if !plan.Name.Equal(state.Name) || !plan.ParentID.Equal(state.ParentID) {
-		_, err := r.sdk.UpdateFolder(plan.ID.ValueString(), v4.UpdateFolder{
+		_, err := r.client.SDK(ctx).UpdateFolder(plan.ID.ValueString(), v4.UpdateFolder{
 			Name:     plan.Name.ValueStringPointer(),
 			ParentId: plan.ParentID.ValueStringPointer(),
 		}, nil)
@@ -158,7 +280,7 @@ func (r *folderResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	if !plan.InheritsPermissions.Equal(state.InheritsPermissions) {
-		_, err := r.sdk.UpdateContentMetadata(plan.ContentMetadataID.ValueString(),
+		_, err := r.client.SDK(ctx).UpdateContentMetadata(plan.ContentMetadataID.ValueString(),
 			v4.WriteContentMeta{Inherits: plan.InheritsPermissions.ValueBoolPointer()},
 			nil,
 		)
@@ -172,19 +294,149 @@ func (r *folderResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *folderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	ctx, cancel := r.withTimeout(ctx, req.State, "delete")
+	defer cancel()
+
 	var state folderResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	_, err := r.sdk.DeleteFolder(state.ID.ValueString(), nil)
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("Folder is protected",
+			fmt.Sprintf("Folder %s has deletion_protection set. Set it to false and apply before destroying the folder.", state.ID.ValueString()))
+		return
+	}
+	if state.ArchiveOnDestroy.ValueBool() {
+		if err := r.archive(ctx, state); err != nil {
+			resp.Diagnostics.AddError("API error on archive", err.Error())
+		}
+		return
+	}
+	if !state.DeleteContents.ValueBool() {
+		contents, err := folderContents(r.client.SDK(ctx), state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API error on folder contents", err.Error())
+			return
+		}
+		if contents != "" {
+			resp.Diagnostics.AddError("Folder is not empty",
+				fmt.Sprintf("Folder %s still contains %s. Move or delete them, or set delete_contents to true and apply to delete them with the folder.", state.ID.ValueString(), contents))
+			return
+		}
+	}
+
+	// Looker deletes the dashboards, looks and subfolders of a folder with it.
+	_, err := r.client.SDK(ctx).DeleteFolder(state.ID.ValueString(), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("API error on DeleteFolder", fmt.Sprintf("Failed to delete folder %s: %v", state.ID.ValueString(), err))
 		return
 	}
 }
 
+// folderContents describes what a folder still contains, e.g. "2 dashboards and 1
+// subfolder", or returns "" when it is empty.
+func folderContents(sdk lookerapi.Client, folderID string) (string, error) {
+	dashboards, err := sdk.FolderDashboards(folderID, "id", nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to list dashboards of folder %s: %w", folderID, err)
+	}
+	looks, err := sdk.FolderLooks(folderID, "id", nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to list looks of folder %s: %w", folderID, err)
+	}
+	fields := "id"
+	children, err := sdk.FolderChildren(v4.RequestFolderChildren{FolderId: folderID, Fields: &fields}, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to list subfolders of folder %s: %w", folderID, err)
+	}
+
+	var parts []string
+	for _, count := range []struct {
+		n        int
+		singular string
+	}{
+		{len(dashboards), "dashboard"},
+		{len(looks), "look"},
+		{len(children), "subfolder"},
+	} {
+		switch {
+		case count.n == 1:
+			parts = append(parts, "1 "+count.singular)
+		case count.n > 1:
+			parts = append(parts, fmt.Sprintf("%d %ss", count.n, count.singular))
+		}
+	}
+	switch len(parts) {
+	case 0:
+		return "", nil
+	case 1:
+		return parts[0], nil
+	}
+	return strings.Join(parts[:len(parts)-1], ", ") + " and " + parts[len(parts)-1], nil
+}
+
+// archive renames the folder, moves it under the archive folder and removes its
+// explicit access grants, leaving the folder and its content in place.
+func (r *folderResource) archive(ctx context.Context, state folderResourceModel) error {
+	folderID := state.ID.ValueString()
+	name := fmt.Sprintf("%s-archived-%s", state.Name.ValueString(), time.Now().UTC().Format("2006-01-02"))
+	_, err := r.client.SDK(ctx).UpdateFolder(folderID, v4.UpdateFolder{
+		Name:     &name,
+		ParentId: state.ArchiveFolderID.ValueStringPointer(),
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to move folder %s to archive folder %s: %w", folderID, state.ArchiveFolderID.ValueString(), err)
+	}
+
+	// The listing includes the grants inherited from the archive folder,
+	// which must stay; only the folder's own grants are removed.
+	contentMetadataID := state.ContentMetadataID.ValueString()
+	grants, err := r.client.SDK(ctx).AllContentMetadataAccesses(contentMetadataID, "id,content_metadata_id", nil)
+	if err != nil {
+		return fmt.Errorf("failed to list access grants on folder %s: %w", folderID, err)
+	}
+	for _, grant := range grants {
+		if grant.Id == nil || (grant.ContentMetadataId != nil && *grant.ContentMetadataId != contentMetadataID) {
+			continue
+		}
+		if _, err := r.client.SDK(ctx).DeleteContentMetadataAccess(*grant.Id, nil); err != nil {
+			return fmt.Errorf("failed to remove access grant %s on folder %s: %w", *grant.Id, folderID, err)
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Archived folder %s as %q", folderID, name))
+	return nil
+}
+
+// ImportState imports a folder by ID, or by a path of folder names such as
+// Shared/Team/Sub, which is resolved to the folder's ID.
 func (r *folderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if !strings.Contains(req.ID, "/") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+	if !r.configured(&resp.Diagnostics) {
+		return
+	}
+
+	folderPath := strings.Trim(req.ID, "/")
+	if !folderTreePathPattern.MatchString(folderPath) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a folder ID or a path of folder names such as Shared/Team/Sub. Got: %q", req.ID),
+		)
+		return
+	}
+	folder, err := folderByPath(r.client.SDK(ctx), nil, folderPath)
+	if err != nil {
+		resp.Diagnostics.AddError("API error", fmt.Sprintf("Failed to resolve folder path %q: %v", folderPath, err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), folder.Id)...)
 }
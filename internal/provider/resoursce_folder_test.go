@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"terraform-provider-looker/internal/lookerapi/lookerapimock"
+	"terraform-provider-looker/internal/lookertest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAccFolderResource(t *testing.T) {
+	srv := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyed(srv, "looker_folder", lookertest.Folders),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderConfig("tf-acc-test-folder", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("looker_folder.test", "id"),
+					resource.TestCheckResourceAttrSet("looker_folder.test", "content_metadata_id"),
+					resource.TestCheckResourceAttr("looker_folder.test", "name", "tf-acc-test-folder"),
+					resource.TestCheckResourceAttr("looker_folder.test", "parent_id", lookertest.SharedFolderID),
+					resource.TestCheckResourceAttr("looker_folder.test", "inherits_permissions", "true"),
+				),
+			},
+			{
+				ResourceName:            "looker_folder.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"archive_on_destroy"},
+			},
+			{
+				ResourceName:            "looker_folder.test",
+				ImportState:             true,
+				ImportStateId:           "Shared/tf-acc-test-folder",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"archive_on_destroy"},
+			},
+			{
+				Config: testAccFolderConfig("tf-acc-test-folder-renamed", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("looker_folder.test", "name", "tf-acc-test-folder-renamed"),
+					resource.TestCheckResourceAttr("looker_folder.test", "inherits_permissions", "false"),
+					testAccCheckStored(srv, "looker_folder.test", lookertest.Folders, "name", "tf-acc-test-folder-renamed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFolderConfig(name string, inherits bool) string {
+	return fmt.Sprintf(`
+resource "looker_folder" "test" {
+  name                 = %q
+  parent_id            = %q
+  inherits_permissions = %t
+}
+`, name, lookertest.SharedFolderID, inherits)
+}
+
+func TestFolderResourceCRUD(t *testing.T) {
+	client, api := newMockClient(t)
+	client.webBaseURL = "https://looker.example.com"
+	tr := newTestResource(t, &folderResource{baseResource{client: client}})
+	folder := v4.Folder{Id: ptr("30"), Name: "reports", ParentId: ptr("1"), ContentMetadataId: ptr("300")}
+
+	gomock.InOrder(
+		api.EXPECT().CreateFolder(v4.CreateFolder{Name: "reports", ParentId: "1"}, nil).Return(folder, nil),
+		api.EXPECT().UpdateContentMetadata("300", v4.WriteContentMeta{Inherits: ptr(false)}, nil).Return(v4.ContentMeta{}, nil),
+	)
+	state, diags := tr.create(map[string]any{
+		"name":                 "reports",
+		"parent_id":            "1",
+		"inherits_permissions": false,
+		"archive_on_destroy":   false,
+		"delete_contents":      false,
+		"deletion_protection":  false,
+	})
+	requireNoErrors(t, "Create", diags)
+	var webURL string
+	tr.get(state, "web_url", &webURL)
+	if want := "https://looker.example.com/folders/30"; webURL != want {
+		t.Errorf("web_url = %q, want %q", webURL, want)
+	}
+
+	gomock.InOrder(
+		api.EXPECT().Folder("30", gomock.Any(), nil).Return(folder, nil),
+		api.EXPECT().ContentMetadata("300", "inherits", nil).Return(v4.ContentMeta{Inherits: ptr(false)}, nil),
+	)
+	state, diags = tr.read(state)
+	requireNoErrors(t, "Read", diags)
+	var inherits bool
+	tr.get(state, "inherits_permissions", &inherits)
+	if inherits {
+		t.Error("inherits_permissions = true, want false")
+	}
+
+	gomock.InOrder(
+		api.EXPECT().UpdateFolder("30", v4.UpdateFolder{Name: ptr("dashboards"), ParentId: ptr("1")}, nil).Return(folder, nil),
+		api.EXPECT().UpdateContentMetadata("300", v4.WriteContentMeta{Inherits: ptr(true)}, nil).Return(v4.ContentMeta{}, nil),
+	)
+	state, diags = tr.update(state, map[string]any{
+		"id":                   "30",
+		"name":                 "dashboards",
+		"parent_id":            "1",
+		"content_metadata_id":  "300",
+		"web_url":              webURL,
+		"inherits_permissions": true,
+		"archive_on_destroy":   false,
+		"delete_contents":      false,
+		"deletion_protection":  false,
+	})
+	requireNoErrors(t, "Update", diags)
+
+	gomock.InOrder(
+		api.EXPECT().FolderDashboards("30", "id", nil).Return(nil, nil),
+		api.EXPECT().FolderLooks("30", "id", nil).Return(nil, nil),
+		api.EXPECT().FolderChildren(gomock.Any(), nil).Return(nil, nil),
+		api.EXPECT().DeleteFolder("30", nil).Return("", nil),
+	)
+	requireNoErrors(t, "Delete", tr.delete(state))
+}
+
+func TestFolderResourceReadNotFound(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &folderResource{baseResource{client: client}})
+
+	api.EXPECT().Folder("30", gomock.Any(), nil).Return(v4.Folder{}, errNotFound)
+	state, diags := tr.read(tr.state(map[string]any{"id": "30", "name": "reports", "parent_id": "1"}))
+	requireNoErrors(t, "Read", diags)
+	if !state.Raw.IsNull() {
+		t.Errorf("state = %v, want removed", state.Raw)
+	}
+}
+
+func TestFolderResourceDeleteRefused(t *testing.T) {
+	tests := map[string]struct {
+		values  map[string]any
+		expect  func(api *lookerapimock.MockClient)
+		summary string
+	}{
+		"deletion protection": {
+			values:  map[string]any{"deletion_protection": true},
+			summary: "Folder is protected",
+		},
+		"not empty": {
+			values: map[string]any{},
+			expect: func(api *lookerapimock.MockClient) {
+				api.EXPECT().FolderDashboards("30", "id", nil).Return([]v4.Dashboard{{}, {}}, nil)
+				api.EXPECT().FolderLooks("30", "id", nil).Return(nil, nil)
+				api.EXPECT().FolderChildren(gomock.Any(), nil).Return([]v4.Folder{{}}, nil)
+			},
+			summary: "Folder is not empty",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, api := newMockClient(t)
+			tr := newTestResource(t, &folderResource{baseResource{client: client}})
+			if test.expect != nil {
+				test.expect(api)
+			}
+
+			values := map[string]any{"id": "30", "name": "reports", "parent_id": "1"}
+			for k, v := range test.values {
+				values[k] = v
+			}
+			diags := tr.delete(tr.state(values))
+			if !diags.HasError() {
+				t.Fatal("Delete succeeded, want an error")
+			}
+			if got := diags.Errors()[0].Summary(); got != test.summary {
+				t.Errorf("summary = %q, want %q", got, test.summary)
+			}
+		})
+	}
+}
+
+func TestFolderResourceArchive(t *testing.T) {
+	client, api := newMockClient(t)
+	tr := newTestResource(t, &folderResource{baseResource{client: client}})
+
+	// DeleteFolder is not expected, so calling it fails the test.
+	gomock.InOrder(
+		api.EXPECT().UpdateFolder("30", gomock.Any(), nil).DoAndReturn(func(_ string, body v4.UpdateFolder, _ *rtl.ApiSettings) (v4.Folder, error) {
+			if !strings.HasPrefix(*body.Name, "reports-archived-") || *body.ParentId != "9" {
+				t.Errorf("moved to %q under %q, want reports-archived-<date> under 9", *body.Name, *body.ParentId)
+			}
+			return v4.Folder{}, nil
+		}),
+		api.EXPECT().AllContentMetadataAccesses("300", "id,content_metadata_id", nil).Return([]v4.ContentMetaGroupUser{
+			{Id: ptr("40"), ContentMetadataId: ptr("300")},
+			// Inherited from the archive folder; deleting it fails the test.
+			{Id: ptr("41"), ContentMetadataId: ptr("90")},
+		}, nil),
+		api.EXPECT().DeleteContentMetadataAccess("40", nil).Return("", nil),
+	)
+	requireNoErrors(t, "Delete", tr.delete(tr.state(map[string]any{
+		"id":                  "30",
+		"name":                "reports",
+		"parent_id":           "1",
+		"content_metadata_id": "300",
+		"archive_on_destroy":  true,
+		"archive_folder_id":   "9",
+	})))
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// contentAccessFields are the fields of a content access grant the provider
+// uses. Asking for them only keeps listings of busy folders small.
+const contentAccessFields = "id,content_metadata_id,permission_type,group_id,user_id"
+
+// streamJSONArray performs an authenticated GET of an API endpoint returning a
+// JSON array and hands every element to visit as soon as it has been decoded,
+// so that only one element is buffered at a time.
+func streamJSONArray[T any](ctx context.Context, c *clientBundle, apiPath string, query url.Values, visit func(T) error) error {
+	body, err := c.Streamer().Stream(ctx, apiPath, query)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	decoder := json.NewDecoder(body)
+	if token, err := decoder.Token(); err != nil {
+		return fmt.Errorf("decoding %s: %w", apiPath, err)
+	} else if token != json.Delim('[') {
+		return fmt.Errorf("decoding %s: expected a JSON array, got %v", apiPath, token)
+	}
+	for decoder.More() {
+		var item T
+		if err := decoder.Decode(&item); err != nil {
+			return fmt.Errorf("decoding %s: %w", apiPath, err)
+		}
+		if err := visit(item); err != nil {
+			return err
+		}
+	}
+	if _, err := decoder.Token(); err != nil {
+		return fmt.Errorf("decoding %s: %w", apiPath, err)
+	}
+	return nil
+}
+
+// forEachContentAccess streams the access grants of a content metadata ID,
+// i.e. those of a folder including the ones inherited from its ancestors.
+func forEachContentAccess(ctx context.Context, c *clientBundle, contentMetadataID string, visit func(v4.ContentMetaGroupUser) error) error {
+	query := url.Values{
+		"content_metadata_id": {contentMetadataID},
+		"fields":              {contentAccessFields},
+	}
+	return streamJSONArray(ctx, c, "/content_metadata_access", query, visit)
+}
+
+// forEachUser streams every user of the instance with the given fields.
+func forEachUser(ctx context.Context, c *clientBundle, fields string, visit func(v4.User) error) error {
+	return streamJSONArray(ctx, c, "/users", url.Values{"fields": {fields}}, visit)
+}
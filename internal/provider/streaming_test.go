@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+	"go.uber.org/mock/gomock"
+)
+
+func TestStreamJSONArray(t *testing.T) {
+	tests := map[string]struct {
+		body    string
+		err     error
+		wantIDs []string
+		wantErr string
+	}{
+		"elements": {
+			body:    `[{"id":"1","email":"a@example.com"},{"id":"2"}]`,
+			wantIDs: []string{"1", "2"},
+		},
+		"empty": {
+			body: `[]`,
+		},
+		"not an array": {
+			body:    `{"id":"1"}`,
+			wantErr: "expected a JSON array",
+		},
+		"truncated": {
+			body:    `[{"id":"1"},{"id":`,
+			wantIDs: []string{"1"},
+			wantErr: "decoding /users",
+		},
+		"request failed": {
+			err:     errNotFound,
+			wantErr: "status=404",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, _ := newMockClient(t)
+			streamer := mockStreamer(t, client)
+			call := streamer.EXPECT().Stream(gomock.Any(), "/users", url.Values{"fields": {"id,email"}})
+			if test.err != nil {
+				call.Return(nil, test.err)
+			} else {
+				call.Return(io.NopCloser(strings.NewReader(test.body)), nil)
+			}
+
+			var ids []string
+			err := forEachUser(context.Background(), client, "id,email", func(user v4.User) error {
+				ids = append(ids, *user.Id)
+				return nil
+			})
+			if test.wantErr == "" && err != nil {
+				t.Fatal(err)
+			}
+			if test.wantErr != "" && (err == nil || !strings.Contains(err.Error(), test.wantErr)) {
+				t.Fatalf("error = %v, want %q", err, test.wantErr)
+			}
+			if !reflect.DeepEqual(ids, test.wantIDs) {
+				t.Errorf("ids = %v, want %v", ids, test.wantIDs)
+			}
+		})
+	}
+}
+
+func TestStreamJSONArrayStopsOnVisitError(t *testing.T) {
+	client, _ := newMockClient(t)
+	mockStreamer(t, client).EXPECT().Stream(gomock.Any(), "/content_metadata_access", gomock.Any()).
+		Return(jsonBody(t, []v4.ContentMetaGroupUser{{Id: ptr("1")}, {Id: ptr("2")}}), nil)
+
+	stop := errors.New("stop")
+	visited := 0
+	err := forEachContentAccess(context.Background(), client, "10", func(v4.ContentMetaGroupUser) error {
+		visited++
+		return stop
+	})
+	if !errors.Is(err, stop) {
+		t.Fatalf("error = %v, want %v", err, stop)
+	}
+	if visited != 1 {
+		t.Errorf("visited %d grants, want 1", visited)
+	}
+}
+
+func TestAsUserWithMockClient(t *testing.T) {
+	client, api := newMockClient(t)
+	sudo := client.asUser("7")
+	if sudo.sudoUserID != "7" || sudo.admin != client {
+		t.Fatalf("asUser(7) = user %q, admin %p", sudo.sudoUserID, sudo.admin)
+	}
+	if sudo.SDK(context.Background()) != api {
+		t.Error("client acting as a user does not call the mock")
+	}
+	if again := client.asUser("7"); again != sudo {
+		t.Error("client acting as a user not reused")
+	}
+}
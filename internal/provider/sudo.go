@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/looker-open-source/sdk-codegen/go/rtl"
+)
+
+// sudoRenewBefore is how long before its expiry a sudo token is replaced, so
+// that no request is sent with a token about to expire.
+const sudoRenewBefore = time.Minute
+
+// sudoSessions holds the clients acting as other users and their tokens. It is
+// shared by the admin client and every client derived from it.
+type sudoSessions struct {
+	mu      sync.Mutex
+	clients map[string]*clientBundle
+	tokens  map[string]sudoToken
+}
+
+// sudoToken is an access token of a user obtained through login_user.
+type sudoToken struct {
+	token   string
+	expires time.Time
+}
+
+func newSudoSessions() *sudoSessions {
+	return &sudoSessions{
+		clients: make(map[string]*clientBundle),
+		tokens:  make(map[string]sudoToken),
+	}
+}
+
+// asUser returns a client whose API calls run as userID, so that e.g. folders
+// it creates are owned by that user. The admin session logs in as the user on
+// the first call and again whenever the token is about to expire. An empty
+// userID returns c unchanged.
+func (c *clientBundle) asUser(userID string) *clientBundle {
+	if userID == "" || userID == c.sudoUserID {
+		return c
+	}
+	admin := c
+	if c.admin != nil {
+		admin = c.admin
+	}
+
+	admin.sudo.mu.Lock()
+	defer admin.sudo.mu.Unlock()
+	if client, ok := admin.sudo.clients[userID]; ok {
+		return client
+	}
+	client := *admin
+	client.session = &rtl.AuthSession{
+		Config: admin.settings,
+		Client: http.Client{Transport: &sudoTransport{base: admin.transport, admin: admin, userID: userID}},
+	}
+	client.admin = admin
+	client.sudoUserID = userID
+	admin.sudo.clients[userID] = &client
+	return &client
+}
+
+// sudoToken returns a valid access token of userID.
+func (c *clientBundle) sudoToken(ctx context.Context, userID string) (string, error) {
+	c.sudo.mu.Lock()
+	defer c.sudo.mu.Unlock()
+	if cached, ok := c.sudo.tokens[userID]; ok && time.Until(cached.expires) > sudoRenewBefore {
+		return cached.token, nil
+	}
+
+	// Not associative, so that what the token creates is attributed to the
+	// user rather than to the admin.
+	token, err := c.SDK(ctx).LoginUser(userID, false, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to log in as user %s: %w", userID, err)
+	}
+	if token.AccessToken == nil {
+		return "", fmt.Errorf("failed to log in as user %s: no access token returned", userID)
+	}
+	expires := time.Now().Add(time.Hour)
+	if token.ExpiresIn != nil {
+		expires = time.Now().Add(time.Duration(*token.ExpiresIn) * time.Second)
+	}
+	c.sudo.tokens[userID] = sudoToken{token: *token.AccessToken, expires: expires}
+	return *token.AccessToken, nil
+}
+
+// dropSudoToken forgets token of userID after Looker rejected it, unless a
+// concurrent request already replaced it.
+func (c *clientBundle) dropSudoToken(userID, token string) {
+	c.sudo.mu.Lock()
+	defer c.sudo.mu.Unlock()
+	if cached, ok := c.sudo.tokens[userID]; ok && cached.token == token {
+		delete(c.sudo.tokens, userID)
+	}
+}
+
+// sudoTransport is an http.RoundTripper that authenticates every request as
+// userID with a token obtained through the admin client.
+type sudoTransport struct {
+	base   http.RoundTripper
+	admin  *clientBundle
+	userID string
+}
+
+// RoundTrip implements http.RoundTripper. A request rejected with 401 is
+// retried once with a new token of the user.
+func (t *sudoTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.admin.sudoToken(req.Context(), t.userID)
+	if err != nil {
+		return nil, err
+	}
+	authorized := req.Clone(req.Context())
+	authorized.Header.Set("Authorization", "Bearer "+token)
+	resp, err := t.base.RoundTrip(authorized)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || (req.Body != nil && req.GetBody == nil) {
+		return resp, err
+	}
+	resp.Body.Close()
+
+	t.admin.dropSudoToken(t.userID, token)
+	if token, err = t.admin.sudoToken(req.Context(), t.userID); err != nil {
+		return nil, err
+	}
+	authorized = req.Clone(req.Context())
+	authorized.Header.Set("Authorization", "Bearer "+token)
+	if req.GetBody != nil {
+		if authorized.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	return t.base.RoundTrip(authorized)
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// durationPattern matches the durations accepted by time.ParseDuration that
+// make sense as timeouts, e.g. "90s" or "1h30m".
+var durationPattern = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$`)
+
+// timeoutsAttribute returns the optional `timeouts` attribute every resource
+// exposes, with one duration per operation.
+func timeoutsAttribute(ctx context.Context) schema.Attribute {
+	description := func(name string) string {
+		return "How long to wait for " + name + " before giving up, e.g. `10m`. Defaults to the provider's `operation_timeout`."
+	}
+	attribute := timeouts.Attributes(ctx, timeouts.Opts{
+		Create:            true,
+		Read:              true,
+		Update:            true,
+		Delete:            true,
+		CreateDescription: description("the resource to be created"),
+		ReadDescription:   description("the resource to be refreshed"),
+		UpdateDescription: description("the resource to be updated"),
+		DeleteDescription: description("the resource to be destroyed"),
+	}).(schema.SingleNestedAttribute)
+	attribute.MarkdownDescription = "Deadlines of the Terraform operations on this resource, covering every API call they make."
+	return attribute
+}
+
+// attributeGetter is implemented by tfsdk.Plan and tfsdk.State.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target interface{}) diag.Diagnostics
+}
+
+// withTimeout bounds ctx by the timeout configured for operation ("create",
+// "read", "update" or "delete") in the `timeouts` attribute of data, falling
+// back to the provider's operation_timeout. Every SDK call made with the
+// returned context fails once the deadline has passed. cancel must be called
+// when the operation is done.
+func (b *baseResource) withTimeout(ctx context.Context, data attributeGetter, operation string) (context.Context, context.CancelFunc) {
+	timeout := b.client.operationTimeout
+
+	var configured timeouts.Value
+	if diags := data.GetAttribute(ctx, path.Root("timeouts"), &configured); !diags.HasError() && !configured.IsNull() && !configured.IsUnknown() {
+		switch operation {
+		case "create":
+			timeout, _ = configured.Create(ctx, timeout)
+		case "read":
+			timeout, _ = configured.Read(ctx, timeout)
+		case "update":
+			timeout, _ = configured.Update(ctx, timeout)
+		case "delete":
+			timeout, _ = configured.Delete(ctx, timeout)
+		}
+	}
+
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
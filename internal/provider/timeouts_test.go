@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWithTimeout(t *testing.T) {
+	type operationTimeouts struct {
+		Create *string `tfsdk:"create"`
+		Read   *string `tfsdk:"read"`
+		Update *string `tfsdk:"update"`
+		Delete *string `tfsdk:"delete"`
+	}
+	tests := map[string]struct {
+		operationTimeout time.Duration
+		timeouts         *operationTimeouts
+		operation        string
+		want             time.Duration
+	}{
+		"no deadline":               {operation: "create"},
+		"provider default":          {operationTimeout: time.Hour, operation: "read", want: time.Hour},
+		"operation timeout":         {operationTimeout: time.Hour, timeouts: &operationTimeouts{Update: ptr("90s")}, operation: "update", want: 90 * time.Second},
+		"other operation's timeout": {operationTimeout: time.Hour, timeouts: &operationTimeouts{Create: ptr("90s")}, operation: "delete", want: time.Hour},
+		"without provider default":  {timeouts: &operationTimeouts{Delete: ptr("1h30m")}, operation: "delete", want: 90 * time.Minute},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, _ := newMockClient(t)
+			client.operationTimeout = test.operationTimeout
+			r := &groupResource{baseResource{client: client}}
+			values := map[string]any{}
+			if test.timeouts != nil {
+				values["timeouts"] = test.timeouts
+			}
+			state := newTestResource(t, r).state(values)
+
+			ctx, cancel := r.withTimeout(context.Background(), state, test.operation)
+			defer cancel()
+			deadline, ok := ctx.Deadline()
+			if ok != (test.want > 0) {
+				t.Fatalf("deadline set = %v, want %v", ok, test.want > 0)
+			}
+			if got := time.Until(deadline); ok && (got < test.want-time.Minute || got > test.want) {
+				t.Errorf("deadline in %v, want %v", got, test.want)
+			}
+		})
+	}
+}
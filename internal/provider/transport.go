@@ -0,0 +1,505 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultMaxRetries is how often a request rejected with 429 or 5xx is
+	// retried when max_retries is not configured.
+	defaultMaxRetries = 5
+	// defaultRetryWaitMax caps the wait between two attempts when
+	// retry_wait_max is not configured.
+	defaultRetryWaitMax = 30 * time.Second
+	// retryWaitMin is the first backoff step, doubled on every attempt.
+	retryWaitMin = time.Second
+	// rateLimitLowWatermark is the remaining quota below which requests are spread out.
+	rateLimitLowWatermark = 10
+)
+
+// retryPolicy configures how rateLimitTransport retries failed requests.
+type retryPolicy struct {
+	// maxRetries is the number of retries after the first attempt.
+	maxRetries int
+	// waitMax caps the wait between two attempts, including waits requested
+	// by Retry-After.
+	waitMax time.Duration
+}
+
+// backoff returns the wait before retry number attempt (0-based): the
+// Retry-After of resp when given, exponential backoff with jitter otherwise,
+// capped at waitMax.
+func (p retryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	wait := retryWaitMin << min(attempt, 16)
+	wait = wait/2 + time.Duration(rand.Int64N(int64(wait/2)+1))
+	wait = retryAfter(resp.Header, wait)
+	if p.waitMax > 0 && wait > p.waitMax {
+		wait = p.waitMax
+	}
+	return wait
+}
+
+// retryable reports whether resp is worth retrying. 429 means the request was
+// not processed. Server errors are only retried for idempotent methods, since
+// a POST may have taken effect before the error.
+func retryable(req *http.Request, resp *http.Response) bool {
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented:
+		return req.Method != http.MethodPost
+	}
+	return false
+}
+
+// contextTransport binds the requests of an SDK call to the context of the
+// Terraform operation that issued it. The rtl session derives every request
+// from context.Background, so without it cancellation never reaches the HTTP
+// layer. The request's own deadline (the rtl timeout) is still honored.
+type contextTransport struct {
+	ctx  context.Context
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithCancel(t.ctx)
+	stop := context.AfterFunc(req.Context(), cancel)
+	release := func() {
+		stop()
+		cancel()
+	}
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		release()
+		return nil, err
+	}
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releaseOnClose runs release once the response body has been closed, keeping
+// the request context alive while the body is being read.
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
+
+// rateLimitTransport is an http.RoundTripper that honors Looker's rate-limit
+// signalling and rides out transient failures: requests rejected with 429, and
+// idempotent requests failing with 5xx, are retried with exponential backoff
+// or after the delay given in Retry-After. Once the remaining quota reported
+// by the X-RateLimit-* headers runs low, subsequent requests are spread over
+// the rest of the window instead of failing near the end of a long apply.
+type rateLimitTransport struct {
+	base   http.RoundTripper
+	policy retryPolicy
+
+	mu        sync.Mutex
+	notBefore time.Time
+}
+
+func newRateLimitTransport(base http.RoundTripper, policy retryPolicy) *rateLimitTransport {
+	return &rateLimitTransport{base: base, policy: policy}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if err := t.throttle(req.Context()); err != nil {
+			return nil, err
+		}
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+		if err != nil {
+			return nil, err
+		}
+		t.observe(req, resp)
+
+		if !retryable(req, resp) || attempt >= t.policy.maxRetries || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+
+		wait := t.policy.backoff(attempt, resp)
+		resp.Body.Close()
+		fields := map[string]interface{}{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"status":  resp.StatusCode,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if resp.StatusCode == http.StatusTooManyRequests {
+			// The quota is shared, so every request waits.
+			tflog.Warn(req.Context(), "Looker API rate limit hit, retrying", fields)
+			t.delay(wait)
+			continue
+		}
+		tflog.Warn(req.Context(), "Looker API server error, retrying", fields)
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// throttle blocks until any pause requested by earlier responses has elapsed.
+func (t *rateLimitTransport) throttle(ctx context.Context) error {
+	t.mu.Lock()
+	wait := time.Until(t.notBefore)
+	t.mu.Unlock()
+	return sleep(ctx, wait)
+}
+
+// sleep waits for d unless ctx is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// delay pushes back the earliest time at which the next request may be sent.
+func (t *rateLimitTransport) delay(wait time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if until := time.Now().Add(wait); until.After(t.notBefore) {
+		t.notBefore = until
+	}
+}
+
+// observe records the rate-limit headers of a response and, when the remaining
+// quota is low, spreads the next requests evenly over the rest of the window.
+func (t *rateLimitTransport) observe(req *http.Request, resp *http.Response) {
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	limit := resp.Header.Get("X-RateLimit-Limit")
+	reset := rateLimitReset(resp.Header.Get("X-RateLimit-Reset"))
+
+	tflog.Debug(req.Context(), "Looker API rate limit status", map[string]interface{}{
+		"method":    req.Method,
+		"path":      req.URL.Path,
+		"limit":     limit,
+		"remaining": remaining,
+		"reset":     reset.String(),
+	})
+
+	if remaining < rateLimitLowWatermark && reset > 0 {
+		t.delay(reset / time.Duration(remaining+1))
+	}
+}
+
+// rateLimitReset parses X-RateLimit-Reset, which is either the number of
+// seconds until the window resets or the reset time as a Unix timestamp.
+func rateLimitReset(value string) time.Duration {
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n <= 0 {
+		return 0
+	}
+	if n > 1_000_000_000 {
+		if wait := time.Until(time.Unix(n, 0)); wait > 0 {
+			return wait
+		}
+		return 0
+	}
+	return time.Duration(n) * time.Second
+}
+
+// retryAfter parses a Retry-After style header, given either in seconds or as
+// an HTTP date, and returns fallback when it is absent or malformed.
+func retryAfter(header http.Header, fallback time.Duration) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return fallback
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(at); wait > 0 {
+			return wait
+		}
+		return 0
+	}
+	return fallback
+}
+
+// concurrencyTransport is an http.RoundTripper that caps the number of
+// requests in flight and the rate at which they are sent. A single instance is
+// shared by every resource of a run, so the limits hold whatever Terraform's
+// -parallelism. A request holds its slot until its response body is closed.
+type concurrencyTransport struct {
+	base   http.RoundTripper
+	slots  chan struct{} // nil when concurrency is not limited
+	bucket *tokenBucket  // nil when the rate is not limited
+}
+
+// newConcurrencyTransport returns base unchanged when neither limit is set.
+// maxConcurrent and perSecond are ignored when not positive.
+func newConcurrencyTransport(base http.RoundTripper, maxConcurrent, perSecond int) http.RoundTripper {
+	if maxConcurrent <= 0 && perSecond <= 0 {
+		return base
+	}
+	t := &concurrencyTransport{base: base}
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+	if perSecond > 0 {
+		t.bucket = newTokenBucket(perSecond)
+	}
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *concurrencyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	release := func() {}
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		var once sync.Once
+		release = func() { once.Do(func() { <-t.slots }) }
+	}
+	if t.bucket != nil {
+		if err := t.bucket.wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// tokenBucket allows perSecond requests per second on average, with bursts
+// of up to perSecond requests after a quiet period.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(perSecond int) *tokenBucket {
+	return &tokenBucket{rate: float64(perSecond), tokens: float64(perSecond), last: time.Now()}
+}
+
+// wait blocks until a token is available and takes it.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		b.tokens = min(b.rate, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+		wait := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+
+		if err := sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// headerTransport is an http.RoundTripper that sets the provider's
+// User-Agent and fixed extra headers, such as the service token of an access
+// gateway in front of Looker, on every request. The rtl session only tags some
+// of its requests, e.g. not the login.
+type headerTransport struct {
+	base    http.RoundTripper
+	headers http.Header
+}
+
+func newHeaderTransport(base http.RoundTripper, userAgent string, extra map[string]string) *headerTransport {
+	t := &headerTransport{base: base, headers: http.Header{}}
+	for name, value := range extra {
+		t.headers.Set(name, value)
+	}
+	if userAgent != "" {
+		t.headers.Set("User-Agent", userAgent)
+	}
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for name, values := range t.headers {
+		req.Header[name] = values
+	}
+	return t.base.RoundTrip(req)
+}
+
+// tokenTransport is an http.RoundTripper that authenticates every request
+// with a fixed API access token, replacing the login with API credentials.
+type tokenTransport struct {
+	base  http.RoundTripper
+	token string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	return t.base.RoundTrip(req)
+}
+
+// reloginTransport is an http.RoundTripper authenticating with API
+// credentials. When Looker rejects the access token with 401, e.g. because it
+// was revoked or expired during a long apply, it logs in again and retries the
+// request once.
+type reloginTransport struct {
+	// login returns a transport holding a new session.
+	login func() http.RoundTripper
+
+	mu      sync.Mutex
+	current http.RoundTripper
+}
+
+func newReloginTransport(login func() http.RoundTripper) *reloginTransport {
+	return &reloginTransport{login: login, current: login()}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *reloginTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	current := t.current
+	t.mu.Unlock()
+
+	resp, err := current.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || (req.Body != nil && req.GetBody == nil) {
+		return resp, err
+	}
+	resp.Body.Close()
+
+	t.mu.Lock()
+	// Requests failing concurrently with the same session log in only once.
+	if t.current == current {
+		tflog.Info(req.Context(), "Looker API rejected the access token, logging in again", map[string]interface{}{
+			"method": req.Method,
+			"path":   req.URL.Path,
+		})
+		t.current = t.login()
+	}
+	current = t.current
+	t.mu.Unlock()
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	return current.RoundTrip(retry)
+}
+
+// requestIDHeaders are the response headers that may carry the ID Looker
+// assigned to a request, quoted in support tickets.
+var requestIDHeaders = []string{"X-Request-Id", "X-Looker-Request-Id"}
+
+// logTransport is an http.RoundTripper that logs every attempt of an API call
+// with its outcome and duration at DEBUG level, and its query at TRACE level,
+// so that slow applies can be diagnosed with TF_LOG. With bodies, the request
+// and response bodies are logged too, redacted by redactBody.
+type logTransport struct {
+	base   http.RoundTripper
+	bodies bool
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *logTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	fields := map[string]interface{}{
+		"method": req.Method,
+		"path":   req.URL.Path,
+	}
+	tflog.Trace(ctx, "Looker API request", map[string]interface{}{
+		"method": req.Method,
+		"path":   req.URL.Path,
+		"query":  req.URL.RawQuery,
+	})
+
+	if t.bodies {
+		body, err := readRequestBody(req)
+		if err != nil {
+			// A RoundTripper must close the request body, even on errors.
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+		fields["request_body"] = redactBody(body, req.Header.Get("Content-Type"))
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Looker API request failed", fields)
+		return nil, err
+	}
+	fields["status"] = resp.StatusCode
+	for _, header := range requestIDHeaders {
+		if id := resp.Header.Get(header); id != "" {
+			fields["request_id"] = id
+			break
+		}
+	}
+	if t.bodies {
+		body, err := readResponseBody(resp)
+		if err != nil {
+			// The response is not returned, so nobody else closes it.
+			resp.Body.Close()
+			return nil, err
+		}
+		fields["response_body"] = redactBody(body, resp.Header.Get("Content-Type"))
+	}
+	tflog.Debug(ctx, "Looker API response", fields)
+	return resp, nil
+}
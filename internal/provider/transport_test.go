@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// failingBody fails every read and records whether it was closed.
+type failingBody struct{ closed bool }
+
+func (b *failingBody) Read([]byte) (int, error) { return 0, errors.New("connection reset") }
+func (b *failingBody) Close() error             { b.closed = true; return nil }
+
+func TestLogTransportClosesUnreadableBodies(t *testing.T) {
+	t.Run("response", func(t *testing.T) {
+		body := &failingBody{}
+		transport := &logTransport{bodies: true, base: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: body}, nil
+		})}
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://looker.example.com/api/4.0/user", nil)
+		if _, err := transport.RoundTrip(req); err == nil {
+			t.Fatal("no error for an unreadable response body")
+		}
+		if !body.closed {
+			t.Error("response body not closed")
+		}
+	})
+	t.Run("request", func(t *testing.T) {
+		body := &failingBody{}
+		transport := &logTransport{bodies: true, base: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			t.Fatal("request sent despite an unreadable body")
+			return nil, nil
+		})}
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "https://looker.example.com/api/4.0/groups", body)
+		if _, err := transport.RoundTrip(req); err == nil {
+			t.Fatal("no error for an unreadable request body")
+		}
+		if !body.closed {
+			t.Error("request body not closed")
+		}
+	})
+	t.Run("readable response", func(t *testing.T) {
+		transport := &logTransport{bodies: true, base: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(`{"id":"1"}`))}, nil
+		})}
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://looker.example.com/api/4.0/user", nil)
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, _ := io.ReadAll(resp.Body)
+		if string(got) != `{"id":"1"}` {
+			t.Errorf("body %q not passed on", got)
+		}
+	})
+}
+
+func TestRetryAfter(t *testing.T) {
+	fallback := 3 * time.Second
+	tests := map[string]struct {
+		value string
+		want  time.Duration
+	}{
+		"absent":       {"", fallback},
+		"seconds":      {"12", 12 * time.Second},
+		"zero":         {"0", 0},
+		"negative":     {"-5", fallback},
+		"malformed":    {"soon", fallback},
+		"past date":    {"Mon, 02 Jan 2006 15:04:05 GMT", 0},
+		"decimal only": {"1.5", fallback},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			header := http.Header{}
+			if test.value != "" {
+				header.Set("Retry-After", test.value)
+			}
+			if got := retryAfter(header, fallback); got != test.want {
+				t.Errorf("retryAfter(%q) = %s, want %s", test.value, got, test.want)
+			}
+		})
+	}
+
+	t.Run("future date", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+		if got := retryAfter(header, fallback); got <= 55*time.Second || got > time.Minute {
+			t.Errorf("retryAfter = %s, want about a minute", got)
+		}
+	})
+}
+
+func TestRateLimitReset(t *testing.T) {
+	tests := map[string]struct {
+		value string
+		want  time.Duration
+	}{
+		"empty":          {"", 0},
+		"malformed":      {"later", 0},
+		"zero":           {"0", 0},
+		"negative":       {"-3", 0},
+		"seconds":        {"30", 30 * time.Second},
+		"past timestamp": {"1500000000", 0},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := rateLimitReset(test.value); got != test.want {
+				t.Errorf("rateLimitReset(%q) = %s, want %s", test.value, got, test.want)
+			}
+		})
+	}
+
+	t.Run("future timestamp", func(t *testing.T) {
+		value := strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10)
+		if got := rateLimitReset(value); got <= 55*time.Second || got > time.Minute {
+			t.Errorf("rateLimitReset(%q) = %s, want about a minute", value, got)
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"terraform-provider-looker/internal/lookerapi"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// userEmailCache remembers the user ID of every email resolved during the
+// provider run, so that groups sharing members do not search for the same
+// users again. It is shared by the admin client and every client derived from
+// it. Emails that match no user are not cached, since the user may be created
+// later in the same run.
+type userEmailCache struct {
+	mu  sync.Mutex
+	ids map[string]string
+
+	// prefetch loads all users on the first lookup instead of searching for
+	// each email, which is faster when many emails are resolved.
+	prefetch   bool
+	prefetched bool
+}
+
+func newUserEmailCache() *userEmailCache {
+	return &userEmailCache{ids: make(map[string]string)}
+}
+
+// resolveUserEmailsToIDs returns the IDs of the users with the given emails,
+// in the same order. Emails are compared case-insensitively, like Looker does.
+func resolveUserEmailsToIDs(ctx context.Context, client *clientBundle, emails []string) ([]string, error) {
+	cache := client.users
+	sdk := client.SDK(ctx)
+
+	cache.mu.Lock()
+	if cache.prefetch && !cache.prefetched && len(emails) > 0 {
+		if err := cache.load(ctx, client); err != nil {
+			cache.mu.Unlock()
+			return nil, err
+		}
+		cache.prefetched = true
+	}
+	cache.mu.Unlock()
+
+	resolvedIDs := make([]string, 0, len(emails))
+	for _, email := range emails {
+		key := strings.ToLower(email)
+		cache.mu.Lock()
+		id, ok := cache.ids[key]
+		cache.mu.Unlock()
+		if !ok {
+			var err error
+			if id, err = searchUserByEmail(sdk, email); err != nil {
+				return nil, err
+			}
+			cache.mu.Lock()
+			cache.ids[key] = id
+			cache.mu.Unlock()
+		}
+		resolvedIDs = append(resolvedIDs, id)
+	}
+	return resolvedIDs, nil
+}
+
+// searchUserByEmail returns the ID of the user with email. The search treats _
+// and % as wildcards, so only exact matches are kept.
+func searchUserByEmail(sdk lookerapi.Client, email string) (string, error) {
+	fields := "id,email"
+	results, err := sdk.SearchUsers(v4.RequestSearchUsers{Email: &email, Fields: &fields}, nil)
+	if err != nil {
+		return "", fmt.Errorf("API error searching for user with email %s: %w", email, err)
+	}
+	var ids []string
+	for _, user := range results {
+		if user.Id != nil && user.Email != nil && strings.EqualFold(*user.Email, email) {
+			ids = append(ids, *user.Id)
+		}
+	}
+	if len(ids) == 0 {
+		return "", fmt.Errorf("no user found with email %s", email)
+	}
+	if len(ids) > 1 {
+		return "", fmt.Errorf("multiple users found with email %s", email)
+	}
+	return ids[0], nil
+}
+
+// load adds every user with an email to the cache. The user list is streamed,
+// so that only the cache grows with the size of the instance. c.mu must be
+// held.
+func (c *userEmailCache) load(ctx context.Context, client *clientBundle) error {
+	err := forEachUser(ctx, client, "id,email", func(user v4.User) error {
+		if user.Id != nil && user.Email != nil && *user.Email != "" {
+			c.ids[strings.ToLower(*user.Email)] = *user.Id
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("API error listing users: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+// Package sweep deletes the objects left behind on a Looker instance by
+// acceptance test runs that failed before destroying them. Every object whose
+// name starts with the test prefix is removed, so the prefix must never be
+// used for real content.
+package sweep
+
+import (
+	"fmt"
+	"strings"
+
+	v4 "github.com/looker-open-source/sdk-codegen/go/sdk/v4"
+)
+
+// DefaultPrefix starts the name of every object created by acceptance tests.
+const DefaultPrefix = "tf-acc-test-"
+
+const pageSize = 500
+
+// object is a swept object.
+type object struct {
+	id   string
+	name string
+}
+
+// sweeper finds and deletes the test objects of one kind.
+type sweeper struct {
+	kind   string
+	search func(sdk *v4.LookerSDK, pattern string, limit, offset int64) ([]object, error)
+	delete func(sdk *v4.LookerSDK, id string) error
+}
+
+// sweepers run in order: roles before the permission and model sets they
+// reference.
+var sweepers = []sweeper{
+	{
+		kind: "role",
+		search: func(sdk *v4.LookerSDK, pattern string, limit, offset int64) ([]object, error) {
+			roles, err := sdk.SearchRoles(v4.RequestSearchRoles{Name: &pattern, Fields: ptr("id,name"), Limit: &limit, Offset: &offset}, nil)
+			objects := make([]object, 0, len(roles))
+			for _, role := range roles {
+				objects = append(objects, object{id: deref(role.Id), name: deref(role.Name)})
+			}
+			return objects, err
+		},
+		delete: func(sdk *v4.LookerSDK, id string) error {
+			_, err := sdk.DeleteRole(id, nil)
+			return err
+		},
+	},
+	{
+		kind: "group",
+		search: func(sdk *v4.LookerSDK, pattern string, limit, offset int64) ([]object, error) {
+			groups, err := sdk.SearchGroups(v4.RequestSearchGroups{Name: &pattern, Fields: ptr("id,name"), Limit: &limit, Offset: &offset}, nil)
+			objects := make([]object, 0, len(groups))
+			for _, group := range groups {
+				objects = append(objects, object{id: deref(group.Id), name: deref(group.Name)})
+			}
+			return objects, err
+		},
+		delete: func(sdk *v4.LookerSDK, id string) error {
+			_, err := sdk.DeleteGroup(id, nil)
+			return err
+		},
+	},
+	{
+		kind: "permission set",
+		search: func(sdk *v4.LookerSDK, pattern string, limit, offset int64) ([]object, error) {
+			sets, err := sdk.SearchPermissionSets(v4.RequestSearchPermissionSets{Name: &pattern, Fields: ptr("id,name"), Limit: &limit, Offset: &offset}, nil)
+			objects := make([]object, 0, len(sets))
+			for _, set := range sets {
+				objects = append(objects, object{id: deref(set.Id), name: deref(set.Name)})
+			}
+			return objects, err
+		},
+		delete: func(sdk *v4.LookerSDK, id string) error {
+			_, err := sdk.DeletePermissionSet(id, nil)
+			return err
+		},
+	},
+	{
+		kind: "model set",
+		search: func(sdk *v4.LookerSDK, pattern string, limit, offset int64) ([]object, error) {
+			sets, err := sdk.SearchModelSets(v4.RequestSearchModelSets{Name: &pattern, Fields: ptr("id,name"), Limit: &limit, Offset: &offset}, nil)
+			objects := make([]object, 0, len(sets))
+			for _, set := range sets {
+				objects = append(objects, object{id: deref(set.Id), name: deref(set.Name)})
+			}
+			return objects, err
+		},
+		delete: func(sdk *v4.LookerSDK, id string) error {
+			_, err := sdk.DeleteModelSet(id, nil)
+			return err
+		},
+	},
+	{
+		// Deleting a folder deletes its subfolders, which are then skipped.
+		kind: "folder",
+		search: func(sdk *v4.LookerSDK, pattern string, limit, offset int64) ([]object, error) {
+			folders, err := sdk.SearchFolders(v4.RequestSearchFolders{Name: &pattern, Fields: ptr("id,name"), Limit: &limit, Offset: &offset}, nil)
+			objects := make([]object, 0, len(folders))
+			for _, folder := range folders {
+				objects = append(objects, object{id: deref(folder.Id), name: folder.Name})
+			}
+			return objects, err
+		},
+		delete: func(sdk *v4.LookerSDK, id string) error {
+			_, err := sdk.DeleteFolder(id, nil)
+			return err
+		},
+	},
+}
+
+// Sweep deletes every role, group, permission set, model set and folder whose
+// name starts with prefix, logging each one through logf. With dryRun the
+// objects are only logged. Errors are collected so that one undeletable
+// object does not keep the others around.
+func Sweep(sdk *v4.LookerSDK, prefix string, dryRun bool, logf func(format string, args ...any)) error {
+	if len(prefix) < 4 {
+		return fmt.Errorf("refusing to sweep with prefix %q: use at least 4 characters", prefix)
+	}
+	// % and _ are wildcards in Looker searches; exact prefixes are checked
+	// below.
+	pattern := prefix + "%"
+
+	var errs []string
+	for _, s := range sweepers {
+		var found []object
+		for offset := int64(0); ; offset += pageSize {
+			page, err := s.search(sdk, pattern, pageSize, offset)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("failed to search %ss: %v", s.kind, err))
+				break
+			}
+			found = append(found, page...)
+			if len(page) < pageSize {
+				break
+			}
+		}
+
+		for _, o := range found {
+			if !strings.HasPrefix(o.name, prefix) {
+				continue
+			}
+			if dryRun {
+				logf("Would delete %s %s (%s)", s.kind, o.id, o.name)
+				continue
+			}
+			if err := s.delete(sdk, o.id); err != nil {
+				if strings.Contains(err.Error(), "status=404") {
+					continue
+				}
+				errs = append(errs, fmt.Sprintf("failed to delete %s %s (%s): %v", s.kind, o.id, o.name, err))
+				continue
+			}
+			logf("Deleted %s %s (%s)", s.kind, o.id, o.name)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("sweep incomplete:\n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+func ptr(s string) *string { return &s }
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}